@@ -0,0 +1,3 @@
+data "prismacloudcompute_collection" "all" {
+  name = "All"
+}
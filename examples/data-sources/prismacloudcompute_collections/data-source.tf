@@ -0,0 +1,7 @@
+data "prismacloudcompute_collections" "payments" {
+  name_regex = "^payments-"
+}
+
+output "payments_collection_names" {
+  value = data.prismacloudcompute_collections.payments.listing[*].name
+}
@@ -0,0 +1,7 @@
+data "prismacloudcompute_credential" "aws" {
+  name = "aws-scanner"
+}
+
+output "aws_scanner_account_id" {
+  value = data.prismacloudcompute_credential.aws.account_id
+}
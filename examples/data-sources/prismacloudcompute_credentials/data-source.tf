@@ -0,0 +1,7 @@
+data "prismacloudcompute_credentials" "aws" {
+  name_regex = "^aws-"
+}
+
+output "aws_credential_names" {
+  value = data.prismacloudcompute_credentials.aws.listing[*].name
+}
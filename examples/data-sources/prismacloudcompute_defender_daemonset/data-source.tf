@@ -0,0 +1,12 @@
+data "prismacloudcompute_defender_daemonset" "cluster" {
+  console_address    = "console.example.com"
+  cluster            = "production"
+  collect_pod_labels = true
+  cri                = true
+  node_selector      = "kubernetes.io/os: linux"
+}
+
+resource "kubernetes_manifest" "defender" {
+  count    = nonsensitive(length(data.prismacloudcompute_defender_daemonset.cluster.manifests))
+  manifest = yamldecode(data.prismacloudcompute_defender_daemonset.cluster.manifests[count.index])
+}
@@ -0,0 +1,16 @@
+data "prismacloudcompute_defender_helm_chart" "cluster" {
+  console_address = "console.example.com"
+  cluster         = "production"
+  cri             = true
+}
+
+resource "local_sensitive_file" "defender_chart" {
+  filename       = "${path.module}/twistlock-defender-helm.tar.gz"
+  content_base64 = data.prismacloudcompute_defender_helm_chart.cluster.content_base64
+}
+
+resource "helm_release" "defender" {
+  name      = "twistlock-defender"
+  chart     = local_sensitive_file.defender_chart.filename
+  namespace = "twistlock"
+}
@@ -0,0 +1,7 @@
+data "prismacloudcompute_defender_host_script" "linux" {}
+
+resource "local_sensitive_file" "install_defender" {
+  filename        = "${path.module}/defender.sh"
+  content         = data.prismacloudcompute_defender_host_script.linux.script
+  file_permission = "0700"
+}
@@ -0,0 +1,3 @@
+data "prismacloudcompute_group" "security" {
+  name = "security"
+}
@@ -0,0 +1,7 @@
+data "prismacloudcompute_groups" "ldap" {
+  name_regex = "^ldap-"
+}
+
+output "ldap_group_names" {
+  value = data.prismacloudcompute_groups.ldap.listing[*].name
+}
@@ -0,0 +1,3 @@
+data "prismacloudcompute_role" "auditor" {
+  name = "auditor"
+}
@@ -0,0 +1,5 @@
+data "prismacloudcompute_roles" "all" {}
+
+output "role_names" {
+  value = data.prismacloudcompute_roles.all.listing[*].name
+}
@@ -0,0 +1,3 @@
+data "prismacloudcompute_user" "admin" {
+  username = "admin"
+}
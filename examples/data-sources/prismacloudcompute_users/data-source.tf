@@ -0,0 +1,7 @@
+data "prismacloudcompute_users" "ci" {
+  name_regex = "^ci-"
+}
+
+output "ci_usernames" {
+  value = data.prismacloudcompute_users.ci.listing[*].username
+}
@@ -0,0 +1,7 @@
+resource "prismacloudcompute_admission_policy_rule" "payments" {
+  name        = "Payments - do not allow privileged pods"
+  position    = "top"
+  description = "Do not allow privileged pods in the payments namespaces"
+  effect      = "block"
+  script      = "match[{\"msg\": msg}] { input.request.operation == \"CREATE\" ; msg := \"privileged\" }"
+}
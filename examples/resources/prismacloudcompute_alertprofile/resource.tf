@@ -1,13 +1,10 @@
-resource "prismacloudcompute_alertprofile" "test" {
-  name               = "webhook-example"
-  enabled            = true
-  alert_profile_type = "webhook"
+resource "prismacloudcompute_alertprofile" "webhook" {
+  name = "webhook-example"
 
   enable_immediate_vulnerabilities_alerts = false
 
-  alert_profile_config {
-    prisma_cloud_integration_id = prismacloud_integration.this.id
-    webhook_url = "https://webhook.url"
+  webhook {
+    url         = "https://webhook.url"
     custom_json = <<-EOT
                 {
                   "types": "#type",
@@ -15,18 +12,8 @@ resource "prismacloudcompute_alertprofile" "test" {
                   "container": "#container",
                   "image": "#image",
                   "host": "#host",
-                  "fqdn": "#fqdn",
-                  "function": "#function",
-                  "region": "#region",
-                  "runtime": "#runtime",
-                  "appID": "#appID",
                   "rule": "#rule",
                   "message": "#message",
-                  "aggregated": "#aggregated",
-                  "rest": "#rest",
-                  "forensics": "#forensics",
-                  "accountID": "#accountID",
-                  "cluster": "#cluster",
                   "labels": #labels,
                   "collections": #collections,
                   "complianceIssues": #complianceIssues,
@@ -35,97 +22,92 @@ resource "prismacloudcompute_alertprofile" "test" {
             EOT
   }
 
-  alert_triggers {
-    access {
-      enabled   = true
-      all_rules = true
-    }
-
+  policy {
     admission {
       enabled   = true
       all_rules = true
     }
 
-    app_embedded_defender_runtime {
+    container_runtime {
       enabled   = true
       all_rules = true
     }
 
-    cloud_native_network_firewall {
-      enabled = true
-    }
-
-    container_and_image_compliance {
+    container_vulnerability {
       enabled   = true
-      all_rules = true
+      all_rules = false
+      rules     = ["rule1", "rule2"]
     }
 
-    container_runtime {
+    defender {
       enabled   = true
       all_rules = true
     }
 
-    defender_health {
-      enabled = true
-    }
-
-    host_compliance {
+    incident {
       enabled   = true
       all_rules = true
     }
+  }
+}
 
-    host_runtime {
-      enabled   = true
-      all_rules = true
-    }
+resource "prismacloudcompute_alertprofile" "slack" {
+  name = "slack-example"
+
+  slack {
+    webhook_url = var.slack_webhook_url
+    channels    = ["#security-alerts"]
+  }
 
-    host_vulnerabilities {
+  policy {
+    incident {
       enabled   = true
       all_rules = true
     }
-    image_vulnerabilities {
-      enabled   = true
-      all_rules = false
-      rules     = ["rule1", "rule2"]
-    }
+  }
+}
 
-    incidents {
-      enabled = true
-    }
+resource "prismacloudcompute_credential" "jira" {
+  name       = "jira-alerts"
+  type       = "basic"
+  account_id = "compute-bot@example.com"
+  secret {
+    plain = var.jira_api_token
+  }
+}
 
-    kubernetes_audits {
-      enabled   = true
-      all_rules = true
-    }
+resource "prismacloudcompute_alertprofile" "jira" {
+  name = "jira-example"
 
-    serverless_runtime {
-      enabled   = true
-      all_rules = true
-    }
+  jira {
+    base_url      = "https://example.atlassian.net"
+    credential_id = prismacloudcompute_credential.jira.name
+    project_key   = "SEC"
+    issue_type    = "Bug"
+    labels        = ["prisma-cloud-compute"]
+  }
 
-    waas_firewall_app_embedded_defender {
+  policy {
+    host_vulnerability {
       enabled   = true
       all_rules = true
     }
+  }
+}
 
-    waas_firewall_container {
-      enabled   = true
-      all_rules = true
-    }
+resource "prismacloudcompute_alertprofile" "pagerduty" {
+  name = "pagerduty-example"
 
-    waas_firewall_host {
-      enabled   = true
-      all_rules = true
-    }
+  pagerduty {
+    routing_key = var.pagerduty_routing_key
+    severity    = "critical"
+    summary     = "Prisma Cloud Compute runtime alert"
+  }
 
-    waas_firewall_serverless {
+  policy {
+    container_runtime {
       enabled   = true
       all_rules = true
     }
-
-    waas_health {
-      enabled = true
-    }
-
   }
 }
@@ -0,0 +1,42 @@
+resource "prismacloudcompute_app_embedded_runtime_policy_rule" "payments" {
+  name                              = "Payments - block cloud metadata access"
+  collections                       = ["payments"]
+  position                          = "top"
+  cloud_metadata_enforcement_effect = "block"
+
+  dns {
+    default_effect = "alert"
+    domain_list {
+      allowed = []
+      denied  = []
+      effect  = "disable"
+    }
+  }
+  filesystem {
+    allowed_list   = []
+    default_effect = "alert"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+  network {
+    allowed_ips    = []
+    default_effect = "alert"
+    denied_ips     = []
+    listening_ports {
+      effect = "disable"
+    }
+    outbound_ports {
+      effect = "disable"
+    }
+  }
+  processes {
+    allowed_list   = []
+    default_effect = "alert"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+}
@@ -0,0 +1,31 @@
+resource "prismacloudcompute_app_embedded_waas_policy" "ruleset" {
+  rule {
+    name        = "Internal APIs"
+    collections = ["All"]
+
+    application {
+      app_id = "app-0001"
+
+      api_protection {
+        endpoint {
+          host      = "*"
+          base_path = "*"
+        }
+        path {
+          path    = "/status"
+          methods = ["GET"]
+        }
+      }
+
+      sql_injection {
+        effect = "alert"
+      }
+      xss {
+        effect = "alert"
+      }
+      command_injection {
+        effect = "alert"
+      }
+    }
+  }
+}
@@ -0,0 +1,20 @@
+resource "prismacloudcompute_ci_coderepo_compliance_policy_rule" "payments" {
+  name        = "Payments - fail repository scans with AGPL licenses"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  license {
+    critical = ["AGPL-3.0"]
+    high     = []
+    medium   = []
+    low      = []
+    alert_threshold {
+      enabled = true
+      value   = 3
+    }
+    block_threshold {
+      enabled = true
+      value   = 1
+    }
+  }
+}
@@ -0,0 +1,20 @@
+resource "prismacloudcompute_ci_coderepo_vulnerability_policy_rule" "payments" {
+  name        = "Payments - fail repository scans with critical vulnerabilities"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  alert_threshold {
+    disabled = false
+    value    = 0
+  }
+  grace_days_policy {
+    critical = 0
+    high     = 0
+    medium   = 0
+    low      = 0
+  }
+  block_threshold {
+    enabled = true
+    value   = 9
+  }
+}
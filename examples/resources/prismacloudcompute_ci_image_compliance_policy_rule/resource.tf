@@ -0,0 +1,10 @@
+resource "prismacloudcompute_ci_image_compliance_policy_rule" "payments" {
+  name        = "Payments - fail builds of images running as root"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  compliance_check {
+    block = true
+    id    = 41
+  }
+}
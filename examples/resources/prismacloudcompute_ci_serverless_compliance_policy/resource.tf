@@ -0,0 +1,15 @@
+resource "prismacloudcompute_ci_serverless_compliance_policy" "ruleset" {
+  rule {
+    name        = "Default - alert on critical and high"
+    effect      = "alert"
+    collections = ["All"]
+    compliance_check {
+      block = false
+      id    = 434
+    }
+    compliance_check {
+      block = false
+      id    = 435
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "prismacloudcompute_ci_serverless_compliance_policy_rule" "payments" {
+  name        = "Payments - fail function builds with compliance issues"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  compliance_check {
+    block = true
+    id    = 434
+  }
+}
@@ -0,0 +1,24 @@
+resource "prismacloudcompute_ci_serverless_vulnerability_policy" "ruleset" {
+  rule {
+    name        = "Default - alert all components"
+    effect      = "alert"
+    collections = ["All"]
+    alert_threshold {
+      disabled = false
+      value    = 4
+    }
+    block_threshold {
+      enabled = false
+      value   = 0
+    }
+    cve_rule {
+      description = "Not exploitable in our functions"
+      effect      = "ignore"
+      id          = "CVE-2021-44228"
+      expiration {
+        date    = "2023-01-01T00:00:00Z"
+        enabled = true
+      }
+    }
+  }
+}
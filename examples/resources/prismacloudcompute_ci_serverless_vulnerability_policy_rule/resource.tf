@@ -0,0 +1,22 @@
+resource "prismacloudcompute_ci_serverless_vulnerability_policy_rule" "payments" {
+  name        = "Payments - fail function builds with critical vulnerabilities"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  alert_threshold {
+    disabled = false
+    value    = 0
+  }
+  cve_rule {
+    id          = "CVE-2021-44228"
+    description = "Accepted risk"
+    effect      = "ignore"
+    expiration {
+      enabled = false
+    }
+  }
+  block_threshold {
+    enabled = true
+    value   = 9
+  }
+}
@@ -0,0 +1,18 @@
+resource "prismacloudcompute_cnns_entity" "frontend" {
+  name        = "frontend"
+  type        = "container"
+  collections = ["frontend"]
+}
+
+resource "prismacloudcompute_cnns_entity" "office" {
+  name        = "office"
+  type        = "subnet"
+  subnet      = "10.0.0.0/16"
+  description = "Office network"
+}
+
+resource "prismacloudcompute_cnns_entity" "registry" {
+  name   = "registry"
+  type   = "dns"
+  domain = "registry.example.com"
+}
@@ -0,0 +1,22 @@
+resource "prismacloudcompute_cnns_policy" "ruleset" {
+  container_enabled             = true
+  container_enforcement_enabled = false
+
+  container_rule {
+    name        = "frontend from office"
+    source      = prismacloudcompute_cnns_entity.office.name
+    destination = prismacloudcompute_cnns_entity.frontend.name
+    effect      = "allow"
+    port {
+      start = 443
+      end   = 443
+    }
+  }
+
+  container_rule {
+    name        = "frontend to registry"
+    source      = prismacloudcompute_cnns_entity.frontend.name
+    destination = prismacloudcompute_cnns_entity.registry.name
+    effect      = "alert"
+  }
+}
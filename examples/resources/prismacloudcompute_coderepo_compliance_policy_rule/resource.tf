@@ -0,0 +1,16 @@
+resource "prismacloudcompute_coderepo_compliance_policy_rule" "payments" {
+  name        = "Payments repositories - alert on AGPL licenses"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "alert"
+  license {
+    critical = ["AGPL-3.0"]
+    high     = []
+    medium   = []
+    low      = []
+    alert_threshold {
+      enabled = true
+      value   = 3
+    }
+  }
+}
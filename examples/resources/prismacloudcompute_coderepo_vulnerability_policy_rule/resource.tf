@@ -0,0 +1,10 @@
+resource "prismacloudcompute_coderepo_vulnerability_policy_rule" "payments" {
+  name        = "Payments repositories - alert on high vulnerabilities"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "alert"
+  alert_threshold {
+    disabled = false
+    value    = 7
+  }
+}
@@ -0,0 +1,10 @@
+resource "prismacloudcompute_container_compliance_policy_rule" "payments" {
+  name        = "Payments - block images running as root"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "block"
+  compliance_check {
+    block = true
+    id    = 41
+  }
+}
@@ -0,0 +1,42 @@
+resource "prismacloudcompute_container_runtime_policy_rule" "payments" {
+  name        = "Payments - block crypto miners"
+  collections = ["payments"]
+  position    = "before"
+  relative_to = "Default - alert on suspicious runtime behavior"
+  dns {
+    default_effect = "alert"
+    domain_list {
+      allowed = []
+      denied  = []
+      effect  = "disable"
+    }
+  }
+  filesystem {
+    allowed_list   = []
+    default_effect = "alert"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+  network {
+    allowed_ips    = []
+    default_effect = "alert"
+    denied_ips     = []
+    listening_ports {
+      effect = "disable"
+    }
+    outbound_ports {
+      effect = "disable"
+    }
+  }
+  processes {
+    allowed_list         = []
+    default_effect       = "alert"
+    crypto_miners_effect = "block"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+}
@@ -0,0 +1,55 @@
+resource "prismacloudcompute_container_waas_policy" "ruleset" {
+  rule {
+    name        = "Storefront"
+    collections = ["All"]
+
+    application {
+      app_id = "app-0001"
+
+      api_protection {
+        effect = "alert"
+        endpoint {
+          host          = "*"
+          base_path     = "/api"
+          internal_port = 8080
+        }
+        # YAML specifications can be converted with jsonencode(yamldecode(file("openapi.yaml"))).
+        openapi_spec = file("openapi.json")
+      }
+
+      sql_injection {
+        effect = "prevent"
+      }
+      xss {
+        effect = "prevent"
+      }
+      command_injection {
+        effect = "prevent"
+      }
+      code_injection {
+        effect = "alert"
+      }
+      attack_tools {
+        effect = "alert"
+      }
+
+      bot_protection {
+        known_bots {
+          search_engine_crawlers = "disable"
+        }
+        unknown_bots {
+          headless_browsers = "alert"
+          request_anomalies {
+            effect    = "alert"
+            threshold = 9
+          }
+        }
+      }
+
+      custom_rule {
+        id     = prismacloudcompute_custom_rule.waas.prisma_id
+        effect = "alert"
+      }
+    }
+  }
+}
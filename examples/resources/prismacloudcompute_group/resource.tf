@@ -2,3 +2,13 @@ resource "prismacloudcompute_group" "mygroup" {
   name  = "my group"
   users = ["george"]
 }
+
+# LDAP, SAML, OAuth and OpenID Connect groups need the identity provider to be
+# enabled, so they depend on its settings.
+resource "prismacloudcompute_group" "ldap" {
+  name       = "CN=Security,OU=Groups,DC=example,DC=com"
+  role       = "auditor"
+  ldap_group = true
+
+  depends_on = [prismacloudcompute_ldap_settings.ldap]
+}
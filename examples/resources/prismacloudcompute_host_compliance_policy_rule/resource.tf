@@ -0,0 +1,10 @@
+resource "prismacloudcompute_host_compliance_policy_rule" "payments" {
+  name        = "Payments hosts - alert on compliance issues"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "alert"
+  compliance_check {
+    block = false
+    id    = 41
+  }
+}
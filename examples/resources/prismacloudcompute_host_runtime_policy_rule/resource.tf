@@ -0,0 +1,26 @@
+resource "prismacloudcompute_host_runtime_policy_rule" "payments" {
+  name        = "Payments hosts - block crypto miners"
+  collections = ["payments"]
+  position    = "top"
+  activities {
+    disabled = false
+  }
+  antimalware {
+    allowed_processes = []
+    crypto_miners     = "block"
+    denied_processes {
+      effect = "alert"
+      paths  = []
+    }
+  }
+  dns {
+    allowed     = []
+    denied      = []
+    deny_effect = "disable"
+  }
+  network {
+    allowed_outbound_ips = []
+    denied_outbound_ips  = []
+    deny_effect          = "alert"
+  }
+}
@@ -0,0 +1,10 @@
+resource "prismacloudcompute_host_vulnerability_policy_rule" "payments" {
+  name        = "Payments hosts - alert on high vulnerabilities"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "alert"
+  alert_threshold {
+    disabled = false
+    value    = 7
+  }
+}
@@ -0,0 +1,14 @@
+resource "prismacloudcompute_image_vulnerability_policy_rule" "payments" {
+  name        = "Payments - block critical vulnerabilities"
+  effect      = "block"
+  collections = ["payments"]
+  position    = "top"
+  alert_threshold {
+    disabled = false
+    value    = 0
+  }
+  block_threshold {
+    enabled = true
+    value   = 9
+  }
+}
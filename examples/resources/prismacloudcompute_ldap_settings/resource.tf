@@ -0,0 +1,16 @@
+resource "prismacloudcompute_ldap_settings" "ldap" {
+  url                    = "ldaps://ldap.example.com:636"
+  search_base            = "DC=example,DC=com"
+  user                   = "CN=prisma,OU=Service Accounts,DC=example,DC=com"
+  password               = var.ldap_password
+  user_search_identifier = "sAMAccountName"
+  ca_cert                = file("ldap-ca.pem")
+}
+
+resource "prismacloudcompute_group" "security" {
+  name       = "CN=Security,OU=Groups,DC=example,DC=com"
+  role       = "auditor"
+  ldap_group = true
+
+  depends_on = [prismacloudcompute_ldap_settings.ldap]
+}
@@ -0,0 +1,14 @@
+resource "prismacloudcompute_logging_settings" "logging" {
+  console_address      = "https://console.example.com:8083"
+  include_runtime_link = true
+
+  stdout {
+    verbose_scan = true
+  }
+
+  syslog {
+    address      = "tcp://syslog.example.com:601"
+    identifier   = "prisma-cloud-compute"
+    verbose_scan = true
+  }
+}
@@ -0,0 +1,16 @@
+resource "prismacloudcompute_oauth_settings" "github" {
+  provider_name = "github"
+  client_id     = "0123456789abcdef0123"
+  client_secret = var.github_client_secret
+  auth_url      = "https://github.com/login/oauth/authorize"
+  token_url     = "https://github.com/login/oauth/access_token"
+  group_scope   = "read:org"
+}
+
+resource "prismacloudcompute_group" "security" {
+  name        = "example/security"
+  role        = "auditor"
+  oauth_group = true
+
+  depends_on = [prismacloudcompute_oauth_settings.github]
+}
@@ -0,0 +1,16 @@
+resource "prismacloudcompute_oidc_settings" "oidc" {
+  issuer_url    = "https://idp.example.com"
+  client_id     = "prisma-cloud-compute"
+  client_secret = var.oidc_client_secret
+  group_claim   = "groups"
+  group_scope   = "groups"
+  user_claim    = "email"
+}
+
+resource "prismacloudcompute_group" "security" {
+  name       = "security"
+  role       = "auditor"
+  oidc_group = true
+
+  depends_on = [prismacloudcompute_oidc_settings.oidc]
+}
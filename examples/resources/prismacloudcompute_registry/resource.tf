@@ -0,0 +1,10 @@
+resource "prismacloudcompute_registry" "ubuntu" {
+  type        = "2"
+  registry    = ""
+  os          = "linux"
+  cap         = 5
+  scanners    = 2
+  repository  = "library/ubuntu"
+  tag         = "20.04"
+  collections = ["All"]
+}
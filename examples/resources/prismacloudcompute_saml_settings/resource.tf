@@ -0,0 +1,16 @@
+resource "prismacloudcompute_saml_settings" "saml" {
+  type        = "okta"
+  url         = "https://example.okta.com/app/prisma/sso/saml"
+  issuer      = "http://www.okta.com/exk1234567890"
+  audience    = "twistlock"
+  cert        = file("okta.pem")
+  console_url = "https://console.example.com:8083"
+}
+
+resource "prismacloudcompute_group" "security" {
+  name       = "security"
+  role       = "auditor"
+  saml_group = true
+
+  depends_on = [prismacloudcompute_saml_settings.saml]
+}
@@ -0,0 +1,16 @@
+resource "prismacloudcompute_serverless_compliance_policy" "ruleset" {
+  rule {
+    name               = "Default - alert on critical and high"
+    effect             = "alert"
+    collections        = ["All"]
+    show_passed_checks = false
+    compliance_check {
+      block = false
+      id    = 434
+    }
+    compliance_check {
+      block = false
+      id    = 435
+    }
+  }
+}
@@ -0,0 +1,10 @@
+resource "prismacloudcompute_serverless_compliance_policy_rule" "payments" {
+  name        = "Payments functions - alert on compliance issues"
+  collections = ["payments"]
+  position    = "top"
+  effect      = "alert"
+  compliance_check {
+    block = false
+    id    = 434
+  }
+}
@@ -0,0 +1,45 @@
+resource "prismacloudcompute_serverless_runtime_policy" "ruleset" {
+  rule {
+    name                       = "Default - alert on suspicious runtime behavior"
+    collections                = ["All"]
+    advanced_protection_effect = "alert"
+    dns {
+      default_effect = "alert"
+      domain_list {
+        allowed = []
+        denied  = []
+        effect  = "alert"
+      }
+    }
+    filesystem {
+      allowed_list          = []
+      backdoor_files_effect = "alert"
+      default_effect        = "alert"
+      denied_list {
+        effect = "alert"
+        paths  = []
+      }
+    }
+    network {
+      allowed_ips       = []
+      default_effect    = "alert"
+      denied_ips        = []
+      denied_ips_effect = "alert"
+      listening_ports {
+        effect = "alert"
+      }
+      outbound_ports {
+        effect = "alert"
+      }
+    }
+    processes {
+      allowed_list         = []
+      crypto_miners_effect = "alert"
+      default_effect       = "alert"
+      denied_list {
+        effect = "alert"
+        paths  = []
+      }
+    }
+  }
+}
@@ -0,0 +1,40 @@
+resource "prismacloudcompute_serverless_runtime_policy_rule" "payments" {
+  name        = "Payments functions - block unexpected processes"
+  collections = ["payments"]
+  position    = "top"
+  dns {
+    default_effect = "alert"
+    domain_list {
+      allowed = []
+      denied  = []
+      effect  = "disable"
+    }
+  }
+  filesystem {
+    allowed_list   = []
+    default_effect = "alert"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+  network {
+    allowed_ips    = []
+    default_effect = "alert"
+    denied_ips     = []
+    listening_ports {
+      effect = "disable"
+    }
+    outbound_ports {
+      effect = "disable"
+    }
+  }
+  processes {
+    allowed_list   = []
+    default_effect = "block"
+    denied_list {
+      effect = "disable"
+      paths  = []
+    }
+  }
+}
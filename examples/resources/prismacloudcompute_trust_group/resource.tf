@@ -0,0 +1,11 @@
+resource "prismacloudcompute_trust_group" "internal" {
+  name   = "internal registry"
+  images = ["registry.example.com/*"]
+}
+
+resource "prismacloudcompute_trust_group" "base" {
+  name = "approved base image"
+  layers = [
+    "sha256:3e207b409db364b595ba862cdc12be96dcdad8e36c59a03b7b3b61c946a5741a",
+  ]
+}
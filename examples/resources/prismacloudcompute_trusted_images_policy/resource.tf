@@ -0,0 +1,18 @@
+resource "prismacloudcompute_trusted_images_policy" "ruleset" {
+  enabled = true
+
+  rule {
+    name           = "Production"
+    collections    = ["production"]
+    effect         = "block"
+    allowed_groups = [prismacloudcompute_trust_group.internal.name, prismacloudcompute_trust_group.base.name]
+    block_message  = "Only images from the internal registry or built on the approved base image can run in production"
+  }
+
+  rule {
+    name           = "Default"
+    collections    = ["All"]
+    effect         = "alert"
+    allowed_groups = [prismacloudcompute_trust_group.internal.name]
+  }
+}
@@ -0,0 +1,8 @@
+resource "prismacloudcompute_trusted_images_policy_rule" "payments" {
+  name           = "Payments - trusted registry"
+  collections    = ["payments"]
+  position       = "top"
+  effect         = "block"
+  allowed_groups = ["Payments registry"]
+  block_message  = "Only images from the payments registry can run"
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -54,35 +55,38 @@ type CloudScanRule struct {
 }
 
 // Get all cloud can rules
-func ListCloudScanRules(c api.Client) ([]CloudScanRule, error) {
+func ListCloudScanRules(ctx context.Context, c api.Client) ([]CloudScanRule, error) {
 	var ans []CloudScanRule
-	if err := c.Request(http.MethodGet, CloudScanRulesEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing Cloud Scan Rules: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CloudScanRulesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing Cloud Scan Rules: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific cloud scan rule
-func GetCloudScanRule(c api.Client, name string) (*CloudScanRule, error) {
+func GetCloudScanRule(ctx context.Context, c api.Client, name string) (*CloudScanRule, error) {
 	var ans []CloudScanRule
 
-	if err := c.Request(http.MethodGet, CloudScanRulesEndpoint, map[string]string{"search": name}, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error searching Cloud Scan Rules: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CloudScanRulesEndpoint, map[string]string{"search": name}, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error searching Cloud Scan Rules: %w", err)
 	}
 	for _, val := range ans {
 		if val.CredentialId == name {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("Cloud Scan Rule '%s' not found", name)
+	return nil, api.NotFoundError("Cloud Scan Rule '%s' not found", name)
 }
 
 // Create/Update cloud scan rules
-func UpdateCloudScanRule(c api.Client, rule []CloudScanRule) error {
-	return c.Request(http.MethodPut, CloudScanRulesEndpoint, nil, rule, nil)
+func UpdateCloudScanRule(ctx context.Context, c api.Client, rule []CloudScanRule) error {
+	unlock := c.Lock(CloudScanRulesEndpoint)
+	defer unlock()
+
+	return c.Request(ctx, http.MethodPut, CloudScanRulesEndpoint, nil, rule, nil)
 }
 
 // Delete an existing cloud scan rule
-func DeleteCloudScanRule(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", CloudScanRulesEndpoint, name), nil, nil, nil)
+func DeleteCloudScanRule(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", CloudScanRulesEndpoint, name), nil, nil, nil)
 }
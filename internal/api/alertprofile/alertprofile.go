@@ -1,60 +1,106 @@
 package alertprofile
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
 )
 
 const AlertprofilesEndpoint = "api/v1/alert-profiles"
 
-// type Email struct {
-// 	Enabled      bool   `json:"enabled"`
-// 	SmtpAddress  string `json:"smtpAddress"`
-// 	Port         int    `json:"port"`
-// 	CredentialId string `json:"credentialId"`
-// 	From         string `json:"from"`
-// 	Ssl          string `json:"ssl"`
-// }
-
-// type Slack struct {
-// 	Enabled    bool   `json:"enabled"`
-// 	WebhookUrl string `json:"webhookUrl"`
-// }
-
-// type Jira struct {
-// 	Enabled      bool   `json:"enabled"`
-// 	BaseUrl      string `json:"baseUrl"`
-// 	CredentialId int    `json:"credentialId"`
-// 	CaCert       string `json:"caCert"`
-// 	ProjectKey   string `json:"projectKey"`
-// 	IssueType    string `json:"issueType"`
-// 	Priority     string `json:"priority"`
-// 	Labels       string `json:"labels"`
-// 	Assignee     string `json:"assignee"`
-// }
-
-// type SecurityCenter struct {
-// 	Enabled      bool   `json:"enabled"`
-// 	CredentialId int    `json:"credentialId"`
-// 	SourceID     string `json:"sourceID"`
-// }
-
-// type GcpPubsub struct {
-// 	Enabled      bool   `json:"enabled"`
-// 	CredentialId int    `json:"credentialId"`
-// 	Topic        string `json:"topic"`
-// }
-
-// type SecurityHub struct {
-// 	Enabled      bool   `json:"enabled"`
-// 	CredentialId string `json:"credentialId"`
-// 	Region       string `json:"region"`
-// 	AccountID    string `json:"accountID"`
-// }
-
 // Alert profiles types
+type Email struct {
+	Enabled      bool     `json:"enabled"`
+	SmtpAddress  string   `json:"smtpAddress,omitempty"`
+	Port         int      `json:"port,omitempty"`
+	CredentialId string   `json:"credentialId,omitempty"`
+	From         string   `json:"from,omitempty"`
+	Ssl          bool     `json:"ssl,omitempty"`
+	Recipients   []string `json:"recipients,omitempty"`
+}
+
+type Slack struct {
+	Enabled    bool     `json:"enabled"`
+	WebhookUrl string   `json:"webhookUrl,omitempty"`
+	Channels   []string `json:"channels,omitempty"`
+	Users      []string `json:"users,omitempty"`
+}
+
+type Jira struct {
+	Enabled      bool     `json:"enabled"`
+	BaseUrl      string   `json:"baseUrl,omitempty"`
+	CredentialId string   `json:"credentialId,omitempty"`
+	CaCert       string   `json:"caCert,omitempty"`
+	ProjectKey   string   `json:"projectKey,omitempty"`
+	IssueType    string   `json:"issueType,omitempty"`
+	Priority     string   `json:"priority,omitempty"`
+	Labels       []string `json:"labels,omitempty"`
+	Assignee     string   `json:"assignee,omitempty"`
+}
+
+// Google Cloud Security Command Center
+type SecurityCenter struct {
+	Enabled      bool   `json:"enabled"`
+	CredentialId string `json:"credentialId,omitempty"`
+	SourceID     string `json:"sourceID,omitempty"`
+}
+
+type GcpPubsub struct {
+	Enabled      bool   `json:"enabled"`
+	CredentialId string `json:"credentialId,omitempty"`
+	Topic        string `json:"topic,omitempty"`
+}
+
+// AWS Security Hub
+type SecurityHub struct {
+	Enabled      bool   `json:"enabled"`
+	CredentialId string `json:"credentialId,omitempty"`
+	Region       string `json:"region,omitempty"`
+	AccountID    string `json:"accountID,omitempty"`
+}
+
+// AWS Simple Queue Service
+type Sqs struct {
+	Enabled      bool   `json:"enabled"`
+	CredentialId string `json:"credentialId,omitempty"`
+	Region       string `json:"region,omitempty"`
+	QueueUrl     string `json:"url,omitempty"`
+}
+
+type PagerDuty struct {
+	Enabled    bool        `json:"enabled"`
+	RoutingKey auth.Secret `json:"routingKey,omitempty"`
+	Summary    string      `json:"summary,omitempty"`
+	Severity   string      `json:"severity,omitempty"`
+}
+
+type Splunk struct {
+	Enabled    bool        `json:"enabled"`
+	Url        string      `json:"url,omitempty"`
+	AuthToken  auth.Secret `json:"authToken,omitempty"`
+	SourceType string      `json:"sourceType,omitempty"`
+	Json       string      `json:"json,omitempty"`
+}
+
+type ServiceNow struct {
+	Enabled      bool   `json:"enabled"`
+	Address      string `json:"address,omitempty"`
+	CredentialId string `json:"credentialId,omitempty"`
+	CaCert       string `json:"caCert,omitempty"`
+	Assignee     string `json:"assignee,omitempty"`
+}
+
+// Cortex XSOAR
+type Xsoar struct {
+	Enabled bool        `json:"enabled"`
+	Url     string      `json:"url,omitempty"`
+	ApiKey  auth.Secret `json:"apiKey,omitempty"`
+	CaCert  string      `json:"caCert,omitempty"`
+}
+
 type Webhook struct {
 	Enabled      bool   `json:"enabled"`
 	CredentialId string `json:"credentialId,omitempty"`
@@ -283,26 +329,37 @@ type Policy struct {
 
 // AlertProfile struct
 type AlertProfile struct {
-	Id                                  string  `json:"_id"`
-	Name                                string  `json:"name"`
-	VulnerabilityImmediateAlertsEnabled bool    `json:"vulnerabilityImmediateAlertsEnabled,omitempty"`
-	Owner                               string  `json:"owner,omitempty"`
-	Webhook                             Webhook `json:"webhook,omitempty"`
-	Policy                              Policy  `json:"policy,omitempty"`
+	Id                                  string         `json:"_id"`
+	Name                                string         `json:"name"`
+	VulnerabilityImmediateAlertsEnabled bool           `json:"vulnerabilityImmediateAlertsEnabled,omitempty"`
+	Owner                               string         `json:"owner,omitempty"`
+	Email                               Email          `json:"email,omitempty"`
+	GcpPubsub                           GcpPubsub      `json:"gcpPubsub,omitempty"`
+	Jira                                Jira           `json:"jira,omitempty"`
+	PagerDuty                           PagerDuty      `json:"pagerDuty,omitempty"`
+	SecurityCenter                      SecurityCenter `json:"securityCenter,omitempty"`
+	SecurityHub                         SecurityHub    `json:"securityHub,omitempty"`
+	ServiceNow                          ServiceNow     `json:"serviceNow,omitempty"`
+	Slack                               Slack          `json:"slack,omitempty"`
+	Splunk                              Splunk         `json:"splunk,omitempty"`
+	Sqs                                 Sqs            `json:"sqs,omitempty"`
+	Webhook                             Webhook        `json:"webhook,omitempty"`
+	Xsoar                               Xsoar          `json:"xsoar,omitempty"`
+	Policy                              Policy         `json:"policy,omitempty"`
 }
 
 // Get all Alertprofiles.
-func ListAlertprofiles(c api.Client) ([]AlertProfile, error) {
+func ListAlertprofiles(ctx context.Context, c api.Client) ([]AlertProfile, error) {
 	var ans []AlertProfile
-	if err := c.Request(http.MethodGet, AlertprofilesEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing Alert Profiles: %s", err)
+	if err := c.Request(ctx, http.MethodGet, AlertprofilesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing Alert Profiles: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific Alertprofile.
-func GetAlertprofile(c api.Client, name string) (*AlertProfile, error) {
-	Alertprofiles, err := ListAlertprofiles(c)
+func GetAlertprofile(ctx context.Context, c api.Client, name string) (*AlertProfile, error) {
+	Alertprofiles, err := ListAlertprofiles(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -311,20 +368,20 @@ func GetAlertprofile(c api.Client, name string) (*AlertProfile, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("error Alert Profile '%s' not found", name)
+	return nil, api.NotFoundError("Alert Profile '%s' not found", name)
 }
 
 // Create a new Alertprofile.
-func CreateAlertprofile(c api.Client, Alertprofile AlertProfile) error {
-	return c.Request(http.MethodPost, AlertprofilesEndpoint, nil, Alertprofile, nil)
+func CreateAlertprofile(ctx context.Context, c api.Client, Alertprofile AlertProfile) error {
+	return c.Request(ctx, http.MethodPost, AlertprofilesEndpoint, nil, Alertprofile, nil)
 }
 
 // Update an existing Alertprofile.
-func UpdateAlertprofile(c api.Client, Alertprofile AlertProfile) error {
-	return c.Request(http.MethodPost, AlertprofilesEndpoint, nil, Alertprofile, nil)
+func UpdateAlertprofile(ctx context.Context, c api.Client, Alertprofile AlertProfile) error {
+	return c.Request(ctx, http.MethodPost, AlertprofilesEndpoint, nil, Alertprofile, nil)
 }
 
 // Delete an existing Alertprofile.
-func DeleteAlertprofile(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", AlertprofilesEndpoint, name), nil, nil, nil)
+func DeleteAlertprofile(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", AlertprofilesEndpoint, name), nil, nil, nil)
 }
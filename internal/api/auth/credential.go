@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -44,17 +45,17 @@ type TemporaryToken struct {
 }
 
 // Get all credentials.
-func ListCredentials(c api.Client) ([]Credential, error) {
+func ListCredentials(ctx context.Context, c api.Client) ([]Credential, error) {
 	var ans []Credential
-	if err := c.Request(http.MethodGet, CredentialsEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing credentials: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CredentialsEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing credentials: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific credential.
-func GetCredential(c api.Client, name string) (*Credential, error) {
-	credentials, err := ListCredentials(c)
+func GetCredential(ctx context.Context, c api.Client, name string) (*Credential, error) {
+	credentials, err := ListCredentials(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -63,15 +64,15 @@ func GetCredential(c api.Client, name string) (*Credential, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("credential '%s' not found", name)
+	return nil, api.NotFoundError("credential '%s' not found", name)
 }
 
 // Create a new or update an existing credential.
-func UpdateCredential(c api.Client, credential Credential) error {
-	return c.Request(http.MethodPost, CredentialsEndpoint, nil, credential, nil)
+func UpdateCredential(ctx context.Context, c api.Client, credential Credential) error {
+	return c.Request(ctx, http.MethodPost, CredentialsEndpoint, nil, credential, nil)
 }
 
 // Delete an existing credential.
-func DeleteCredential(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", CredentialsEndpoint, name), nil, nil, nil)
+func DeleteCredential(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", CredentialsEndpoint, name), nil, nil, nil)
 }
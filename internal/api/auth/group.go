@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -31,17 +32,17 @@ type GroupUser struct {
 }
 
 // Get all groups.
-func ListGroups(c api.Client) ([]Group, error) {
+func ListGroups(ctx context.Context, c api.Client) ([]Group, error) {
 	var ans []Group
-	if err := c.Request(http.MethodGet, GroupsEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing groups: %s", err)
+	if err := c.Request(ctx, http.MethodGet, GroupsEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing groups: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific group.
-func GetGroup(c api.Client, name string) (*Group, error) {
-	groups, err := ListGroups(c)
+func GetGroup(ctx context.Context, c api.Client, name string) (*Group, error) {
+	groups, err := ListGroups(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -50,20 +51,20 @@ func GetGroup(c api.Client, name string) (*Group, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("group '%s' not found", name)
+	return nil, api.NotFoundError("group '%s' not found", name)
 }
 
 // Create a new group.
-func CreateGroup(c api.Client, group Group) error {
-	return c.Request(http.MethodPost, GroupsEndpoint, nil, group, nil)
+func CreateGroup(ctx context.Context, c api.Client, group Group) error {
+	return c.Request(ctx, http.MethodPost, GroupsEndpoint, nil, group, nil)
 }
 
 // Update an existing group.
-func UpdateGroup(c api.Client, group Group) error {
-	return c.Request(http.MethodPut, fmt.Sprintf("%s/%s", GroupsEndpoint, group.Name), nil, group, nil)
+func UpdateGroup(ctx context.Context, c api.Client, group Group) error {
+	return c.Request(ctx, http.MethodPut, fmt.Sprintf("%s/%s", GroupsEndpoint, group.Name), nil, group, nil)
 }
 
 // Delete an existing group.
-func DeleteGroup(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", GroupsEndpoint, name), nil, nil, nil)
+func DeleteGroup(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", GroupsEndpoint, name), nil, nil, nil)
 }
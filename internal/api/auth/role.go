@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -21,17 +22,17 @@ type RolePermission struct {
 }
 
 // Get all roles.
-func ListRoles(c api.Client) ([]Role, error) {
+func ListRoles(ctx context.Context, c api.Client) ([]Role, error) {
 	var ans []Role
-	if err := c.Request(http.MethodGet, RolesEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing roles: %s", err)
+	if err := c.Request(ctx, http.MethodGet, RolesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing roles: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific role.
-func GetRole(c api.Client, name string) (*Role, error) {
-	roles, err := ListRoles(c)
+func GetRole(ctx context.Context, c api.Client, name string) (*Role, error) {
+	roles, err := ListRoles(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -40,20 +41,20 @@ func GetRole(c api.Client, name string) (*Role, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("role '%s' not found", name)
+	return nil, api.NotFoundError("role '%s' not found", name)
 }
 
 // Create a new role.
-func CreateRole(c api.Client, role Role) error {
-	return c.Request(http.MethodPost, RolesEndpoint, nil, role, nil)
+func CreateRole(ctx context.Context, c api.Client, role Role) error {
+	return c.Request(ctx, http.MethodPost, RolesEndpoint, nil, role, nil)
 }
 
 // Update an existing role.
-func UpdateRole(c api.Client, role Role) error {
-	return c.Request(http.MethodPut, RolesEndpoint, nil, role, nil)
+func UpdateRole(ctx context.Context, c api.Client, role Role) error {
+	return c.Request(ctx, http.MethodPut, RolesEndpoint, nil, role, nil)
 }
 
 // Delete an existing role.
-func DeleteRole(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", RolesEndpoint, name), nil, nil, nil)
+func DeleteRole(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", RolesEndpoint, name), nil, nil, nil)
 }
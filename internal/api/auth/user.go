@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -23,17 +24,17 @@ type UserPermission struct {
 }
 
 // Get all users.
-func ListUsers(c api.Client) ([]User, error) {
+func ListUsers(ctx context.Context, c api.Client) ([]User, error) {
 	var ans []User
-	if err := c.Request(http.MethodGet, UsersEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing users: %s", err)
+	if err := c.Request(ctx, http.MethodGet, UsersEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing users: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific user.
-func GetUser(c api.Client, name string) (*User, error) {
-	users, err := ListUsers(c)
+func GetUser(ctx context.Context, c api.Client, name string) (*User, error) {
+	users, err := ListUsers(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -42,20 +43,20 @@ func GetUser(c api.Client, name string) (*User, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("user '%s' not found", name)
+	return nil, api.NotFoundError("user '%s' not found", name)
 }
 
 // Create a new user.
-func CreateUser(c api.Client, user User) error {
-	return c.Request(http.MethodPost, UsersEndpoint, nil, user, nil)
+func CreateUser(ctx context.Context, c api.Client, user User) error {
+	return c.Request(ctx, http.MethodPost, UsersEndpoint, nil, user, nil)
 }
 
 // Update an existing user.
-func UpdateUser(c api.Client, user User) error {
-	return c.Request(http.MethodPut, UsersEndpoint, nil, user, nil)
+func UpdateUser(ctx context.Context, c api.Client, user User) error {
+	return c.Request(ctx, http.MethodPut, UsersEndpoint, nil, user, nil)
 }
 
 // Delete an existing user.
-func DeleteUser(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", UsersEndpoint, name), nil, nil, nil)
+func DeleteUser(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", UsersEndpoint, name), nil, nil, nil)
 }
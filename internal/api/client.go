@@ -2,7 +2,8 @@ package api
 
 import (
 	"bytes"
-	"crypto/tls"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,15 +11,50 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
+	"sync"
 	"time"
 )
 
+const authenticateEndpoint = "api/v1/authenticate"
+
+// Tokens are refreshed this long before they expire so that a request is
+// never sent with a token that expires in flight.
+const tokenRefreshWindow = time.Minute
+
 type APIClientConfig struct {
 	ConsoleURL           string `json:"console_url"`
 	Project              string `json:"project"`
 	Username             string `json:"username"`
 	Password             string `json:"password"`
 	SkipCertVerification bool   `json:"skip_cert_verification"`
+
+	// Additional CAs to trust, as a path to a PEM file or as PEM.
+	CACertFile string `json:"ca_cert_file"`
+	CACertPEM  string `json:"ca_cert_pem"`
+	// Client certificate and key for mutual TLS, each as PEM or a path to a PEM file.
+	ClientCert string `json:"client_cert"`
+	ClientKey  string `json:"client_key"`
+
+	// Proxy for requests to the Console. When unset, the HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables are used.
+	ProxyURL string `json:"proxy_url"`
+	// Comma-separated hosts, domains and CIDRs reached without the proxy.
+	NoProxy       string `json:"no_proxy"`
+	ProxyUsername string `json:"proxy_username"`
+	ProxyPassword string `json:"proxy_password"`
+
+	// Prisma Cloud access key, used in place of a username and password.
+	AccessKeyID string `json:"access_key_id"`
+	SecretKey   string `json:"secret_key"`
+	// A token issued outside the provider. It is used as is and cannot be
+	// renewed once it expires. Takes precedence over all other credentials.
+	Token string `json:"token"`
+
+	// Number of times a failed request is retried. Zero disables retries.
+	MaxRetries int `json:"max_retries"`
+	// Longest wait between retries in seconds. Zero uses DefaultRetryMaxWait.
+	RetryMaxWait int `json:"retry_max_wait"`
 }
 
 // A connection to Prisma Cloud Compute.
@@ -26,13 +62,25 @@ type Client struct {
 	Config     APIClientConfig
 	HTTPClient *http.Client
 	JWT        string
+
+	// Shared by all copies of the client so that a refreshed token is
+	// visible to every resource operation.
+	session *session
+}
+
+// The current token and its expiry. The mutex is held while
+// re-authenticating so that concurrent requests share a single refresh.
+type session struct {
+	mu      sync.Mutex
+	token   string
+	expires time.Time
 }
 
 type ErrResponse struct {
 	Err string
 }
 
-func (c *Client) Initialize(filename string) error {
+func (c *Client) Initialize(ctx context.Context, filename string) error {
 	c2 := Client{}
 
 	if filename != "" {
@@ -68,37 +116,76 @@ func (c *Client) Initialize(filename string) error {
 		c.Config.Password = c2.Config.Password
 	}
 
-	c.HTTPClient = &http.Client{}
+	if c.Config.AccessKeyID == "" && c2.Config.AccessKeyID != "" {
+		c.Config.AccessKeyID = c2.Config.AccessKeyID
+	}
+
+	if c.Config.SecretKey == "" && c2.Config.SecretKey != "" {
+		c.Config.SecretKey = c2.Config.SecretKey
+	}
+
+	if c.Config.Token == "" && c2.Config.Token != "" {
+		c.Config.Token = c2.Config.Token
+	}
+
+	httpClient, err := newHTTPClient(c.Config)
+	if err != nil {
+		return err
+	}
+	c.HTTPClient = httpClient
 
-	return c.Authenticate()
+	return c.Authenticate(ctx)
 }
 
 // Communicate with the Prisma Cloud Compute API.
-func (c *Client) Request(method, endpoint string, query, data, response interface{}) (err error) {
-	parsedURL, err := url.Parse(c.Config.ConsoleURL)
+// If the Console rejects the token, the client re-authenticates and replays
+// the request once.
+func (c *Client) Request(ctx context.Context, method, endpoint string, query, data, response interface{}) (err error) {
+	token, err := c.token(ctx)
 	if err != nil {
 		return err
 	}
+
+	res, err := c.doWithRetries(ctx, method, endpoint, query, data, response, token)
+	if res != nil && res.StatusCode == http.StatusUnauthorized && c.session != nil {
+		if token, err = c.reauthenticate(ctx, token); err != nil {
+			return err
+		}
+		_, err = c.doWithRetries(ctx, method, endpoint, query, data, response, token)
+	}
+	return err
+}
+
+// Send a single request with the given token. The response is returned with
+// its body already consumed so that callers can inspect the status and headers.
+func (c *Client) do(ctx context.Context, method, endpoint string, query, data, response interface{}, token string) (*http.Response, error) {
+	parsedURL, err := url.Parse(c.Config.ConsoleURL)
+	if err != nil {
+		return nil, err
+	}
 	if parsedURL.Scheme == "" {
 		parsedURL.Scheme = "https"
 	}
 	parsedURL.Path = path.Join(parsedURL.Path, endpoint)
 
 	var buf bytes.Buffer
+	var data_json []byte
 
 	if data != nil {
-		data_json, err := json.Marshal(data)
+		data_json, err = json.Marshal(data)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		buf = *bytes.NewBuffer(data_json)
 	}
 
-	req, err := http.NewRequest(method, parsedURL.String(), &buf)
+	req, err := http.NewRequestWithContext(ctx, method, parsedURL.String(), &buf)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
-	req.Header.Set("Authorization", "Bearer "+c.JWT)
 	req.Header.Set("Content-Type", "application/json")
 
 	// TODO: simplify logic
@@ -123,48 +210,111 @@ func (c *Client) Request(method, endpoint string, query, data, response interfac
 		req.URL.RawQuery = queryParams.Encode()
 	}
 
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		logRequest(ctx, req, data_json, nil, nil, time.Since(start), err)
+		return nil, certificateError(err)
 	}
 	defer res.Body.Close()
 
-	// Retry in case backend responds with HTTP 429
-	// sleep for 3 seconds before retry
-	if res.StatusCode == 429 {
-		time.Sleep(3 * time.Second)
-		return c.Request(method, endpoint, query, data, &response)
+	body, err := io.ReadAll(res.Body)
+	// Files such as Defender deployment manifests hold credentials, so they
+	// are not logged.
+	loggedBody := body
+	if _, ok := response.(*[]byte); ok && res.StatusCode == http.StatusOK {
+		loggedBody = nil
 	}
+	logRequest(ctx, req, data_json, res, loggedBody, time.Since(start), nil)
 
 	if res.StatusCode != http.StatusOK {
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			return fmt.Errorf("Error reading response body from non-OK response: %s", err)
-		}
+		// A partial body still helps to explain the error, so read errors are ignored.
+		return res, newError(method, endpoint, res, body)
+	}
+	if err != nil {
+		return res, err
+	}
 
-		var response ErrResponse
-		if err = json.Unmarshal(body, &response); err != nil {
-			return err
+	// Responses that are not JSON, such as files, are returned as they are.
+	if raw, ok := response.(*[]byte); ok {
+		*raw = body
+		return res, nil
+	}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, response); err != nil {
+			return res, err
 		}
+	}
+	return res, nil
+}
 
-		return fmt.Errorf("Non-OK status: %d (%s)", res.StatusCode, response.Err)
+// Get the token for the next request, refreshing it first if it is about
+// to expire.
+func (c *Client) token(ctx context.Context) (string, error) {
+	if c.session == nil {
+		return c.JWT, nil
 	}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.Config.Token == "" && !c.session.expires.IsZero() && time.Until(c.session.expires) < tokenRefreshWindow {
+		if err := c.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.session.token, nil
+}
+
+// Replace a token the Console rejected. If a concurrent request has already
+// replaced it, the new token is used without authenticating again.
+func (c *Client) reauthenticate(ctx context.Context, rejected string) (string, error) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.session.token == rejected {
+		if err := c.refresh(ctx); err != nil {
+			return "", err
+		}
 	}
+	return c.session.token, nil
+}
 
-	if len(body) > 0 {
-		if err = json.Unmarshal(body, response); err != nil {
+// Authenticate and store the new token in the session.
+// The caller must hold the session lock.
+func (c *Client) refresh(ctx context.Context) error {
+	var token string
+	if c.Config.Token != "" {
+		if c.session.token != "" {
+			return fmt.Errorf("the Console rejected the configured token, which cannot be renewed")
+		}
+		token = c.Config.Token
+	} else {
+		var err error
+		if token, err = c.authenticate(ctx); err != nil {
 			return err
 		}
 	}
+	c.JWT = token
+	c.session.token = token
+	c.session.expires = tokenExpiry(token)
 	return nil
 }
 
 // Authenticate with the Prisma Cloud Compute Console.
-func (c *Client) Authenticate() (err error) {
+func (c *Client) Authenticate(ctx context.Context) (err error) {
+	if c.session == nil {
+		c.session = &session{}
+	}
+
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	return c.refresh(ctx)
+}
+
+// Exchange the configured access key or username and password for a token.
+func (c *Client) authenticate(ctx context.Context) (string, error) {
 
 	type AuthRequest struct {
 		Username string `json:"username"`
@@ -175,33 +325,56 @@ func (c *Client) Authenticate() (err error) {
 		Token string `json:"token"`
 	}
 
+	req := AuthRequest{c.Config.Username, c.Config.Password}
+	if c.Config.AccessKeyID != "" || c.Config.SecretKey != "" {
+		if c.Config.AccessKeyID == "" || c.Config.SecretKey == "" {
+			return "", fmt.Errorf("both an access key ID and a secret key are required to authenticate with an access key")
+		}
+		// The Console accepts access keys in place of a username and password.
+		req = AuthRequest{c.Config.AccessKeyID, c.Config.SecretKey}
+	}
+
 	res := AuthResponse{}
-	if err := c.Request(http.MethodPost, "/api/v1/authenticate", nil, AuthRequest{c.Config.Username, c.Config.Password}, &res); err != nil {
-		return fmt.Errorf("error POSTing to authenticate endpoint: %v", err)
+	if _, err := c.doWithRetries(ctx, http.MethodPost, authenticateEndpoint, nil, req, &res, ""); err != nil {
+		return "", fmt.Errorf("error POSTing to authenticate endpoint: %w", err)
 	}
-	c.JWT = res.Token
-	return nil
+	return res.Token, nil
+}
+
+// Get the expiry from the exp claim of a JWT. The zero time is returned if
+// the token has no readable exp claim, in which case the token is only
+// replaced after the Console rejects it.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
 }
 
 // Create Client and authenticate.
-func APIClient(config APIClientConfig) (*Client, error) {
+func APIClient(ctx context.Context, config APIClientConfig) (*Client, error) {
 	apiClient := &Client{
 		Config: config,
 	}
 
-	if config.SkipCertVerification {
-		apiClient.HTTPClient = &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: true,
-				},
-			},
-		}
-	} else {
-		apiClient.HTTPClient = &http.Client{}
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
 	}
+	apiClient.HTTPClient = httpClient
 
-	if err := apiClient.Authenticate(); err != nil {
+	if err := apiClient.Authenticate(ctx); err != nil {
 		return nil, err
 	}
 
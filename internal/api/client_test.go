@@ -1,33 +1,299 @@
 package api
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"os"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
 )
 
 func TestAPIClient(t *testing.T) {
-	credsFile, err := os.Open("examples/creds.json")
-	if err != nil {
-		fmt.Printf("error opening creds file: %v", err)
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	config := APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
 	}
-	defer credsFile.Close()
 
-	fileContent, err := io.ReadAll(credsFile)
+	client, err := APIClient(context.Background(), config)
 	if err != nil {
-		fmt.Printf("error reading creds file: %v", err)
-		return
+		t.Fatalf("error creating client: %s", err)
+	}
+	if client.JWT == "" {
+		t.Errorf("JWT is empty. Authenticate did not work.")
 	}
-	var config APIClientConfig
-	if err := json.Unmarshal(fileContent, &config); err != nil {
-		fmt.Printf("error unmarshalling creds file: %v", err)
-		return
+}
+
+func TestAPIClientInvalidCredentials(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	config := APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   "wrong",
 	}
 
-	client, _ := APIClient(config)
+	if _, err := APIClient(context.Background(), config); err == nil {
+		t.Errorf("expected authentication with invalid credentials to fail")
+	}
+}
+
+func TestAPIClientAccessKey(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL:  console.URL,
+		AccessKeyID: console.AccessKeyID,
+		SecretKey:   console.SecretKey,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
 	if client.JWT == "" {
 		t.Errorf("JWT is empty. Authenticate did not work.")
 	}
 }
+
+func TestAPIClientAccessKeyIncomplete(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	config := APIClientConfig{
+		ConsoleURL:  console.URL,
+		AccessKeyID: console.AccessKeyID,
+	}
+
+	if _, err := APIClient(context.Background(), config); err == nil {
+		t.Errorf("expected authentication without a secret key to fail")
+	}
+	if n := console.Authentications(); n != 0 {
+		t.Errorf("authenticated %d times, expected 0", n)
+	}
+}
+
+func TestAPIClientToken(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	token := console.IssueToken()
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+		Token:      token,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	if client.JWT != token {
+		t.Errorf("JWT is %q, expected the configured token", client.JWT)
+	}
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+	if n := console.Authentications(); n != 0 {
+		t.Errorf("authenticated %d times, expected the token to take precedence", n)
+	}
+}
+
+func TestRequestTokenRejected(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Token:      console.IssueToken(),
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	console.ExpireTokens()
+
+	var ans []map[string]interface{}
+	err = client.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans)
+	if err == nil || !strings.Contains(err.Error(), "cannot be renewed") {
+		t.Errorf("expected an error for the rejected token, got %v", err)
+	}
+}
+
+func TestRequestUnauthorized(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client := Client{
+		Config:     APIClientConfig{ConsoleURL: console.URL},
+		HTTPClient: console.Client(),
+	}
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans); err == nil {
+		t.Errorf("expected unauthenticated request to fail")
+	}
+}
+
+func TestRequestReauthenticatesOnUnauthorized(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	console.ExpireTokens()
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans); err != nil {
+		t.Fatalf("expected request with an expired token to be replayed: %s", err)
+	}
+	if n := console.Authentications(); n != 2 {
+		t.Errorf("authenticated %d times, expected 2", n)
+	}
+}
+
+func TestRequestRefreshesExpiringToken(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+	console.TokenLifetime = tokenRefreshWindow / 2
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	first := client.JWT
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+	if n := console.Authentications(); n != 2 {
+		t.Errorf("authenticated %d times, expected 2", n)
+	}
+	if client.JWT == first {
+		t.Errorf("token was not refreshed before it expired")
+	}
+}
+
+func TestConcurrentRequestsShareRefresh(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	console.ExpireTokens()
+
+	// Resources use copies of the client, so refreshes must be shared
+	// between copies.
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(c Client) {
+			defer wg.Done()
+			var ans []map[string]interface{}
+			errs <- c.Request(context.Background(), "GET", "api/v1/collections", nil, nil, &ans)
+		}(*client)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("error in request: %s", err)
+		}
+	}
+	if n := console.Authentications(); n != 2 {
+		t.Errorf("authenticated %d times, expected 2", n)
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	cases := map[string]time.Time{
+		"":                             {},
+		"opaque":                       {},
+		"a.not-base64!.c":              {},
+		"a.eyJmb28iOiJiYXIifQ.c":       {},
+		"a.eyJleHAiOjE2NDQwMDAwMDB9.c": time.Unix(1644000000, 0),
+	}
+	for token, expected := range cases {
+		if actual := tokenExpiry(token); !actual.Equal(expected) {
+			t.Errorf("tokenExpiry(%q) = %s, expected %s", token, actual, expected)
+		}
+	}
+}
+
+func TestRequestCanceled(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := Client{
+		Config:     APIClientConfig{ConsoleURL: server.URL},
+		HTTPClient: server.Client(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var ans []map[string]interface{}
+	err := client.Request(ctx, http.MethodGet, "api/v1/collections", nil, nil, &ans)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected request to be canceled, got %v", err)
+	}
+}
+
+func TestRequestRawResponse(t *testing.T) {
+	const manifest = "apiVersion: v1\nkind: Namespace\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-yaml")
+		w.Write([]byte(manifest))
+	}))
+	defer server.Close()
+
+	client := Client{
+		Config:     APIClientConfig{ConsoleURL: server.URL},
+		HTTPClient: server.Client(),
+	}
+
+	var ans []byte
+	if err := client.Request(context.Background(), http.MethodPost, "api/v1/defenders/daemonset.yaml", nil, nil, &ans); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+	if string(ans) != manifest {
+		t.Errorf("got %q, expected %q", ans, manifest)
+	}
+}
@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -23,20 +24,22 @@ type Collection struct {
 	Labels      []string `json:"labels,omitempty"`
 	Name        string   `json:"name,omitempty"`
 	Namespaces  []string `json:"namespaces,omitempty"`
+	Prisma      bool     `json:"prisma,omitempty"`
+	System      bool     `json:"system,omitempty"`
 }
 
 // Get all collections.
-func ListCollections(c api.Client) ([]Collection, error) {
+func ListCollections(ctx context.Context, c api.Client) ([]Collection, error) {
 	var ans []Collection
-	if err := c.Request(http.MethodGet, CollectionsEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing collections: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CollectionsEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing collections: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific collection.
-func GetCollection(c api.Client, name string) (*Collection, error) {
-	collections, err := ListCollections(c)
+func GetCollection(ctx context.Context, c api.Client, name string) (*Collection, error) {
+	collections, err := ListCollections(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -45,20 +48,20 @@ func GetCollection(c api.Client, name string) (*Collection, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("collection '%s' not found", name)
+	return nil, api.NotFoundError("collection '%s' not found", name)
 }
 
 // Create a new collection.
-func CreateCollection(c api.Client, collection Collection) error {
-	return c.Request(http.MethodPost, CollectionsEndpoint, nil, collection, nil)
+func CreateCollection(ctx context.Context, c api.Client, collection Collection) error {
+	return c.Request(ctx, http.MethodPost, CollectionsEndpoint, nil, collection, nil)
 }
 
 // Update an existing collection.
-func UpdateCollection(c api.Client, collection Collection) error {
-	return c.Request(http.MethodPut, fmt.Sprintf("%s/%s", CollectionsEndpoint, collection.Name), nil, collection, nil)
+func UpdateCollection(ctx context.Context, c api.Client, collection Collection) error {
+	return c.Request(ctx, http.MethodPut, fmt.Sprintf("%s/%s", CollectionsEndpoint, collection.Name), nil, collection, nil)
 }
 
 // Delete an existing collection.
-func DeleteCollection(c api.Client, name string) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%s", CollectionsEndpoint, name), nil, nil, nil)
+func DeleteCollection(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", CollectionsEndpoint, name), nil, nil, nil)
 }
@@ -0,0 +1,548 @@
+// Package consoletest provides an in-process Prisma Cloud Compute Console
+// for exercising the SDK and the provider without a live Console.
+//
+// The server keeps its state in memory and implements the subset of the
+// Console API used by the SDK: authentication, collections, policies,
+// users, groups, roles, credentials, custom rules, custom compliance,
+// registry, logging and identity provider settings, alert profiles, cloud
+// scan rules, network entities, trust groups, the conversion of OpenAPI
+// specifications for WAAS policies and the files that deploy Defenders.
+package consoletest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultUsername      = "admin"
+	DefaultPassword      = "consoletest"
+	DefaultAccessKeyID   = "consoletest-access-key"
+	DefaultSecretKey     = "consoletest-secret-key"
+	DefaultTokenLifetime = time.Hour
+
+	apiPrefix = "/api/v1/"
+)
+
+// A fake Prisma Cloud Compute Console.
+type Server struct {
+	*httptest.Server
+
+	Username string
+	Password string
+
+	// An access key accepted in place of the username and password.
+	AccessKeyID string
+	SecretKey   string
+
+	// How long issued tokens are valid. Changes apply to tokens issued afterwards.
+	TokenLifetime time.Duration
+
+	mu              sync.Mutex
+	authentications int
+	tokens          map[string]time.Time
+	documents       map[string]map[string]interface{}
+	lists           map[string]*list
+}
+
+// An ordered list of objects identified by a key field.
+type list struct {
+	key   string
+	items []map[string]interface{}
+}
+
+// Start a new Console with the default credentials and seeded state.
+func NewServer() *Server {
+	s := NewUnstartedServer()
+	s.Start()
+	return s
+}
+
+// Create a new Console without starting it, so that its TLS configuration
+// can be changed before calling Start or StartTLS.
+func NewUnstartedServer() *Server {
+	s := &Server{
+		Username:      DefaultUsername,
+		Password:      DefaultPassword,
+		AccessKeyID:   DefaultAccessKeyID,
+		SecretKey:     DefaultSecretKey,
+		TokenLifetime: DefaultTokenLifetime,
+		tokens:        make(map[string]time.Time),
+		documents:     make(map[string]map[string]interface{}),
+		lists: map[string]*list{
+			"alert-profiles":                     {key: "name"},
+			"cloud-scan-rules":                   {key: "credentialId"},
+			"collections":                        {key: "name"},
+			"credentials":                        {key: "_id"},
+			"custom-compliance":                  {key: "_id"},
+			"custom-rules":                       {key: "_id"},
+			"groups":                             {key: "groupName"},
+			"policies/firewall/network/entities": {key: "name"},
+			"rbac/roles":                         {key: "name"},
+			"trust/groups":                       {key: "_id"},
+			"users":                              {key: "username"},
+		},
+	}
+	s.lists["collections"].items = append(s.lists["collections"].items, map[string]interface{}{
+		"accountIDs":  []interface{}{"*"},
+		"appIDs":      []interface{}{"*"},
+		"clusters":    []interface{}{"*"},
+		"codeRepos":   []interface{}{"*"},
+		"color":       "#3FA2F7",
+		"containers":  []interface{}{"*"},
+		"description": "System - all resources collection",
+		"functions":   []interface{}{"*"},
+		"hosts":       []interface{}{"*"},
+		"images":      []interface{}{"*"},
+		"labels":      []interface{}{"*"},
+		"name":        "All",
+		"namespaces":  []interface{}{"*"},
+		"system":      true,
+	})
+	s.documents["settings/registry"] = map[string]interface{}{
+		"specifications": []interface{}{},
+	}
+	s.documents["settings/logging"] = map[string]interface{}{
+		"consoleAddress":          "",
+		"enableMetricsCollection": false,
+		"includeRuntimeLink":      false,
+		"stdout":                  map[string]interface{}{"allProcEvents": false, "enabled": false, "verboseScan": false},
+		"syslog":                  map[string]interface{}{"addr": "", "allProcEvents": false, "cert": "", "enabled": true, "id": "", "verboseScan": false},
+	}
+	for _, endpoint := range []string{"settings/ldap", "settings/oauth", "settings/oidc", "settings/saml"} {
+		s.documents[endpoint] = map[string]interface{}{"enabled": false}
+	}
+	s.Server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Serialize an error the same way the Console does.
+func writeError(w http.ResponseWriter, status int, format string, a ...interface{}) {
+	writeJSON(w, status, map[string]string{"err": fmt.Sprintf(format, a...)})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// Issue a JWT with an exp claim. The Console does not expect clients to
+// verify the signature, so it is random.
+func newToken(username string, expires time.Time) string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{"username": username, "exp": expires.Unix()})
+	return strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(claims),
+		base64.RawURLEncoding.EncodeToString(b),
+	}, ".")
+}
+
+// Number of successful authentications so far.
+func (s *Server) Authentications() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.authentications
+}
+
+// Issue a token without authenticating, as if it had been obtained outside
+// the client.
+func (s *Server) IssueToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expires := time.Now().Add(s.TokenLifetime)
+	token := newToken(s.Username, expires)
+	s.tokens[token] = expires
+	return token
+}
+
+// Invalidate all issued tokens, as if they had expired.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]time.Time)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		writeError(w, http.StatusNotFound, "unknown path %s", r.URL.Path)
+		return
+	}
+	endpoint := strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/")
+
+	var body interface{}
+	if b, err := io.ReadAll(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body: %s", err)
+		return
+	} else if len(b) > 0 {
+		if err := json.Unmarshal(b, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: %s", err)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if endpoint == "authenticate" {
+		s.authenticate(w, r, body)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if expires, ok := s.tokens[token]; !ok || time.Now().After(expires) {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	switch {
+	case endpoint == "policies/firewall/app/apispec":
+		s.importAPISpec(w, r, body)
+	case strings.HasPrefix(endpoint, "policies/firewall/network/entities"):
+		s.serveList(w, r, endpoint, body)
+	case strings.HasPrefix(endpoint, "policies/"), endpoint == "trust/policy":
+		s.serveDocument(w, r, endpoint, body, true)
+	case strings.HasPrefix(endpoint, "defenders/"), strings.HasPrefix(endpoint, "scripts/"):
+		s.serveDefenderDeployment(w, r, endpoint, body)
+	case strings.HasPrefix(endpoint, "settings/"):
+		s.serveDocument(w, r, endpoint, body, false)
+	default:
+		s.serveList(w, r, endpoint, body)
+	}
+}
+
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request, body interface{}) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+		return
+	}
+	req, _ := body.(map[string]interface{})
+	userAuth := req["username"] == s.Username && req["password"] == s.Password
+	keyAuth := s.AccessKeyID != "" && req["username"] == s.AccessKeyID && req["password"] == s.SecretKey
+	if !userAuth && !keyAuth {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+	expires := time.Now().Add(s.TokenLifetime)
+	token := newToken(s.Username, expires)
+	s.tokens[token] = expires
+	s.authentications++
+	writeJSON(w, http.StatusOK, map[string]string{"token": token})
+}
+
+// Secrets of the settings documents, which are encrypted like the secrets of
+// credentials.
+var documentSecrets = map[string][]string{
+	"settings/ldap":  {"password"},
+	"settings/oauth": {"clientSecret"},
+	"settings/oidc":  {"clientSecret"},
+	"settings/saml":  {"appSecret"},
+}
+
+// Serve a singleton document such as a policy or a settings page.
+// Policies additionally accept POST to add a single rule on top of the policy.
+func (s *Server) serveDocument(w http.ResponseWriter, r *http.Request, endpoint string, body interface{}, policy bool) {
+	doc, ok := s.documents[endpoint]
+	if !ok {
+		doc = map[string]interface{}{}
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, doc)
+	case http.MethodPut:
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			writeError(w, http.StatusBadRequest, "expected a JSON object")
+			return
+		}
+		encryptSecrets(obj, documentSecrets[endpoint]...)
+		s.documents[endpoint] = obj
+		writeJSON(w, http.StatusOK, nil)
+	case http.MethodPost:
+		obj, ok := body.(map[string]interface{})
+		if !ok {
+			writeError(w, http.StatusBadRequest, "expected a JSON object")
+			return
+		}
+		field := "specifications"
+		if policy {
+			field = "rules"
+		}
+		items, _ := doc[field].([]interface{})
+		if policy {
+			doc[field] = append([]interface{}{obj}, items...)
+		} else {
+			doc[field] = append(items, obj)
+		}
+		s.documents[endpoint] = doc
+		writeJSON(w, http.StatusOK, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+	}
+}
+
+// Convert an OpenAPI specification into a WAAS API specification. Only the
+// title, paths, methods and parameters are converted.
+func (s *Server) importAPISpec(w http.ResponseWriter, r *http.Request, body interface{}) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+		return
+	}
+	spec, _ := body.(map[string]interface{})
+	specPaths, ok := spec["paths"].(map[string]interface{})
+	if !ok {
+		writeError(w, http.StatusBadRequest, "failed to parse OpenAPI specification: no paths")
+		return
+	}
+	info, _ := spec["info"].(map[string]interface{})
+
+	paths := make([]interface{}, 0, len(specPaths))
+	for _, path := range sortedKeys(specPaths) {
+		ops, _ := specPaths[path].(map[string]interface{})
+		methods := make([]interface{}, 0, len(ops))
+		for _, method := range sortedKeys(ops) {
+			op, _ := ops[method].(map[string]interface{})
+			specParams, _ := op["parameters"].([]interface{})
+			params := make([]interface{}, 0, len(specParams))
+			for _, val := range specParams {
+				param, _ := val.(map[string]interface{})
+				paramType, _ := param["type"].(string)
+				if schema, ok := param["schema"].(map[string]interface{}); ok {
+					paramType, _ = schema["type"].(string)
+				}
+				params = append(params, map[string]interface{}{
+					"name":     param["name"],
+					"location": param["in"],
+					"required": param["required"] == true,
+					"type":     paramType,
+				})
+			}
+			m := map[string]interface{}{"method": strings.ToUpper(method)}
+			if len(params) > 0 {
+				m["parameters"] = params
+			}
+			methods = append(methods, m)
+		}
+		paths = append(paths, map[string]interface{}{
+			"path":    path,
+			"methods": methods,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"description": info["title"],
+		"paths":       paths,
+	})
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	ans := make([]string, 0, len(m))
+	for k := range m {
+		ans = append(ans, k)
+	}
+	sort.Strings(ans)
+	return ans
+}
+
+// Serve a list of objects. The endpoint is either the list itself or
+// the list followed by the key of a single object.
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request, endpoint string, body interface{}) {
+	var (
+		l        *list
+		name, id string
+	)
+	for candidate := range s.lists {
+		if endpoint == candidate || strings.HasPrefix(endpoint, candidate+"/") {
+			l, name = s.lists[candidate], candidate
+			id = strings.TrimPrefix(strings.TrimPrefix(endpoint, candidate), "/")
+			break
+		}
+	}
+	if l == nil {
+		writeError(w, http.StatusNotFound, "unknown endpoint %s", endpoint)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if id != "" {
+			writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.render(name, l, r.URL.Query().Get("search")))
+	case http.MethodPost, http.MethodPut:
+		var objs []map[string]interface{}
+		switch v := body.(type) {
+		case map[string]interface{}:
+			objs = append(objs, v)
+		case []interface{}:
+			for _, val := range v {
+				if obj, ok := val.(map[string]interface{}); ok {
+					objs = append(objs, obj)
+				}
+			}
+		default:
+			writeError(w, http.StatusBadRequest, "expected a JSON object")
+			return
+		}
+		for _, obj := range objs {
+			if status, err := s.store(name, l, r.Method, id, obj); err != nil {
+				writeError(w, status, "%s", err)
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, nil)
+	case http.MethodDelete:
+		i := l.index(id)
+		if i < 0 {
+			writeError(w, http.StatusNotFound, "%s '%s' not found", name, id)
+			return
+		}
+		l.items = append(l.items[:i], l.items[i+1:]...)
+		writeJSON(w, http.StatusOK, nil)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+	}
+}
+
+// Create or update an object in a list, following the semantics of the
+// corresponding Console endpoint.
+func (s *Server) store(name string, l *list, method, id string, obj map[string]interface{}) (int, error) {
+	switch name {
+	case "custom-rules":
+		// The ID is chosen by the client and passed in the path.
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return http.StatusBadRequest, fmt.Errorf("invalid custom rule ID '%s'", id)
+		}
+		obj["_id"] = float64(n)
+	case "custom-compliance":
+		// The Console assigns IDs to new custom compliance checks.
+		if n, _ := obj["_id"].(float64); n == 0 {
+			max := 9000.0
+			for _, val := range l.items {
+				if n, _ := val["_id"].(float64); n > max {
+					max = n
+				}
+			}
+			obj["_id"] = max + 1
+		}
+	case "alert-profiles":
+		obj["_id"] = obj["name"]
+		for _, channel := range []string{"pagerDuty", "splunk", "xsoar"} {
+			if val, ok := obj[channel].(map[string]interface{}); ok {
+				encryptSecrets(val, "apiKey", "authToken", "routingKey")
+			}
+		}
+	case "credentials":
+		encryptSecrets(obj, "apiToken", "secret")
+	}
+
+	key := keyString(obj[l.key])
+	if key == "" {
+		return http.StatusBadRequest, fmt.Errorf("missing %s", l.key)
+	}
+	if id != "" && name != "custom-rules" && id != key {
+		return http.StatusBadRequest, fmt.Errorf("%s '%s' does not match the request path", l.key, key)
+	}
+
+	i := l.index(key)
+	switch {
+	case method == http.MethodPost && i >= 0 && strict(name):
+		return http.StatusConflict, fmt.Errorf("%s '%s' already exists", name, key)
+	case method == http.MethodPut && i < 0 && strict(name):
+		return http.StatusNotFound, fmt.Errorf("%s '%s' not found", name, key)
+	case i >= 0:
+		l.items[i] = obj
+	default:
+		l.items = append(l.items, obj)
+	}
+	return http.StatusOK, nil
+}
+
+// Endpoints where POST only creates new objects and PUT only updates
+// existing ones. The others create or replace objects with either method.
+func strict(name string) bool {
+	switch name {
+	case "collections", "groups", "policies/firewall/network/entities", "rbac/roles", "trust/groups", "users":
+		return true
+	}
+	return false
+}
+
+// Render a list the way the Console returns it.
+func (s *Server) render(name string, l *list, search string) []interface{} {
+	ans := make([]interface{}, 0, len(l.items))
+	for _, val := range l.items {
+		if search != "" && !strings.Contains(keyString(val[l.key]), search) {
+			continue
+		}
+		obj := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			obj[k] = v
+		}
+		switch name {
+		case "users":
+			// Passwords are write-only.
+			delete(obj, "password")
+		case "cloud-scan-rules":
+			// Scan rules embed the credential they reference.
+			creds := s.lists["credentials"]
+			if i := creds.index(keyString(obj["credentialId"])); i >= 0 {
+				obj["credential"] = creds.items[i]
+			}
+		}
+		ans = append(ans, obj)
+	}
+	return ans
+}
+
+func (l *list) index(key string) int {
+	for i, val := range l.items {
+		if keyString(val[l.key]) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func keyString(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return ""
+}
+
+// Replace the plain text of the given secrets of an object with an
+// encrypted value, as the Console never returns plain text secrets.
+func encryptSecrets(obj map[string]interface{}, fields ...string) {
+	for _, field := range fields {
+		secret, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if plain, _ := secret["plain"].(string); plain != "" {
+			secret["encrypted"] = "encrypted:" + hex.EncodeToString([]byte(plain))
+		}
+		delete(secret, "plain")
+	}
+}
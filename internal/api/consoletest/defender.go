@@ -0,0 +1,157 @@
+package consoletest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Render a simplified Defender DaemonSet manifest that reflects the given
+// options, like the manifest the Console generates.
+func daemonSetManifest(options map[string]interface{}) string {
+	namespace, _ := options["namespace"].(string)
+	if namespace == "" {
+		namespace = "twistlock"
+	}
+	cluster, _ := options["cluster"].(string)
+	consoleAddr, _ := options["consoleAddr"].(string)
+	nodeSelector, _ := options["nodeSelector"].(string)
+	collectPodLabels, _ := options["collectPodLabels"].(bool)
+	cri, _ := options["cri"].(bool)
+	var httpProxy string
+	if proxy, ok := options["proxy"].(map[string]interface{}); ok {
+		httpProxy, _ = proxy["httpProxy"].(string)
+	}
+	runtimeSocket := "/var/run/docker.sock"
+	if cri {
+		runtimeSocket = "/var/run/containerd/containerd.sock"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: twistlock-secrets
+  namespace: %s
+type: Opaque
+data:
+  service-parameter: Y29uc29sZXRlc3Q=
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: twistlock-defender-ds
+  namespace: %s
+spec:
+  selector:
+    matchLabels:
+      app: twistlock-defender
+  template:
+    metadata:
+      labels:
+        app: twistlock-defender
+    spec:
+`, namespace, namespace, namespace)
+	if nodeSelector != "" {
+		parts := strings.SplitN(nodeSelector, ":", 2)
+		fmt.Fprintf(&b, "      nodeSelector:\n        %s: %q\n", strings.TrimSpace(parts[0]), strings.TrimSpace(parts[len(parts)-1]))
+	}
+	fmt.Fprintf(&b, `      containers:
+      - name: twistlock-defender
+        image: registry.twistlock.com/twistlock/defender:defender_22_01
+        env:
+        - name: WS_ADDRESS
+          value: "wss://%s:8084"
+        - name: CLUSTER
+          value: %q
+        - name: COLLECT_POD_LABELS
+          value: "%t"
+        - name: HTTP_PROXY
+          value: %q
+        volumeMounts:
+        - name: runtime-sock
+          mountPath: %s
+      volumes:
+      - name: runtime-sock
+        hostPath:
+          path: %s
+`, consoleAddr, cluster, collectPodLabels, httpProxy, runtimeSocket, runtimeSocket)
+	return b.String()
+}
+
+// Package the DaemonSet manifest as a Helm chart, as a gzipped tar archive.
+func helmChart(options map[string]interface{}) ([]byte, error) {
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"twistlock-defender/Chart.yaml", "apiVersion: v1\nname: twistlock-defender\nversion: 22.01.839\n"},
+		{"twistlock-defender/templates/defender.yaml", daemonSetManifest(options)},
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Serve the files that deploy Defenders. The Console generates them on POST.
+func (s *Server) serveDefenderDeployment(w http.ResponseWriter, r *http.Request, endpoint string, body interface{}) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+		return
+	}
+	options, _ := body.(map[string]interface{})
+	if addr, _ := options["consoleAddr"].(string); addr == "" && strings.HasPrefix(endpoint, "defenders/") {
+		writeError(w, http.StatusBadRequest, "missing console address")
+		return
+	}
+
+	switch endpoint {
+	case "defenders/daemonset.yaml":
+		w.Header().Set("Content-Type", "application/x-yaml")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(daemonSetManifest(options)))
+	case "defenders/helm/twistlock-defender-helm.tar.gz":
+		chart, err := helmChart(options)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to package chart: %s", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/gzip")
+		w.WriteHeader(http.StatusOK)
+		w.Write(chart)
+	case "scripts/defender.sh":
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("#!/bin/bash\n# Install a host Defender.\nset -e\n"))
+	case "scripts/defender.ps1":
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("# Install a host Defender.\n$ErrorActionPreference = \"Stop\"\n"))
+	default:
+		writeError(w, http.StatusNotFound, "unknown path %s", r.URL.Path)
+	}
+}
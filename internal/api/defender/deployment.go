@@ -0,0 +1,76 @@
+package defender
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+)
+
+const (
+	DaemonSetEndpoint         = "api/v1/defenders/daemonset.yaml"
+	HelmChartEndpoint         = "api/v1/defenders/helm/twistlock-defender-helm.tar.gz"
+	HostScriptLinuxEndpoint   = "api/v1/scripts/defender.sh"
+	HostScriptWindowsEndpoint = "api/v1/scripts/defender.ps1"
+)
+
+// Options of the Defender DaemonSet, which are the same for the DaemonSet
+// manifest and the Helm chart.
+type DaemonSetOptions struct {
+	Cluster                string         `json:"cluster,omitempty"`
+	CollectPodLabels       bool           `json:"collectPodLabels"`
+	ConsoleAddr            string         `json:"consoleAddr"`
+	Cri                    bool           `json:"cri"`
+	Image                  string         `json:"image,omitempty"`
+	Istio                  bool           `json:"istio"`
+	MonitorServiceAccounts bool           `json:"serviceAccounts"`
+	Namespace              string         `json:"namespace"`
+	NodeSelector           string         `json:"nodeSelector,omitempty"`
+	Orchestration          string         `json:"orchestration"`
+	Privileged             bool           `json:"privileged"`
+	Proxy                  *ProxySettings `json:"proxy,omitempty"`
+	Selinux                bool           `json:"selinux"`
+}
+
+// Proxy that Defenders connect to the Console through.
+type ProxySettings struct {
+	Ca        string      `json:"ca,omitempty"`
+	HttpProxy string      `json:"httpProxy,omitempty"`
+	NoProxy   string      `json:"noProxy,omitempty"`
+	Password  auth.Secret `json:"password,omitempty"`
+	User      string      `json:"user,omitempty"`
+}
+
+// Get the manifest that deploys Defenders as a DaemonSet.
+func GetDaemonSet(ctx context.Context, c api.Client, options DaemonSetOptions) (string, error) {
+	var ans []byte
+	if err := c.Request(ctx, http.MethodPost, DaemonSetEndpoint, nil, options, &ans); err != nil {
+		return "", fmt.Errorf("error getting Defender DaemonSet: %w", err)
+	}
+	return string(ans), nil
+}
+
+// Get the Helm chart that deploys Defenders as a DaemonSet, as a gzipped tar
+// archive.
+func GetHelmChart(ctx context.Context, c api.Client, options DaemonSetOptions) ([]byte, error) {
+	var ans []byte
+	if err := c.Request(ctx, http.MethodPost, HelmChartEndpoint, nil, options, &ans); err != nil {
+		return nil, fmt.Errorf("error getting Defender Helm chart: %w", err)
+	}
+	return ans, nil
+}
+
+// Get the script that installs a host Defender on Linux or Windows.
+func GetHostScript(ctx context.Context, c api.Client, os string) (string, error) {
+	endpoint := HostScriptLinuxEndpoint
+	if os == "windows" {
+		endpoint = HostScriptWindowsEndpoint
+	}
+	var ans []byte
+	if err := c.Request(ctx, http.MethodPost, endpoint, nil, nil, &ans); err != nil {
+		return "", fmt.Errorf("error getting %s host Defender install script: %w", os, err)
+	}
+	return string(ans), nil
+}
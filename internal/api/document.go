@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+// Locks for endpoints shared by several resources, keyed by Console URL and
+// endpoint so that copies of a client and aliased providers share them.
+var endpointLocks sync.Map
+
+// Serialize writes to an endpoint shared by several resources, such as a
+// policy document or a list the Console only replaces as a whole. Terraform
+// applies resources in parallel, so without the lock concurrent
+// read-modify-write cycles overwrite each other.
+func (c Client) Lock(endpoint string) (unlock func()) {
+	mu, _ := endpointLocks.LoadOrStore(c.Config.ConsoleURL+"/"+endpoint, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// Read-modify-write a document shared by several resources, such as a policy.
+// The current document is decoded into current, if it is not nil, and modify
+// returns the document to write. The endpoint is locked for the whole cycle,
+// so concurrent changes from this provider are not lost. To detect changes
+// made by other clients, modify checks the document, or the part of it it
+// replaces, with CheckDigest.
+func (c Client) ModifyDocument(ctx context.Context, endpoint string, current interface{}, modify func() (interface{}, error)) error {
+	unlock := c.Lock(endpoint)
+	defer unlock()
+
+	var base json.RawMessage
+	if err := c.Request(ctx, http.MethodGet, endpoint, nil, nil, &base); err != nil {
+		return err
+	}
+	if current != nil && len(base) > 0 {
+		if err := json.Unmarshal(base, current); err != nil {
+			return err
+		}
+	}
+
+	doc, err := modify()
+	if err != nil {
+		return err
+	}
+
+	return c.Request(ctx, http.MethodPut, endpoint, nil, doc, nil)
+}
+
+// Replace a document shared by several resources, such as a policy. If ctx
+// expects a digest, the current document is decoded into the type of doc and
+// the update fails with a conflict error if another client changed it.
+func (c Client) UpdateDocument(ctx context.Context, endpoint string, doc interface{}) error {
+	if expectedDigest(ctx) == "" {
+		unlock := c.Lock(endpoint)
+		defer unlock()
+
+		return c.Request(ctx, http.MethodPut, endpoint, nil, doc, nil)
+	}
+
+	current := reflect.New(reflect.TypeOf(doc))
+	return c.ModifyDocument(ctx, endpoint, current.Interface(), func() (interface{}, error) {
+		if err := CheckDigest(ctx, endpoint, current.Elem().Interface()); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	})
+}
+
+type digestKey struct{}
+
+// Get a digest of a document, or of the part of it a resource manages, to
+// tell whether it changed since it was read. The digest covers the fields the
+// SDK knows about, so changes to other fields are not conflicts.
+func Digest(doc interface{}) (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Expect the documents written with the returned context to still have the
+// given digest, which was taken when they were last read. An empty digest
+// expects nothing.
+func WithDigest(ctx context.Context, digest string) context.Context {
+	return context.WithValue(ctx, digestKey{}, digest)
+}
+
+func expectedDigest(ctx context.Context) string {
+	digest, _ := ctx.Value(digestKey{}).(string)
+	return digest
+}
+
+// Check that current, the named document or part of it as it is now on the
+// Console, still has the digest expected by ctx. Fails with a conflict error
+// if another client changed it since it was last read, so that the change is
+// not overwritten.
+func CheckDigest(ctx context.Context, name string, current interface{}) error {
+	expected := expectedDigest(ctx)
+	if expected == "" {
+		return nil
+	}
+	digest, err := Digest(current)
+	if err != nil {
+		return err
+	}
+	if digest != expected {
+		return ConflictError("%s was changed by another client since it was last read, so the update was not applied. Run Terraform again to plan the update on top of the current version", name)
+	}
+	return nil
+}
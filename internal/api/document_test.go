@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+)
+
+const testDocumentEndpoint = "api/v1/policies/runtime/container"
+
+type testDocument struct {
+	Rules []map[string]interface{} `json:"rules"`
+}
+
+func TestModifyDocumentConcurrent(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	// Each update adds a rule to the current document, so an update based
+	// on a stale document would drop rules added concurrently.
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(c Client, i int) {
+			defer wg.Done()
+			var doc testDocument
+			errs <- c.ModifyDocument(context.Background(), testDocumentEndpoint, &doc, func() (interface{}, error) {
+				doc.Rules = append(doc.Rules, map[string]interface{}{"name": fmt.Sprintf("rule%d", i)})
+				return doc, nil
+			})
+		}(*client, i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("error modifying document: %s", err)
+		}
+	}
+	var doc testDocument
+	if err := client.Request(context.Background(), http.MethodGet, testDocumentEndpoint, nil, nil, &doc); err != nil {
+		t.Fatalf("error getting document: %s", err)
+	}
+	if len(doc.Rules) != n {
+		t.Errorf("document has %d rules, expected %d", len(doc.Rules), n)
+	}
+}
+
+func TestUpdateDocumentConflict(t *testing.T) {
+	const read = `{"learningDisabled":true,"rules":[{"name":"a"}]}`
+	cases := []struct {
+		name     string
+		current  string
+		digest   bool
+		conflict bool
+	}{
+		{"unchanged", read, true, false},
+		{"no digest", `{"rules":[{"name":"b"}]}`, false, false},
+		{"changed rule", `{"rules":[{"name":"b"}]}`, true, true},
+		{"deleted rule", `{"rules":[]}`, true, true},
+		// Fields the SDK does not know about are not compared.
+		{"changed unknown field", `{"learningDisabled":false,"rules":[{"name":"a"}]}`, true, false},
+	}
+	for _, tc := range cases {
+		// The document read at plan time is changed by another client
+		// before the update is applied.
+		doc := tc.current
+		var puts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				io.WriteString(w, doc)
+			case http.MethodPut:
+				puts++
+			}
+		}))
+		client := Client{
+			Config:     APIClientConfig{ConsoleURL: server.URL},
+			HTTPClient: server.Client(),
+		}
+
+		var planned testDocument
+		if err := json.Unmarshal([]byte(read), &planned); err != nil {
+			t.Fatalf("%s: error decoding document: %s", tc.name, err)
+		}
+		ctx := context.Background()
+		if tc.digest {
+			digest, err := Digest(planned)
+			if err != nil {
+				t.Fatalf("%s: error getting digest: %s", tc.name, err)
+			}
+			ctx = WithDigest(ctx, digest)
+		}
+		err := client.UpdateDocument(ctx, testDocumentEndpoint, testDocument{Rules: []map[string]interface{}{{"name": "mine"}}})
+		server.Close()
+
+		if tc.conflict {
+			if !IsConflict(err) {
+				t.Errorf("%s: expected a conflict, got %v", tc.name, err)
+			}
+			if puts != 0 {
+				t.Errorf("%s: document was written %d times despite the conflict", tc.name, puts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: error updating document: %s", tc.name, err)
+		}
+		if puts != 1 {
+			t.Errorf("%s: document was written %d times, expected once", tc.name, puts)
+		}
+	}
+}
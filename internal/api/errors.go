@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// Longest part of a non-JSON error body included in the error message.
+const maxErrorBodyLength = 200
+
+// A request the Console answered with a non-OK status.
+type Error struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	// The error reported by the Console. Empty if the body is not a Console
+	// error, such as an HTML page from a load balancer.
+	Message string
+	// The X-Request-Id response header, if the Console or a proxy set it.
+	RequestID string
+	Body      []byte
+}
+
+// Create an error from a non-OK response.
+func newError(method, endpoint string, res *http.Response, body []byte) *Error {
+	e := &Error{
+		StatusCode: res.StatusCode,
+		Method:     method,
+		Endpoint:   endpoint,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+	var response ErrResponse
+	if err := json.Unmarshal(body, &response); err == nil {
+		e.Message = response.Err
+	}
+	return e
+}
+
+// Create an error for an object that does not exist. Many Console endpoints
+// only list objects, so the SDK has to look up single objects itself.
+func NotFoundError(format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// Create an error for a change that conflicts with the current state of the
+// Console, such as an object that already exists.
+func ConflictError(format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusConflict,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+func (e *Error) Error() string {
+	if e.Method == "" && e.Message != "" {
+		return e.Message
+	}
+
+	var b strings.Builder
+	if e.Method != "" {
+		fmt.Fprintf(&b, "%s %s: ", e.Method, e.Endpoint)
+	}
+	fmt.Fprintf(&b, "%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+
+	switch {
+	case e.Message != "":
+		fmt.Fprintf(&b, ": %s", e.Message)
+	case len(e.Body) > 0:
+		body := strings.TrimSpace(string(e.Body))
+		if len(body) > maxErrorBodyLength {
+			body = body[:maxErrorBodyLength]
+			for !utf8.ValidString(body) {
+				body = body[:len(body)-1]
+			}
+			body += "..."
+		}
+		fmt.Fprintf(&b, ": %s", body)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request ID %s)", e.RequestID)
+	}
+	return b.String()
+}
+
+// Whether the error is a response with the given status code.
+func hasStatus(err error, status int) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == status
+}
+
+// Whether the requested object does not exist.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// Whether the request conflicts with the current state of the Console, such
+// as creating an object that already exists.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestError(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		message     string
+		errorString string
+	}{
+		{"console error", http.StatusNotFound, "application/json", `{"err":"collection does not exist"}`, "collection does not exist", "GET api/v1/collections: 404 Not Found: collection does not exist (request ID abc123)"},
+		{"html", http.StatusBadGateway, "text/html", "<html><body>502 Bad Gateway</body></html>", "", "GET api/v1/collections: 502 Bad Gateway: <html><body>502 Bad Gateway</body></html> (request ID abc123)"},
+		{"long body", http.StatusForbidden, "text/plain", strings.Repeat("x", 300), "", fmt.Sprintf("GET api/v1/collections: 403 Forbidden: %s... (request ID abc123)", strings.Repeat("x", maxErrorBodyLength))},
+		{"empty body", http.StatusConflict, "", "", "", "GET api/v1/collections: 409 Conflict (request ID abc123)"},
+	}
+	for _, tc := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", tc.contentType)
+			w.Header().Set("X-Request-Id", "abc123")
+			w.WriteHeader(tc.status)
+			w.Write([]byte(tc.body))
+		}))
+
+		client := Client{
+			Config:     APIClientConfig{ConsoleURL: server.URL},
+			HTTPClient: server.Client(),
+		}
+		var ans []map[string]interface{}
+		err := client.Request(context.Background(), http.MethodGet, "api/v1/collections", nil, nil, &ans)
+		server.Close()
+
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Errorf("%s: expected *Error, got %T: %v", tc.name, err, err)
+			continue
+		}
+		if e.StatusCode != tc.status || e.Method != http.MethodGet || e.Endpoint != "api/v1/collections" {
+			t.Errorf("%s: unexpected error %#v", tc.name, e)
+		}
+		if e.Message != tc.message {
+			t.Errorf("%s: message is %q, expected %q", tc.name, e.Message, tc.message)
+		}
+		if string(e.Body) != tc.body {
+			t.Errorf("%s: body is %q, expected %q", tc.name, e.Body, tc.body)
+		}
+		if e.Error() != tc.errorString {
+			t.Errorf("%s: error is %q, expected %q", tc.name, e.Error(), tc.errorString)
+		}
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := []struct {
+		err      error
+		notFound bool
+		conflict bool
+	}{
+		{nil, false, false},
+		{errors.New("not found"), false, false},
+		{&Error{StatusCode: http.StatusNotFound}, true, false},
+		{&Error{StatusCode: http.StatusConflict}, false, true},
+		{fmt.Errorf("error listing collections: %w", &Error{StatusCode: http.StatusNotFound}), true, false},
+		{NotFoundError("collection '%s' not found", "x"), true, false},
+	}
+	for _, tc := range cases {
+		if actual := IsNotFound(tc.err); actual != tc.notFound {
+			t.Errorf("IsNotFound(%v) = %t, expected %t", tc.err, actual, tc.notFound)
+		}
+		if actual := IsConflict(tc.err); actual != tc.conflict {
+			t.Errorf("IsConflict(%v) = %t, expected %t", tc.err, actual, tc.conflict)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,7 +32,8 @@ func main() {
 		return
 	}
 
-	client, err := api.APIClient(config)
+	ctx := context.Background()
+	client, err := api.APIClient(ctx, config)
 	if err != nil {
 		fmt.Printf("failed creating API client: %v", err)
 		return
@@ -42,14 +44,14 @@ func main() {
 	*/
 	fmt.Printf("create collection\n")
 	newColl := collection.Collection{Name: "My Collection"}
-	err = collection.CreateCollection(*client, newColl)
+	err = collection.CreateCollection(ctx, *client, newColl)
 	if err != nil {
 		fmt.Printf("Failed to create collection: %s\n", err)
 		return
 	}
 
 	fmt.Printf("\nlist collections:\n")
-	colls, err := collection.ListCollections(*client)
+	colls, err := collection.ListCollections(ctx, *client)
 	if err != nil {
 		fmt.Printf("Failed to list collections: %s\n", err)
 	}
@@ -59,7 +61,7 @@ func main() {
 	}
 
 	fmt.Printf("\nget collection:\n")
-	coll, err := collection.GetCollection(*client, "My Collection")
+	coll, err := collection.GetCollection(ctx, *client, "My Collection")
 	if err != nil {
 		fmt.Printf("Failed to get collection: %s\n", err)
 	}
@@ -67,13 +69,13 @@ func main() {
 
 	fmt.Printf("\nupdate collection\n")
 	existingColl := collection.Collection{Name: "My Collection", Color: "#FFFFFF"}
-	err = collection.UpdateCollection(*client, existingColl)
+	err = collection.UpdateCollection(ctx, *client, existingColl)
 	if err != nil {
 		fmt.Printf("Failed to update collection: %s\n", err)
 	}
 
 	fmt.Printf("\nlist collections:\n")
-	colls, err = collection.ListCollections(*client)
+	colls, err = collection.ListCollections(ctx, *client)
 	if err != nil {
 		fmt.Printf("Failed to list collections: %s\n", err)
 	}
@@ -83,13 +85,13 @@ func main() {
 	}
 
 	fmt.Printf("\ndelete collection\n")
-	err = collection.DeleteCollection(*client, "My Collection")
+	err = collection.DeleteCollection(ctx, *client, "My Collection")
 	if err != nil {
 		fmt.Printf("Failed to delete collection: %s\n", err)
 	}
 
 	fmt.Printf("\nlist collections:\n")
-	colls, err = collection.ListCollections(*client)
+	colls, err = collection.ListCollections(ctx, *client)
 	if err != nil {
 		fmt.Printf("Failed to list collections: %s\n", err)
 	}
@@ -110,13 +112,13 @@ func main() {
 	complianceCiImagePolicy := policy.CompliancePolicy{Type: "ciImagesCompliance", Rules: complianceCiImageRules}
 
 	fmt.Printf("\nupdate CI image compliance policy\n")
-	complianceCiImageErr := policy.UpdateComplianceCiImage(*client, complianceCiImagePolicy)
+	complianceCiImageErr := policy.UpdateComplianceCiImage(ctx, *client, complianceCiImagePolicy)
 	if complianceCiImageErr != nil {
 		fmt.Printf("\nFailed to update CI image compliance policy: %v\n", complianceCiImageErr)
 	}
 
 	fmt.Printf("\nget CI image compliance policy:\n")
-	retrievedCompliancePolicy, complianceCiImageErr := policy.GetComplianceCiImage(*client)
+	retrievedCompliancePolicy, complianceCiImageErr := policy.GetComplianceCiImage(ctx, *client)
 	if complianceCiImageErr != nil {
 		fmt.Printf("failed to get CI image compliance policy: %s\n", complianceCiImageErr)
 	}
@@ -125,13 +127,13 @@ func main() {
 	fmt.Printf("\nupdate CI image compliance policy\n")
 	complianceCiImageRule.Name = "name change"
 	complianceCiImagePolicy = policy.CompliancePolicy{Type: "ciImagesCompliance", Rules: []policy.ComplianceRule{complianceCiImageRule}}
-	complianceCiImageErr = policy.UpdateComplianceCiImage(*client, complianceCiImagePolicy)
+	complianceCiImageErr = policy.UpdateComplianceCiImage(ctx, *client, complianceCiImagePolicy)
 	if complianceCiImageErr != nil {
 		fmt.Printf("failed to update CI image compliance policy: %s\n", complianceCiImageErr)
 	}
 
 	fmt.Printf("\nget CI image compliance policy:\n")
-	retrievedCompliancePolicy, complianceCiImageErr = policy.GetComplianceCiImage(*client)
+	retrievedCompliancePolicy, complianceCiImageErr = policy.GetComplianceCiImage(ctx, *client)
 	if complianceCiImageErr != nil {
 		fmt.Printf("failed to get CI image compliance policy: %s\n", complianceCiImageErr)
 	}
@@ -149,13 +151,13 @@ func main() {
 	complianceContainerPolicy := policy.CompliancePolicy{Type: "containerCompliance", Rules: complianceContainerRules}
 
 	fmt.Printf("\nupdate container compliance policy\n")
-	complianceContainerErr := policy.UpdateComplianceContainer(*client, complianceContainerPolicy)
+	complianceContainerErr := policy.UpdateComplianceContainer(ctx, *client, complianceContainerPolicy)
 	if complianceContainerErr != nil {
 		fmt.Printf("\nFailed to update container compliance policy: %v\n", complianceContainerErr)
 	}
 
 	fmt.Printf("\nget container compliance policy:\n")
-	retrievedCompliancePolicy, complianceContainerErr = policy.GetComplianceContainer(*client)
+	retrievedCompliancePolicy, complianceContainerErr = policy.GetComplianceContainer(ctx, *client)
 	if complianceContainerErr != nil {
 		fmt.Printf("failed to get container compliance policy: %s\n", complianceContainerErr)
 	}
@@ -164,13 +166,13 @@ func main() {
 	fmt.Printf("\nupdate container compliance policy\n")
 	complianceContainerRule.Name = "name change"
 	complianceContainerPolicy = policy.CompliancePolicy{Type: "containerCompliance", Rules: []policy.ComplianceRule{complianceContainerRule}}
-	complianceContainerErr = policy.UpdateComplianceContainer(*client, complianceContainerPolicy)
+	complianceContainerErr = policy.UpdateComplianceContainer(ctx, *client, complianceContainerPolicy)
 	if complianceContainerErr != nil {
 		fmt.Printf("failed to update container compliance policy: %s\n", complianceContainerErr)
 	}
 
 	fmt.Printf("\nget container compliance policy:\n")
-	retrievedCompliancePolicy, complianceContainerErr = policy.GetComplianceContainer(*client)
+	retrievedCompliancePolicy, complianceContainerErr = policy.GetComplianceContainer(ctx, *client)
 	if complianceContainerErr != nil {
 		fmt.Printf("failed to get container compliance policy: %s\n", complianceContainerErr)
 	}
@@ -188,13 +190,13 @@ func main() {
 	complianceHostPolicy := policy.CompliancePolicy{Type: "hostCompliance", Rules: complianceHostRules}
 
 	fmt.Printf("\nupdate host compliance policy\n")
-	complianceHostErr := policy.UpdateComplianceHost(*client, complianceHostPolicy)
+	complianceHostErr := policy.UpdateComplianceHost(ctx, *client, complianceHostPolicy)
 	if complianceHostErr != nil {
 		fmt.Printf("\nFailed to update host compliance policy: %v\n", complianceHostErr)
 	}
 
 	fmt.Printf("\nget host compliance policy:\n")
-	retrievedCompliancePolicy, complianceHostErr = policy.GetComplianceHost(*client)
+	retrievedCompliancePolicy, complianceHostErr = policy.GetComplianceHost(ctx, *client)
 	if complianceHostErr != nil {
 		fmt.Printf("failed to get host compliance policy: %s\n", complianceHostErr)
 	}
@@ -203,13 +205,13 @@ func main() {
 	fmt.Printf("\nupdate host compliance policy\n")
 	complianceHostRule.Name = "name change"
 	complianceHostPolicy = policy.CompliancePolicy{Type: "hostCompliance", Rules: []policy.ComplianceRule{complianceHostRule}}
-	complianceHostErr = policy.UpdateComplianceHost(*client, complianceHostPolicy)
+	complianceHostErr = policy.UpdateComplianceHost(ctx, *client, complianceHostPolicy)
 	if complianceHostErr != nil {
 		fmt.Printf("failed to update host compliance policy: %s\n", complianceHostErr)
 	}
 
 	fmt.Printf("\nget host compliance policy:\n")
-	retrievedCompliancePolicy, complianceHostErr = policy.GetComplianceHost(*client)
+	retrievedCompliancePolicy, complianceHostErr = policy.GetComplianceHost(ctx, *client)
 	if complianceHostErr != nil {
 		fmt.Printf("failed to get host compliance policy: %s\n", complianceHostErr)
 	}
@@ -220,26 +222,26 @@ func main() {
 	*/
 	runtimeContainerColl := collection.Collection{Name: "All"}
 	runtimeContainerRule := policy.RuntimeContainerRule{
-		Name:               "example container runtime rule",
-		Collections:        []collection.Collection{runtimeContainerColl},
-		AdvancedProtection: true,
-		Dns:                policy.RuntimeContainerDns{DenyEffect: "disable"},
-		Filesystem:         policy.RuntimeContainerFilesystem{DenyEffect: "alert"},
-		Network:            policy.RuntimeContainerNetwork{DenyEffect: "alert"},
-		Processes:          policy.RuntimeContainerProcesses{DenyEffect: "alert"},
-		WildFireAnalysis:   "alert",
+		Name:                     "example container runtime rule",
+		Collections:              []collection.Collection{runtimeContainerColl},
+		AdvancedProtectionEffect: "alert",
+		Dns:                      policy.RuntimeContainerDns{DefaultEffect: "disable"},
+		Filesystem:               policy.RuntimeContainerFilesystem{DefaultEffect: "alert"},
+		Network:                  policy.RuntimeContainerNetwork{DefaultEffect: "alert"},
+		Processes:                policy.RuntimeContainerProcesses{DefaultEffect: "alert"},
+		WildFireAnalysis:         "alert",
 	}
 	runtimeContainerRules := []policy.RuntimeContainerRule{runtimeContainerRule}
 	runtimeContainerPolicy := policy.RuntimeContainerPolicy{LearningDisabled: false, Rules: runtimeContainerRules}
 
 	fmt.Printf("\nupdate container runtime policy\n")
-	runtimeContainerErr := policy.UpdateRuntimeContainer(*client, runtimeContainerPolicy)
+	runtimeContainerErr := policy.UpdateRuntimeContainer(ctx, *client, runtimeContainerPolicy)
 	if runtimeContainerErr != nil {
 		fmt.Printf("\nfailed to update container runtime policy: %v\n", runtimeContainerErr)
 	}
 
 	fmt.Printf("\nget container runtime policy:\n")
-	retrievedRuntimeContainerPolicy, runtimeContainerErr := policy.GetRuntimeContainer(*client)
+	retrievedRuntimeContainerPolicy, runtimeContainerErr := policy.GetRuntimeContainer(ctx, *client)
 	if runtimeContainerErr != nil {
 		fmt.Printf("failed to get container runtime policy: %s\n", runtimeContainerErr)
 	}
@@ -248,13 +250,13 @@ func main() {
 	fmt.Printf("\nupdate container runtime policy\n")
 	runtimeContainerRule.Name = "name change"
 	runtimeContainerPolicy = policy.RuntimeContainerPolicy{LearningDisabled: false, Rules: []policy.RuntimeContainerRule{runtimeContainerRule}}
-	runtimeContainerErr = policy.UpdateRuntimeContainer(*client, runtimeContainerPolicy)
+	runtimeContainerErr = policy.UpdateRuntimeContainer(ctx, *client, runtimeContainerPolicy)
 	if runtimeContainerErr != nil {
 		fmt.Printf("failed to update container runtime policy: %s\n", runtimeContainerErr)
 	}
 
 	fmt.Printf("\nget container runtime policy:\n")
-	retrievedRuntimeContainerPolicy, runtimeContainerErr = policy.GetRuntimeContainer(*client)
+	retrievedRuntimeContainerPolicy, runtimeContainerErr = policy.GetRuntimeContainer(ctx, *client)
 	if runtimeContainerErr != nil {
 		fmt.Printf("failed to get container runtime policy: %s\n", runtimeContainerErr)
 	}
@@ -297,13 +299,13 @@ func main() {
 	runtimeHostPolicy := policy.RuntimeHostPolicy{Rules: runtimeHostRules}
 
 	fmt.Printf("\nupdate host runtime policy\n")
-	runtimeHostErr := policy.UpdateRuntimeHost(*client, runtimeHostPolicy)
+	runtimeHostErr := policy.UpdateRuntimeHost(ctx, *client, runtimeHostPolicy)
 	if runtimeHostErr != nil {
 		fmt.Printf("\nfailed to update host runtime policy: %v\n", runtimeHostErr)
 	}
 
 	fmt.Printf("\nget host runtime policy:\n")
-	retrievedRuntimeHostPolicy, runtimeHostErr := policy.GetRuntimeHost(*client)
+	retrievedRuntimeHostPolicy, runtimeHostErr := policy.GetRuntimeHost(ctx, *client)
 	if runtimeHostErr != nil {
 		fmt.Printf("failed to get host runtime policy: %s\n", runtimeHostErr)
 	}
@@ -312,13 +314,13 @@ func main() {
 	fmt.Printf("\nupdate host runtime policy\n")
 	runtimeHostRule.Name = "name change"
 	runtimeHostPolicy = policy.RuntimeHostPolicy{Rules: []policy.RuntimeHostRule{runtimeHostRule}}
-	runtimeHostErr = policy.UpdateRuntimeHost(*client, runtimeHostPolicy)
+	runtimeHostErr = policy.UpdateRuntimeHost(ctx, *client, runtimeHostPolicy)
 	if runtimeHostErr != nil {
 		fmt.Printf("failed to update host runtime policy: %s\n", runtimeHostErr)
 	}
 
 	fmt.Printf("\nget host runtime policy:\n")
-	retrievedRuntimeHostPolicy, runtimeHostErr = policy.GetRuntimeHost(*client)
+	retrievedRuntimeHostPolicy, runtimeHostErr = policy.GetRuntimeHost(ctx, *client)
 	if runtimeHostErr != nil {
 		fmt.Printf("failed to get host runtime policy: %s\n", runtimeHostErr)
 	}
@@ -332,13 +334,13 @@ func main() {
 	vulnerabilityCiImagePolicy := policy.VulnerabilityImagePolicy{Type: "ciImagesVulnerability", Rules: []policy.VulnerabilityImageRule{vulnerabilityCiImageRule}}
 
 	fmt.Printf("\nupdate CI image vulnerability policy\n")
-	vulnerabilityCiImageErr := policy.UpdateVulnerabilityCiImage(*client, vulnerabilityCiImagePolicy)
+	vulnerabilityCiImageErr := policy.UpdateVulnerabilityCiImage(ctx, *client, vulnerabilityCiImagePolicy)
 	if vulnerabilityCiImageErr != nil {
 		fmt.Printf("failed to update CI image vulnerability policy: %s\n", vulnerabilityCiImageErr)
 	}
 
 	fmt.Printf("\nget CI image vulnerability policy:\n")
-	retrievedVulnerabilityImagePolicy, vulnerabilityCiImageErr := policy.GetVulnerabilityCiImage(*client)
+	retrievedVulnerabilityImagePolicy, vulnerabilityCiImageErr := policy.GetVulnerabilityCiImage(ctx, *client)
 	if vulnerabilityCiImageErr != nil {
 		fmt.Printf("failed to get CI image vulnerability policy: %s\n", vulnerabilityCiImageErr)
 	}
@@ -347,13 +349,13 @@ func main() {
 	fmt.Printf("\nupdate CI image vulnerability policy\n")
 	vulnerabilityCiImageRule.Name = "name change"
 	vulnerabilityCiImagePolicy = policy.VulnerabilityImagePolicy{Type: "ciImagesVulnerability", Rules: []policy.VulnerabilityImageRule{vulnerabilityCiImageRule}}
-	vulnerabilityCiImageErr = policy.UpdateVulnerabilityCiImage(*client, vulnerabilityCiImagePolicy)
+	vulnerabilityCiImageErr = policy.UpdateVulnerabilityCiImage(ctx, *client, vulnerabilityCiImagePolicy)
 	if vulnerabilityCiImageErr != nil {
 		fmt.Printf("failed to update CI image vulnerability policy: %s\n", vulnerabilityCiImageErr)
 	}
 
 	fmt.Printf("\nget CI image vulnerability policy:\n")
-	retrievedVulnerabilityImagePolicy, vulnerabilityCiImageErr = policy.GetVulnerabilityCiImage(*client)
+	retrievedVulnerabilityImagePolicy, vulnerabilityCiImageErr = policy.GetVulnerabilityCiImage(ctx, *client)
 	if vulnerabilityCiImageErr != nil {
 		fmt.Printf("failed to get CI image vulnerability policy: %s\n", vulnerabilityCiImageErr)
 	}
@@ -367,13 +369,13 @@ func main() {
 	vulnerabilityHostPolicy := policy.VulnerabilityHostPolicy{Type: "hostVulnerability", Rules: []policy.VulnerabilityHostRule{vulnerabilityHostRule}}
 
 	fmt.Printf("\nupdate host vulnerability policy\n")
-	vulnerabilityHostErr := policy.UpdateVulnerabilityHost(*client, vulnerabilityHostPolicy)
+	vulnerabilityHostErr := policy.UpdateVulnerabilityHost(ctx, *client, vulnerabilityHostPolicy)
 	if vulnerabilityHostErr != nil {
 		fmt.Printf("failed to update host vulnerability policy: %s\n", vulnerabilityHostErr)
 	}
 
 	fmt.Printf("\nget host vulnerability policy:\n")
-	retrievedVulnerabilityHostPolicy, vulnerabilityHostErr := policy.GetVulnerabilityHost(*client)
+	retrievedVulnerabilityHostPolicy, vulnerabilityHostErr := policy.GetVulnerabilityHost(ctx, *client)
 	if vulnerabilityHostErr != nil {
 		fmt.Printf("failed to get host vulnerability policy: %s\n", vulnerabilityHostErr)
 	}
@@ -382,13 +384,13 @@ func main() {
 	fmt.Printf("\nupdate host vulnerability policy\n")
 	vulnerabilityHostRule.Name = "name change"
 	vulnerabilityHostPolicy = policy.VulnerabilityHostPolicy{Type: "hostVulnerability", Rules: []policy.VulnerabilityHostRule{vulnerabilityHostRule}}
-	vulnerabilityHostErr = policy.UpdateVulnerabilityHost(*client, vulnerabilityHostPolicy)
+	vulnerabilityHostErr = policy.UpdateVulnerabilityHost(ctx, *client, vulnerabilityHostPolicy)
 	if vulnerabilityHostErr != nil {
 		fmt.Printf("failed to update host vulnerability policy: %s\n", vulnerabilityHostErr)
 	}
 
 	fmt.Printf("\nget host vulnerability policy:\n")
-	retrievedVulnerabilityHostPolicy, vulnerabilityHostErr = policy.GetVulnerabilityHost(*client)
+	retrievedVulnerabilityHostPolicy, vulnerabilityHostErr = policy.GetVulnerabilityHost(ctx, *client)
 	if vulnerabilityHostErr != nil {
 		fmt.Printf("failed to get host vulnerability policy: %s\n", vulnerabilityHostErr)
 	}
@@ -401,13 +403,13 @@ func main() {
 	vulnerabilityimageRule := policy.VulnerabilityImageRule{Name: "example image vulnerability rule", Collections: []collection.Collection{vulnerabilityimageColl}, Effect: "alert"}
 	vulnerabilityimagePolicy := policy.VulnerabilityImagePolicy{Type: "containerVulnerability", Rules: []policy.VulnerabilityImageRule{vulnerabilityimageRule}}
 	fmt.Printf("\nupdate image vulnerability policy\n")
-	vulnerabilityimageErr := policy.UpdateVulnerabilityImage(*client, vulnerabilityimagePolicy)
+	vulnerabilityimageErr := policy.UpdateVulnerabilityImage(ctx, *client, vulnerabilityimagePolicy)
 	if vulnerabilityimageErr != nil {
 		fmt.Printf("failed to update image vulnerability policy: %s\n", vulnerabilityimageErr)
 	}
 
 	fmt.Printf("\nget image vulnerability policy:\n")
-	retrievedVulnerabilityImagePolicy, vulnerabilityimageErr = policy.GetVulnerabilityImage(*client)
+	retrievedVulnerabilityImagePolicy, vulnerabilityimageErr = policy.GetVulnerabilityImage(ctx, *client)
 	if vulnerabilityimageErr != nil {
 		fmt.Printf("failed to get image vulnerability policy: %s\n", vulnerabilityimageErr)
 	}
@@ -416,13 +418,13 @@ func main() {
 	fmt.Printf("\nupdate image vulnerability policy\n")
 	vulnerabilityimageRule.Name = "name change"
 	vulnerabilityimagePolicy = policy.VulnerabilityImagePolicy{Type: "containerVulnerability", Rules: []policy.VulnerabilityImageRule{vulnerabilityimageRule}}
-	vulnerabilityimageErr = policy.UpdateVulnerabilityImage(*client, vulnerabilityimagePolicy)
+	vulnerabilityimageErr = policy.UpdateVulnerabilityImage(ctx, *client, vulnerabilityimagePolicy)
 	if vulnerabilityimageErr != nil {
 		fmt.Printf("failed to update image vulnerability policy: %s\n", vulnerabilityimageErr)
 	}
 
 	fmt.Printf("\nget image vulnerability policy:\n")
-	retrievedVulnerabilityImagePolicy, vulnerabilityimageErr = policy.GetVulnerabilityImage(*client)
+	retrievedVulnerabilityImagePolicy, vulnerabilityimageErr = policy.GetVulnerabilityImage(ctx, *client)
 	if vulnerabilityimageErr != nil {
 		fmt.Printf("failed to get image vulnerability policy: %s\n", vulnerabilityimageErr)
 	}
@@ -444,13 +446,13 @@ func main() {
 	reg := settings.RegistrySettings{Specifications: []settings.RegistrySpecification{registrySpec}}
 
 	fmt.Printf("\ncreate registry settings\n")
-	registryErr := settings.UpdateRegistrySettings(*client, reg)
+	registryErr := settings.UpdateRegistrySettings(ctx, *client, reg)
 	if registryErr != nil {
 		fmt.Printf("failed to create registry settings: %s\n", registryErr)
 	}
 
 	fmt.Printf("\nget registry settings:\n")
-	retrievedRegistry, registryErr := settings.GetRegistrySettings(*client)
+	retrievedRegistry, registryErr := settings.GetRegistrySettings(ctx, *client)
 	if registryErr != nil {
 		fmt.Printf("failed to get registry settings: %s\n", registryErr)
 	}
@@ -459,13 +461,13 @@ func main() {
 	fmt.Printf("\nupdate registry settings\n")
 	registrySpec.Tag = "21.04"
 	reg = settings.RegistrySettings{Specifications: []settings.RegistrySpecification{registrySpec}}
-	registryErr = settings.UpdateRegistrySettings(*client, reg)
+	registryErr = settings.UpdateRegistrySettings(ctx, *client, reg)
 	if registryErr != nil {
 		fmt.Printf("failed to update registry settings: %s\n", registryErr)
 	}
 
 	fmt.Printf("\nget registry settings:\n")
-	retrievedRegistry, registryErr = settings.GetRegistrySettings(*client)
+	retrievedRegistry, registryErr = settings.GetRegistrySettings(ctx, *client)
 	if registryErr != nil {
 		fmt.Printf("failed to get registry settings: %s\n", registryErr)
 	}
@@ -482,13 +484,13 @@ func main() {
 		}
 
 		fmt.Printf("\ncreate user\n")
-		userErr := auth.CreateUser(*client, user)
+		userErr := auth.CreateUser(ctx, *client, user)
 		if userErr != nil {
 			fmt.Printf("failed to create user: %s\n", userErr)
 		}
 
 		fmt.Printf("\nlist users:\n")
-		retrievedUsers, userErr := auth.ListUsers(*client)
+		retrievedUsers, userErr := auth.ListUsers(ctx, *client)
 		if userErr != nil {
 			fmt.Printf("failed to get users: %s\n", userErr)
 		}
@@ -496,26 +498,26 @@ func main() {
 
 		fmt.Printf("\nupdate user\n")
 		user.Role = "vulnerabilityManager"
-		userErr = auth.UpdateUser(*client, user)
+		userErr = auth.UpdateUser(ctx, *client, user)
 		if userErr != nil {
 			fmt.Printf("failed to update user: %s\n", userErr)
 		}
 
 		fmt.Printf("\nlist users:\n")
-		retrievedUsers, userErr = auth.ListUsers(*client)
+		retrievedUsers, userErr = auth.ListUsers(ctx, *client)
 		if userErr != nil {
 			fmt.Printf("failed to get users: %s\n", userErr)
 		}
 		fmt.Printf("* %+v\n", retrievedUsers)
 
 		fmt.Printf("\ndelete user\n")
-		userErr = auth.DeleteUser(*client, user.Username)
+		userErr = auth.DeleteUser(ctx, *client, user.Username)
 		if userErr != nil {
 			fmt.Printf("failed to delete user: %s\n", userErr)
 		}
 
 		fmt.Printf("\nlist users:\n")
-		retrievedUsers, userErr = auth.ListUsers(*client)
+		retrievedUsers, userErr = auth.ListUsers(ctx, *client)
 		if userErr != nil {
 			fmt.Printf("failed to get users: %s\n", userErr)
 		}
@@ -534,13 +536,13 @@ func main() {
 		}
 
 		fmt.Printf("\ncreate group\n")
-		groupErr := auth.CreateGroup(*client, group)
+		groupErr := auth.CreateGroup(ctx, *client, group)
 		if groupErr != nil {
 			fmt.Printf("failed to create group: %s\n", groupErr)
 		}
 
 		fmt.Printf("\nlist groups:\n")
-		retrievedGroups, groupErr := auth.ListGroups(*client)
+		retrievedGroups, groupErr := auth.ListGroups(ctx, *client)
 		if groupErr != nil {
 			fmt.Printf("failed to get groups: %s\n", groupErr)
 		}
@@ -548,26 +550,26 @@ func main() {
 
 		fmt.Printf("\nupdate group\n")
 		group.Users = make([]auth.GroupUser, 0)
-		groupErr = auth.UpdateGroup(*client, group)
+		groupErr = auth.UpdateGroup(ctx, *client, group)
 		if groupErr != nil {
 			fmt.Printf("failed to update group: %s\n", groupErr)
 		}
 
 		fmt.Printf("\nlist groups:\n")
-		retrievedGroups, groupErr = auth.ListGroups(*client)
+		retrievedGroups, groupErr = auth.ListGroups(ctx, *client)
 		if groupErr != nil {
 			fmt.Printf("failed to get groups: %s\n", groupErr)
 		}
 		fmt.Printf("* %+v\n", retrievedGroups)
 
 		fmt.Printf("\ndelete group\n")
-		groupErr = auth.DeleteGroup(*client, group.Name)
+		groupErr = auth.DeleteGroup(ctx, *client, group.Name)
 		if groupErr != nil {
 			fmt.Printf("failed to delete group: %s\n", groupErr)
 		}
 
 		fmt.Printf("\nlist groups:\n")
-		retrievedGroups, groupErr = auth.ListGroups(*client)
+		retrievedGroups, groupErr = auth.ListGroups(ctx, *client)
 		if groupErr != nil {
 			fmt.Printf("failed to get groups: %s\n", groupErr)
 		}
@@ -591,13 +593,13 @@ func main() {
 		}
 
 		fmt.Printf("\ncreate role\n")
-		roleErr := auth.CreateRole(*client, role)
+		roleErr := auth.CreateRole(ctx, *client, role)
 		if roleErr != nil {
 			fmt.Printf("failed to create role: %s\n", roleErr)
 		}
 
 		fmt.Printf("\nget roles:\n")
-		retrievedRoles, roleErr := auth.GetRole(*client, role.Name)
+		retrievedRoles, roleErr := auth.GetRole(ctx, *client, role.Name)
 		if roleErr != nil {
 			fmt.Printf("failed to get role: %s\n", roleErr)
 		}
@@ -608,26 +610,26 @@ func main() {
 			Name:      "accessUI",
 			ReadWrite: false,
 		})
-		roleErr = auth.UpdateRole(*client, role)
+		roleErr = auth.UpdateRole(ctx, *client, role)
 		if roleErr != nil {
 			fmt.Printf("failed to update role: %s\n", roleErr)
 		}
 
 		fmt.Printf("\nget roles:\n")
-		retrievedRoles, roleErr = auth.GetRole(*client, role.Name)
+		retrievedRoles, roleErr = auth.GetRole(ctx, *client, role.Name)
 		if roleErr != nil {
 			fmt.Printf("failed to get role: %s\n", roleErr)
 		}
 		fmt.Printf("* %+v\n", retrievedRoles)
 
 		fmt.Printf("\ndelete role\n")
-		roleErr = auth.DeleteRole(*client, role.Name)
+		roleErr = auth.DeleteRole(ctx, *client, role.Name)
 		if roleErr != nil {
 			fmt.Printf("failed to delete role: %s\n", roleErr)
 		}
 
 		fmt.Printf("\nget roles:\n")
-		retrievedRoles, roleErr = auth.GetRole(*client, role.Name)
+		retrievedRoles, roleErr = auth.GetRole(ctx, *client, role.Name)
 		if roleErr != nil {
 			fmt.Printf("failed to get role: %s\n", roleErr)
 		}
@@ -651,13 +653,13 @@ func main() {
 	}
 
 	fmt.Printf("\ncreate credential\n")
-	credentialErr := auth.UpdateCredential(*client, credential)
+	credentialErr := auth.UpdateCredential(ctx, *client, credential)
 	if credentialErr != nil {
 		fmt.Printf("failed to create credential: %s\n", credentialErr)
 	}
 
 	fmt.Printf("\nlist credentials:\n")
-	credentials, err := auth.ListCredentials(*client)
+	credentials, err := auth.ListCredentials(ctx, *client)
 	if err != nil {
 		fmt.Printf("Failed to list credentials: %s\n", err)
 	}
@@ -667,19 +669,19 @@ func main() {
 
 	fmt.Printf("\nupdate credential\n")
 	credential.AccountID = "test update"
-	credentialErr = auth.UpdateCredential(*client, credential)
+	credentialErr = auth.UpdateCredential(ctx, *client, credential)
 	if credentialErr != nil {
 		fmt.Printf("failed to update credential: %s\n", credentialErr)
 	}
 
 	fmt.Printf("\ndelete credential\n")
-	credentialErr = auth.DeleteCredential(*client, credential.Id)
+	credentialErr = auth.DeleteCredential(ctx, *client, credential.Id)
 	if credentialErr != nil {
 		fmt.Printf("failed to delete credential: %s\n", credentialErr)
 	}
 
 	fmt.Printf("\nlist credentials:\n")
-	credentials, err = auth.ListCredentials(*client)
+	credentials, err = auth.ListCredentials(ctx, *client)
 	if err != nil {
 		fmt.Printf("Failed to list credentials: %s\n", err)
 	}
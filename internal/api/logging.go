@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "REDACTED"
+
+// Lower-cased JSON fields whose values are never logged. Secrets such as
+// apiToken and secretAccessKey are objects whose plain field holds the value,
+// so the whole object is redacted. Webhook URLs, such as those of Slack,
+// embed a token.
+var sensitiveFields = map[string]bool{
+	"password":           true,
+	"plain":              true,
+	"token":              true,
+	"apitoken":           true,
+	"secret":             true,
+	"secretaccesskey":    true,
+	"awssecretaccesskey": true,
+	"webhookurl":         true,
+	"routingkey":         true,
+	"authtoken":          true,
+	"apikey":             true,
+	"appsecret":          true,
+	"clientsecret":       true,
+}
+
+// Log a request to the Console. Bodies are only logged at TRACE level, and
+// credentials in them are redacted.
+func logRequest(ctx context.Context, req *http.Request, reqBody []byte, res *http.Response, resBody []byte, latency time.Duration, err error) {
+	fields := []interface{}{
+		"method", req.Method,
+		"url", req.URL.String(),
+		"latency_ms", latency.Milliseconds(),
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Console request failed", append(fields, "error", err.Error())...)
+		return
+	}
+	fields = append(fields, "status", res.StatusCode)
+	tflog.Debug(ctx, "Console request", fields...)
+
+	if len(reqBody) > 0 {
+		fields = append(fields, "request_body", redactBody(reqBody))
+	}
+	if len(resBody) > 0 {
+		fields = append(fields, "response_body", redactBody(resBody))
+	}
+	tflog.Trace(ctx, "Console request bodies", fields...)
+}
+
+// Replace the values of sensitive fields in a JSON body. Bodies that are not
+// JSON, such as error pages from a proxy, are logged as is.
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redact(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	}
+	return v
+}
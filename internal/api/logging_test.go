@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]string{
+		`{"username":"admin","password":"hunter2"}`:                                        `{"password":"REDACTED","username":"admin"}`,
+		`{"token":"eyJ.eyJ.sig"}`:                                                          `{"token":"REDACTED"}`,
+		`{"apiToken":{"encrypted":"","plain":"t0k3n"},"type":"github"}`:                    `{"apiToken":"REDACTED","type":"github"}`,
+		`{"secret":{"plain":"s3cr3t"}}`:                                                    `{"secret":"REDACTED"}`,
+		`{"rules":[{"name":"r","secretAccessKey":"k"}]}`:                                   `{"rules":[{"name":"r","secretAccessKey":"REDACTED"}]}`,
+		`[{"password":"p"}]`:                                                               `[{"password":"REDACTED"}]`,
+		`{"slack":{"enabled":true,"webhookUrl":"https://hooks.slack.com/services/T/B/x"}}`: `{"slack":{"enabled":true,"webhookUrl":"REDACTED"}}`,
+		`{"pagerDuty":{"routingKey":{"plain":"k"}},"splunk":{"authToken":{"plain":"t"}}}`:  `{"pagerDuty":{"routingKey":"REDACTED"},"splunk":{"authToken":"REDACTED"}}`,
+		`{"xsoar":{"apiKey":{"plain":"k"}}}`:                                               `{"xsoar":{"apiKey":"REDACTED"}}`,
+		`{"appSecret":{"plain":"s"},"clientSecret":{"plain":"s"}}`:                         `{"appSecret":"REDACTED","clientSecret":"REDACTED"}`,
+		`<html>502 Bad Gateway</html>`:                                                     `<html>502 Bad Gateway</html>`,
+	}
+	for body, expected := range cases {
+		if actual := redactBody([]byte(body)); actual != expected {
+			t.Errorf("redactBody(%s) = %s, expected %s", body, actual, expected)
+		}
+	}
+}
+
+func TestRequestLogging(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "terraform.log")
+	t.Setenv("TF_LOG", "TRACE")
+	t.Setenv("TF_LOG_PATH", logFile)
+	t.Setenv("TF_ACC_LOG_PATH", "")
+	t.Setenv("TF_LOG_PATH_MASK", "")
+
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	ctx := tfsdklog.RegisterTestSink(context.Background(), t)
+	ctx = tfsdklog.NewRootProviderLogger(ctx)
+
+	client, err := APIClient(ctx, APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	user := map[string]interface{}{"username": "tfuser", "password": "hunter2", "role": "user"}
+	if err := client.Request(ctx, http.MethodPost, "api/v1/users", nil, user, nil); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+
+	b, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := string(b)
+	for _, expected := range []string{"Console request", "method=POST", "api/v1/users", "status=200", "latency_ms=", "tfuser"} {
+		if !strings.Contains(log, expected) {
+			t.Errorf("log does not contain %q:\n%s", expected, log)
+		}
+	}
+	for _, secret := range []string{console.Password, "hunter2", client.JWT} {
+		if strings.Contains(log, secret) {
+			t.Errorf("log contains secret %q:\n%s", secret, log)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -23,15 +24,67 @@ type AdmissionRule struct {
 }
 
 // Get the current admission policy.
-func GetAdmission(c api.Client) (AdmissionPolicy, error) {
+func GetAdmission(ctx context.Context, c api.Client) (AdmissionPolicy, error) {
 	var ans AdmissionPolicy
-	if err := c.Request(http.MethodGet, AdmissionEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting admission policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, AdmissionEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting admission policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current admission policy.
-func UpdateAdmission(c api.Client, policy AdmissionPolicy) error {
-	return c.Request(http.MethodPut, AdmissionEndpoint, nil, policy, nil)
+func UpdateAdmission(ctx context.Context, c api.Client, policy AdmissionPolicy) error {
+	return c.UpdateDocument(ctx, AdmissionEndpoint, policy)
+}
+
+// Get an admission policy rule by name.
+func GetAdmissionRule(ctx context.Context, c api.Client, name string) (*AdmissionRule, error) {
+	var ans AdmissionRule
+	if err := getRule(ctx, c, AdmissionEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting admission policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add an admission policy rule, leaving the other rules of the policy as
+// they are. Fails if the policy already has a rule with the same name.
+func CreateAdmissionRule(ctx context.Context, c api.Client, rule AdmissionRule, position RulePosition) error {
+	if err := createRule(ctx, c, AdmissionEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating admission policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace an admission policy rule, leaving the other rules of the
+// policy as they are.
+func PutAdmissionRule(ctx context.Context, c api.Client, rule AdmissionRule, position RulePosition) error {
+	if err := putRule(ctx, c, AdmissionEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating admission policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete an admission policy rule, leaving the other rules of the policy as
+// they are.
+func DeleteAdmissionRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, AdmissionEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting admission policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default admission policy.
+func DefaultAdmission() (AdmissionPolicy, error) {
+	var ans AdmissionPolicy
+	err := loadDefault("admission.json", &ans)
+	return ans, err
+}
+
+// Reset the admission policy to the Console default.
+func ResetAdmission(ctx context.Context, c api.Client) error {
+	policy, err := DefaultAdmission()
+	if err != nil {
+		return err
+	}
+	return UpdateAdmission(ctx, c, policy)
 }
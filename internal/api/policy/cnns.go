@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const (
+	CnnsEndpoint         = "api/v1/policies/firewall/network"
+	CnnsEntitiesEndpoint = "api/v1/policies/firewall/network/entities"
+)
+
+// Types of network entities.
+const (
+	CnnsEntityTypeContainer = "container"
+	CnnsEntityTypeDns       = "dns"
+	CnnsEntityTypeHost      = "host"
+	CnnsEntityTypeSubnet    = "subnet"
+)
+
+// The Cloud Native Network Segmentation (CNNS) policy, which controls the
+// traffic between containers, hosts and the network entities in its rules.
+type CnnsPolicy struct {
+	ContainerEnabled            bool       `json:"containerEnabled"`
+	ContainerEnforcementEnabled bool       `json:"containerEnforcementEnabled"`
+	ContainerRules              []CnnsRule `json:"containerRules"`
+	HostEnabled                 bool       `json:"hostEnabled"`
+	HostEnforcementEnabled      bool       `json:"hostEnforcementEnabled"`
+	HostRules                   []CnnsRule `json:"hostRules"`
+	Id                          string     `json:"_id,omitempty"`
+}
+
+// A rule for the traffic from one network entity to another, which are
+// referenced by name.
+type CnnsRule struct {
+	Destination string     `json:"dst,omitempty"`
+	Disabled    bool       `json:"disabled"`
+	Effect      string     `json:"effect,omitempty"`
+	Id          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Ports       []CnnsPort `json:"ports,omitempty"`
+	Source      string     `json:"src,omitempty"`
+}
+
+type CnnsPort struct {
+	End   int `json:"end,omitempty"`
+	Start int `json:"start,omitempty"`
+}
+
+// A network entity is a group of containers or hosts selected by collections,
+// a subnet, or a DNS name.
+type CnnsEntity struct {
+	Collections []collection.Collection `json:"collections,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Domain      string                  `json:"domain,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Subnet      string                  `json:"subnet,omitempty"`
+	Type        string                  `json:"type,omitempty"`
+}
+
+// Get the current CNNS policy.
+func GetCnns(ctx context.Context, c api.Client) (CnnsPolicy, error) {
+	var ans CnnsPolicy
+	if err := c.Request(ctx, http.MethodGet, CnnsEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CNNS policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current CNNS policy.
+func UpdateCnns(ctx context.Context, c api.Client, policy CnnsPolicy) error {
+	return c.UpdateDocument(ctx, CnnsEndpoint, policy)
+}
+
+// Reset the CNNS policy to the Console default, which is disabled and has no
+// rules.
+func ResetCnns(ctx context.Context, c api.Client) error {
+	var policy CnnsPolicy
+	return c.ModifyDocument(ctx, CnnsEndpoint, &policy, func() (interface{}, error) {
+		return CnnsPolicy{
+			ContainerRules: []CnnsRule{},
+			HostRules:      []CnnsRule{},
+			Id:             policy.Id,
+		}, nil
+	})
+}
+
+// Get all network entities.
+func ListCnnsEntities(ctx context.Context, c api.Client) ([]CnnsEntity, error) {
+	var ans []CnnsEntity
+	if err := c.Request(ctx, http.MethodGet, CnnsEntitiesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing network entities: %w", err)
+	}
+	return ans, nil
+}
+
+// Get a specific network entity.
+func GetCnnsEntity(ctx context.Context, c api.Client, name string) (*CnnsEntity, error) {
+	entities, err := ListCnnsEntities(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range entities {
+		if val.Name == name {
+			return &val, nil
+		}
+	}
+	return nil, api.NotFoundError("network entity '%s' not found", name)
+}
+
+// Create a new network entity.
+func CreateCnnsEntity(ctx context.Context, c api.Client, entity CnnsEntity) error {
+	return c.Request(ctx, http.MethodPost, CnnsEntitiesEndpoint, nil, entity, nil)
+}
+
+// Update an existing network entity.
+func UpdateCnnsEntity(ctx context.Context, c api.Client, entity CnnsEntity) error {
+	return c.Request(ctx, http.MethodPut, fmt.Sprintf("%s/%s", CnnsEntitiesEndpoint, entity.Name), nil, entity, nil)
+}
+
+// Delete an existing network entity.
+func DeleteCnnsEntity(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", CnnsEntitiesEndpoint, name), nil, nil, nil)
+}
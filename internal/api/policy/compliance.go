@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -43,71 +44,335 @@ type ComplianceCheck struct {
 }
 
 // Get the current CI image compliance policy.
-func GetComplianceCiImage(c api.Client) (CompliancePolicy, error) {
+func GetComplianceCiImage(ctx context.Context, c api.Client) (CompliancePolicy, error) {
 	var ans CompliancePolicy
-	if err := c.Request(http.MethodGet, ComplianceCiImagesEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting CI image compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceCiImagesEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI image compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current CI serverless compliance policy.
-func GetComplianceCiServerless(c api.Client) (CompliancePolicy, error) {
+func GetComplianceCiServerless(ctx context.Context, c api.Client) (CompliancePolicy, error) {
 	var ans CompliancePolicy
-	if err := c.Request(http.MethodGet, ComplianceCiServerlessEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting CI serverless compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceCiServerlessEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI serverless compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current container compliance policy.
-func GetComplianceContainer(c api.Client) (CompliancePolicy, error) {
+func GetComplianceContainer(ctx context.Context, c api.Client) (CompliancePolicy, error) {
 	var ans CompliancePolicy
-	if err := c.Request(http.MethodGet, ComplianceContainerEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting container compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceContainerEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting container compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current host compliance policy.
-func GetComplianceHost(c api.Client) (CompliancePolicy, error) {
+func GetComplianceHost(ctx context.Context, c api.Client) (CompliancePolicy, error) {
 	var ans CompliancePolicy
-	if err := c.Request(http.MethodGet, ComplianceHostEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting host compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceHostEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting host compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current serverless compliance policy.
-func GetComplianceServerless(c api.Client) (CompliancePolicy, error) {
+func GetComplianceServerless(ctx context.Context, c api.Client) (CompliancePolicy, error) {
 	var ans CompliancePolicy
-	if err := c.Request(http.MethodGet, ComplianceServerlessEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting serverless compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceServerlessEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting serverless compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current CI image compliance policy.
-func UpdateComplianceCiImage(c api.Client, policy CompliancePolicy) error {
-	return c.Request(http.MethodPut, ComplianceCiImagesEndpoint, nil, policy, nil)
+func UpdateComplianceCiImage(ctx context.Context, c api.Client, policy CompliancePolicy) error {
+	return c.UpdateDocument(ctx, ComplianceCiImagesEndpoint, policy)
 }
 
 // Update the current CI serverless compliance policy.
-func UpdateComplianceCiServerless(c api.Client, policy CompliancePolicy) error {
-	return c.Request(http.MethodPut, ComplianceCiServerlessEndpoint, nil, policy, nil)
+func UpdateComplianceCiServerless(ctx context.Context, c api.Client, policy CompliancePolicy) error {
+	return c.UpdateDocument(ctx, ComplianceCiServerlessEndpoint, policy)
 }
 
 // Update the current container compliance policy.
-func UpdateComplianceContainer(c api.Client, policy CompliancePolicy) error {
-	return c.Request(http.MethodPut, ComplianceContainerEndpoint, nil, policy, nil)
+func UpdateComplianceContainer(ctx context.Context, c api.Client, policy CompliancePolicy) error {
+	return c.UpdateDocument(ctx, ComplianceContainerEndpoint, policy)
 }
 
 // Update the current host compliance policy.
-func UpdateComplianceHost(c api.Client, policy CompliancePolicy) error {
-	return c.Request(http.MethodPut, ComplianceHostEndpoint, nil, policy, nil)
+func UpdateComplianceHost(ctx context.Context, c api.Client, policy CompliancePolicy) error {
+	return c.UpdateDocument(ctx, ComplianceHostEndpoint, policy)
 }
 
 // Update the current serverless compliance policy.
-func UpdateComplianceServerless(c api.Client, policy CompliancePolicy) error {
-	return c.Request(http.MethodPut, ComplianceServerlessEndpoint, nil, policy, nil)
+func UpdateComplianceServerless(ctx context.Context, c api.Client, policy CompliancePolicy) error {
+	return c.UpdateDocument(ctx, ComplianceServerlessEndpoint, policy)
+}
+
+// Get a container compliance policy rule by name.
+func GetComplianceContainerRule(ctx context.Context, c api.Client, name string) (*ComplianceRule, error) {
+	var ans ComplianceRule
+	if err := getRule(ctx, c, ComplianceContainerEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting container compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a container compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceContainerRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceContainerEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating container compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a container compliance policy rule, leaving the other rules
+// of the policy as they are.
+func PutComplianceContainerRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceContainerEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating container compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a container compliance policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteComplianceContainerRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceContainerEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting container compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a host compliance policy rule by name.
+func GetComplianceHostRule(ctx context.Context, c api.Client, name string) (*ComplianceRule, error) {
+	var ans ComplianceRule
+	if err := getRule(ctx, c, ComplianceHostEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting host compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a host compliance policy rule, leaving the other rules of the policy
+// as they are. Fails if the policy already has a rule with the same name.
+func CreateComplianceHostRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating host compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a host compliance policy rule, leaving the other rules of
+// the policy as they are.
+func PutComplianceHostRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating host compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a host compliance policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteComplianceHostRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceHostEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting host compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI image compliance policy rule by name.
+func GetComplianceCiImageRule(ctx context.Context, c api.Client, name string) (*ComplianceRule, error) {
+	var ans ComplianceRule
+	if err := getRule(ctx, c, ComplianceCiImagesEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI image compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI image compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceCiImageRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceCiImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI image compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI image compliance policy rule, leaving the other rules
+// of the policy as they are.
+func PutComplianceCiImageRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceCiImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI image compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI image compliance policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteComplianceCiImageRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceCiImagesEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI image compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a serverless compliance policy rule by name.
+func GetComplianceServerlessRule(ctx context.Context, c api.Client, name string) (*ComplianceRule, error) {
+	var ans ComplianceRule
+	if err := getRule(ctx, c, ComplianceServerlessEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting serverless compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a serverless compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceServerlessRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a serverless compliance policy rule, leaving the other
+// rules of the policy as they are.
+func PutComplianceServerlessRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a serverless compliance policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteComplianceServerlessRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceServerlessEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI serverless compliance policy rule by name.
+func GetComplianceCiServerlessRule(ctx context.Context, c api.Client, name string) (*ComplianceRule, error) {
+	var ans ComplianceRule
+	if err := getRule(ctx, c, ComplianceCiServerlessEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI serverless compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI serverless compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceCiServerlessRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceCiServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI serverless compliance policy rule, leaving the other
+// rules of the policy as they are.
+func PutComplianceCiServerlessRule(ctx context.Context, c api.Client, rule ComplianceRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceCiServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI serverless compliance policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteComplianceCiServerlessRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceCiServerlessEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI serverless compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default CI image compliance policy.
+func DefaultComplianceCiImage() (CompliancePolicy, error) {
+	var ans CompliancePolicy
+	err := loadDefault("compliance_ci_images.json", &ans)
+	return ans, err
+}
+
+// Get the Console default CI serverless compliance policy.
+func DefaultComplianceCiServerless() (CompliancePolicy, error) {
+	var ans CompliancePolicy
+	err := loadDefault("compliance_ci_serverless.json", &ans)
+	return ans, err
+}
+
+// Get the Console default container compliance policy.
+func DefaultComplianceContainer() (CompliancePolicy, error) {
+	var ans CompliancePolicy
+	err := loadDefault("compliance_container.json", &ans)
+	return ans, err
+}
+
+// Get the Console default host compliance policy.
+func DefaultComplianceHost() (CompliancePolicy, error) {
+	var ans CompliancePolicy
+	err := loadDefault("compliance_host.json", &ans)
+	return ans, err
+}
+
+// Get the Console default serverless compliance policy.
+func DefaultComplianceServerless() (CompliancePolicy, error) {
+	var ans CompliancePolicy
+	err := loadDefault("compliance_serverless.json", &ans)
+	return ans, err
+}
+
+// Reset the CI image compliance policy to the Console default.
+func ResetComplianceCiImage(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceCiImage()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceCiImage(ctx, c, policy)
+}
+
+// Reset the CI serverless compliance policy to the Console default.
+func ResetComplianceCiServerless(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceCiServerless()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceCiServerless(ctx, c, policy)
+}
+
+// Reset the container compliance policy to the Console default.
+func ResetComplianceContainer(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceContainer()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceContainer(ctx, c, policy)
+}
+
+// Reset the host compliance policy to the Console default.
+func ResetComplianceHost(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceHost()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceHost(ctx, c, policy)
+}
+
+// Reset the serverless compliance policy to the Console default.
+func ResetComplianceServerless(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceServerless()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceServerless(ctx, c, policy)
 }
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -53,29 +54,135 @@ type ComplianceCoderepoGraceDaysPolicy struct {
 }
 
 // Get the current CI coderepo compliance policy.
-func GetComplianceCiCoderepo(c api.Client) (ComplianceCoderepoPolicy, error) {
+func GetComplianceCiCoderepo(ctx context.Context, c api.Client) (ComplianceCoderepoPolicy, error) {
 	var ans ComplianceCoderepoPolicy
-	if err := c.Request(http.MethodGet, ComplianceCiCodereposEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting CI coderepo compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceCiCodereposEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI coderepo compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current coderepo compliance policy.
-func GetComplianceCoderepo(c api.Client) (ComplianceCoderepoPolicy, error) {
+func GetComplianceCoderepo(ctx context.Context, c api.Client) (ComplianceCoderepoPolicy, error) {
 	var ans ComplianceCoderepoPolicy
-	if err := c.Request(http.MethodGet, ComplianceCodereposEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting coderepo compliance policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, ComplianceCodereposEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting coderepo compliance policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current CI coderepo compliance policy.
-func UpdateComplianceCiCoderepo(c api.Client, policy ComplianceCoderepoPolicy) error {
-	return c.Request(http.MethodPut, ComplianceCiCodereposEndpoint, nil, policy, nil)
+func UpdateComplianceCiCoderepo(ctx context.Context, c api.Client, policy ComplianceCoderepoPolicy) error {
+	return c.UpdateDocument(ctx, ComplianceCiCodereposEndpoint, policy)
 }
 
 // Update the current coderepo compliance policy.
-func UpdateComplianceCoderepo(c api.Client, policy ComplianceCoderepoPolicy) error {
-	return c.Request(http.MethodPut, ComplianceCodereposEndpoint, nil, policy, nil)
+func UpdateComplianceCoderepo(ctx context.Context, c api.Client, policy ComplianceCoderepoPolicy) error {
+	return c.UpdateDocument(ctx, ComplianceCodereposEndpoint, policy)
+}
+
+// Get a coderepo compliance policy rule by name.
+func GetComplianceCoderepoRule(ctx context.Context, c api.Client, name string) (*ComplianceCoderepoRule, error) {
+	var ans ComplianceCoderepoRule
+	if err := getRule(ctx, c, ComplianceCodereposEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting coderepo compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a coderepo compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceCoderepoRule(ctx context.Context, c api.Client, rule ComplianceCoderepoRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a coderepo compliance policy rule, leaving the other rules
+// of the policy as they are.
+func PutComplianceCoderepoRule(ctx context.Context, c api.Client, rule ComplianceCoderepoRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a coderepo compliance policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteComplianceCoderepoRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceCodereposEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI coderepo compliance policy rule by name.
+func GetComplianceCiCoderepoRule(ctx context.Context, c api.Client, name string) (*ComplianceCoderepoRule, error) {
+	var ans ComplianceCoderepoRule
+	if err := getRule(ctx, c, ComplianceCiCodereposEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI coderepo compliance policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI coderepo compliance policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateComplianceCiCoderepoRule(ctx context.Context, c api.Client, rule ComplianceCoderepoRule, position RulePosition) error {
+	if err := createRule(ctx, c, ComplianceCiCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI coderepo compliance policy rule, leaving the other
+// rules of the policy as they are.
+func PutComplianceCiCoderepoRule(ctx context.Context, c api.Client, rule ComplianceCoderepoRule, position RulePosition) error {
+	if err := putRule(ctx, c, ComplianceCiCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI coderepo compliance policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteComplianceCiCoderepoRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, ComplianceCiCodereposEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI coderepo compliance policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default CI coderepo compliance policy.
+func DefaultComplianceCiCoderepo() (ComplianceCoderepoPolicy, error) {
+	var ans ComplianceCoderepoPolicy
+	err := loadDefault("compliance_ci_coderepos.json", &ans)
+	return ans, err
+}
+
+// Get the Console default coderepo compliance policy.
+func DefaultComplianceCoderepo() (ComplianceCoderepoPolicy, error) {
+	var ans ComplianceCoderepoPolicy
+	err := loadDefault("compliance_coderepos.json", &ans)
+	return ans, err
+}
+
+// Reset the CI coderepo compliance policy to the Console default.
+func ResetComplianceCiCoderepo(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceCiCoderepo()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceCiCoderepo(ctx, c, policy)
+}
+
+// Reset the coderepo compliance policy to the Console default.
+func ResetComplianceCoderepo(ctx context.Context, c api.Client) error {
+	policy, err := DefaultComplianceCoderepo()
+	if err != nil {
+		return err
+	}
+	return UpdateComplianceCoderepo(ctx, c, policy)
 }
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -18,17 +19,17 @@ type CustomCompliance struct {
 }
 
 // Get all custom Compliances.
-func ListCustomCompliance(c api.Client) ([]CustomCompliance, error) {
+func ListCustomCompliance(ctx context.Context, c api.Client) ([]CustomCompliance, error) {
 	var ans []CustomCompliance
-	if err := c.Request(http.MethodGet, CustomCompliancesEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing custom Compliances: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CustomCompliancesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing custom Compliances: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific custom Compliance by ID.
-func GetCustomComplianceById(c api.Client, id int) (*CustomCompliance, error) {
-	compliances, err := ListCustomCompliance(c)
+func GetCustomComplianceById(ctx context.Context, c api.Client, id int) (*CustomCompliance, error) {
+	compliances, err := ListCustomCompliance(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -37,12 +38,12 @@ func GetCustomComplianceById(c api.Client, id int) (*CustomCompliance, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("custom Compliance '%d' not found", id)
+	return nil, api.NotFoundError("custom Compliance '%d' not found", id)
 }
 
 // Get a specific custom Compliance by name.
-func GetCustomComplianceByName(c api.Client, name string) (*CustomCompliance, error) {
-	compliances, err := ListCustomCompliance(c)
+func GetCustomComplianceByName(ctx context.Context, c api.Client, name string) (*CustomCompliance, error) {
+	compliances, err := ListCustomCompliance(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -51,19 +52,19 @@ func GetCustomComplianceByName(c api.Client, name string) (*CustomCompliance, er
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("custom Compliance '%s' not found", name)
+	return nil, api.NotFoundError("custom Compliance '%s' not found", name)
 }
 
 // Create a new custom compliance.
-// func CreateCustomCompliance(c api.Client, compliance CustomCompliance) (int, error) {
-func CreateCustomCompliance(c api.Client, compliance CustomCompliance) error {
-	return UpdateCustomCompliance(c, compliance)
+// func CreateCustomCompliance(ctx context.Context, c api.Client, compliance CustomCompliance) (int, error) {
+func CreateCustomCompliance(ctx context.Context, c api.Client, compliance CustomCompliance) error {
+	return UpdateCustomCompliance(ctx, c, compliance)
 }
 
 // Helper method to generate an ID for new custom Compliance.
 // Finds the maximum custom Compliance ID and increments it by 1.
-func GenerateCustomComplianceId(c api.Client) (int, error) {
-	compliances, err := ListCustomCompliance(c)
+func GenerateCustomComplianceId(ctx context.Context, c api.Client) (int, error) {
+	compliances, err := ListCustomCompliance(ctx, c)
 	if err != nil {
 		return -1, err
 	}
@@ -79,15 +80,15 @@ func GenerateCustomComplianceId(c api.Client) (int, error) {
 }
 
 // Update an existing custom Compliance.
-func UpdateCustomCompliance(c api.Client, compliance CustomCompliance) error {
+func UpdateCustomCompliance(ctx context.Context, c api.Client, compliance CustomCompliance) error {
 	var ans CustomCompliance
 
-	return c.Request(http.MethodPut, CustomCompliancesEndpoint, nil, compliance, &ans)
+	return c.Request(ctx, http.MethodPut, CustomCompliancesEndpoint, nil, compliance, &ans)
 }
 
 // Delete an existing custom Compliance.
-func DeleteCustomCompliance(c api.Client, name string) error {
-	compliances, err := ListCustomCompliance(c)
+func DeleteCustomCompliance(ctx context.Context, c api.Client, name string) error {
+	compliances, err := ListCustomCompliance(ctx, c)
 	if err != nil {
 		return err
 	}
@@ -103,5 +104,5 @@ func DeleteCustomCompliance(c api.Client, name string) error {
 		}
 	}
 
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%d", CustomCompliancesEndpoint, id), nil, nil, nil)
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", CustomCompliancesEndpoint, id), nil, nil, nil)
 }
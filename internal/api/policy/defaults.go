@@ -0,0 +1,26 @@
+package policy
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+)
+
+// Console version the default policy fixtures were captured from.
+const DefaultsVersion = "22.01"
+
+//go:embed defaults
+var defaultsFS embed.FS
+
+// Load the default policy fixture with the given file name into out.
+func loadDefault(name string, out interface{}) error {
+	b, err := defaultsFS.ReadFile(path.Join("defaults", DefaultsVersion, name))
+	if err != nil {
+		return fmt.Errorf("error reading default policy '%s': %s", name, err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("error parsing default policy '%s': %s", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+type defaultPolicyCase struct {
+	fixture  string
+	endpoint string
+	policy   func() (interface{}, error)
+	reset    func(context.Context, api.Client) error
+}
+
+var defaultPolicyCases = []defaultPolicyCase{
+	{"admission.json", AdmissionEndpoint, func() (interface{}, error) { return DefaultAdmission() }, ResetAdmission},
+	{"compliance_ci_images.json", ComplianceCiImagesEndpoint, func() (interface{}, error) { return DefaultComplianceCiImage() }, ResetComplianceCiImage},
+	{"compliance_ci_serverless.json", ComplianceCiServerlessEndpoint, func() (interface{}, error) { return DefaultComplianceCiServerless() }, ResetComplianceCiServerless},
+	{"compliance_container.json", ComplianceContainerEndpoint, func() (interface{}, error) { return DefaultComplianceContainer() }, ResetComplianceContainer},
+	{"compliance_host.json", ComplianceHostEndpoint, func() (interface{}, error) { return DefaultComplianceHost() }, ResetComplianceHost},
+	{"compliance_serverless.json", ComplianceServerlessEndpoint, func() (interface{}, error) { return DefaultComplianceServerless() }, ResetComplianceServerless},
+	{"compliance_ci_coderepos.json", ComplianceCiCodereposEndpoint, func() (interface{}, error) { return DefaultComplianceCiCoderepo() }, ResetComplianceCiCoderepo},
+	{"compliance_coderepos.json", ComplianceCodereposEndpoint, func() (interface{}, error) { return DefaultComplianceCoderepo() }, ResetComplianceCoderepo},
+	{"runtime_container.json", RuntimeContainerEndpoint, func() (interface{}, error) { return DefaultRuntimeContainer() }, ResetRuntimeContainer},
+	{"runtime_host.json", RuntimeHostEndpoint, func() (interface{}, error) { return DefaultRuntimeHost() }, ResetRuntimeHost},
+	{"runtime_serverless.json", RuntimeServerlessEndpoint, func() (interface{}, error) { return DefaultRuntimeServerless() }, ResetRuntimeServerless},
+	{"runtime_app_embedded.json", RuntimeAppEmbeddedEndpoint, func() (interface{}, error) { return DefaultRuntimeAppEmbedded() }, ResetRuntimeAppEmbedded},
+	{"vulnerability_ci_coderepos.json", VulnerabilityCiCodereposEndpoint, func() (interface{}, error) { return DefaultVulnerabilityCiCoderepo() }, ResetVulnerabilityCiCoderepo},
+	{"vulnerability_coderepos.json", VulnerabilityCodereposEndpoint, func() (interface{}, error) { return DefaultVulnerabilityCoderepo() }, ResetVulnerabilityCoderepo},
+	{"vulnerability_host.json", VulnerabilityHostEndpoint, func() (interface{}, error) { return DefaultVulnerabilityHost() }, ResetVulnerabilityHost},
+	{"vulnerability_ci_images.json", VulnerabilityCiImagesEndpoint, func() (interface{}, error) { return DefaultVulnerabilityCiImage() }, ResetVulnerabilityCiImage},
+	{"vulnerability_images.json", VulnerabilityImagesEndpoint, func() (interface{}, error) { return DefaultVulnerabilityImage() }, ResetVulnerabilityImage},
+	{"vulnerability_ci_serverless.json", VulnerabilityCiServerlessEndpoint, func() (interface{}, error) { return DefaultVulnerabilityCiServerless() }, ResetVulnerabilityCiServerless},
+	{"vulnerability_serverless.json", VulnerabilityServerlessEndpoint, func() (interface{}, error) { return DefaultVulnerabilityServerless() }, ResetVulnerabilityServerless},
+}
+
+func TestDefaultPolicyFixtures(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, tc := range defaultPolicyCases {
+		covered[tc.fixture] = true
+
+		ans, err := tc.policy()
+		if err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+
+		// Every field in the fixture must map onto the SDK type, otherwise
+		// it would be silently dropped when resetting the policy.
+		b, err := defaultsFS.ReadFile(path.Join("defaults", DefaultsVersion, tc.fixture))
+		if err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		strict := reflect.New(reflect.TypeOf(ans))
+		if err := dec.Decode(strict.Interface()); err != nil {
+			t.Errorf("%s: %s", tc.fixture, err)
+		}
+
+		rules := reflect.ValueOf(ans).FieldByName("Rules")
+		if rules.Len() == 0 {
+			t.Errorf("%s: default policy has no rules", tc.fixture)
+		}
+	}
+
+	files, err := fs.ReadDir(defaultsFS, path.Join("defaults", DefaultsVersion))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if !covered[f.Name()] {
+			t.Errorf("fixture %s is not used by any default policy", f.Name())
+		}
+	}
+}
+
+func TestResetPolicies(t *testing.T) {
+	for _, tc := range defaultPolicyCases {
+		var method, endpoint string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			endpoint = strings.TrimPrefix(r.URL.Path, "/")
+			body, _ = io.ReadAll(r.Body)
+		}))
+
+		c := api.Client{
+			Config:     api.APIClientConfig{ConsoleURL: server.URL},
+			HTTPClient: server.Client(),
+		}
+		if err := tc.reset(context.Background(), c); err != nil {
+			t.Errorf("%s: %s", tc.fixture, err)
+		}
+		server.Close()
+
+		if method != http.MethodPut || endpoint != tc.endpoint {
+			t.Errorf("%s: got %s %s, expected %s %s", tc.fixture, method, endpoint, http.MethodPut, tc.endpoint)
+		}
+
+		want, _ := tc.policy()
+		got := reflect.New(reflect.TypeOf(want))
+		if err := json.Unmarshal(body, got.Interface()); err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+		if !reflect.DeepEqual(got.Elem().Interface(), want) {
+			t.Errorf("%s: request body does not match the default policy", tc.fixture)
+		}
+	}
+}
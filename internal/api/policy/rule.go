@@ -0,0 +1,209 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+// Positions of a rule among the other rules of a policy. The Console applies
+// the first rule that matches, so a rule has to be above broader rules, such
+// as the default rule for all collections, to take effect.
+const (
+	RulePositionTop    = "top"
+	RulePositionBottom = "bottom"
+	RulePositionBefore = "before"
+	RulePositionAfter  = "after"
+)
+
+// Where to put a rule in a policy.
+type RulePosition struct {
+	// One of the RulePosition constants. If empty, an existing rule stays
+	// where it is and a new rule is added to the top.
+	Position string
+	// The rule to put the rule before or after.
+	RelativeTo string
+}
+
+// The parts of a policy document needed to manage single rules. Other fields
+// of the policy and other rules are kept as they are, including fields the SDK
+// does not know about.
+type ruleDocument struct {
+	fields map[string]json.RawMessage
+	rules  []json.RawMessage
+	names  []string
+}
+
+func (doc *ruleDocument) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &doc.fields); err != nil {
+		return err
+	}
+	doc.rules = nil
+	if rules, ok := doc.fields["rules"]; ok {
+		if err := json.Unmarshal(rules, &doc.rules); err != nil {
+			return err
+		}
+	}
+	doc.names = make([]string, 0, len(doc.rules))
+	for _, rule := range doc.rules {
+		var r struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(rule, &r); err != nil {
+			return err
+		}
+		doc.names = append(doc.names, r.Name)
+	}
+	return nil
+}
+
+func (doc ruleDocument) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]json.RawMessage, len(doc.fields)+1)
+	for k, v := range doc.fields {
+		fields[k] = v
+	}
+	rules, err := json.Marshal(doc.rules)
+	if err != nil {
+		return nil, err
+	}
+	fields["rules"] = rules
+	return json.Marshal(fields)
+}
+
+// Get the index of the named rule, or -1 if the policy has no such rule.
+func (doc ruleDocument) index(name string) int {
+	for i, n := range doc.names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (doc *ruleDocument) remove(i int) {
+	doc.rules = append(doc.rules[:i], doc.rules[i+1:]...)
+	doc.names = append(doc.names[:i], doc.names[i+1:]...)
+}
+
+func (doc *ruleDocument) insert(i int, name string, rule json.RawMessage) {
+	doc.rules = append(doc.rules[:i], append([]json.RawMessage{rule}, doc.rules[i:]...)...)
+	doc.names = append(doc.names[:i], append([]string{name}, doc.names[i:]...)...)
+}
+
+// Get the index to insert a rule at after it was removed from the policy.
+// current is the index the rule had, or -1 for a new rule.
+func (doc ruleDocument) insertIndex(current int, position RulePosition) (int, error) {
+	switch position.Position {
+	case "":
+		if current >= 0 {
+			return current, nil
+		}
+		return 0, nil
+	case RulePositionTop:
+		return 0, nil
+	case RulePositionBottom:
+		return len(doc.rules), nil
+	case RulePositionBefore, RulePositionAfter:
+		i := doc.index(position.RelativeTo)
+		if i < 0 {
+			return 0, fmt.Errorf("rule '%s' to put the rule %s not found", position.RelativeTo, position.Position)
+		}
+		if position.Position == RulePositionAfter {
+			i++
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("invalid rule position '%s'", position.Position)
+	}
+}
+
+// Get the named rule of the policy at endpoint.
+func getRule(ctx context.Context, c api.Client, endpoint, name string, rule interface{}) error {
+	var doc ruleDocument
+	if err := c.Request(ctx, http.MethodGet, endpoint, nil, nil, &doc); err != nil {
+		return err
+	}
+	i := doc.index(name)
+	if i < 0 {
+		return api.NotFoundError("rule '%s' not found", name)
+	}
+	return json.Unmarshal(doc.rules[i], rule)
+}
+
+// Add the named rule to the policy at endpoint. Fails if the policy already
+// has a rule with the same name, so that a rule managed elsewhere is not
+// taken over.
+func createRule(ctx context.Context, c api.Client, endpoint, name string, rule interface{}, position RulePosition) error {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	var doc ruleDocument
+	return c.ModifyDocument(ctx, endpoint, &doc, func() (interface{}, error) {
+		if doc.index(name) >= 0 {
+			return nil, api.ConflictError("rule '%s' already exists", name)
+		}
+		i, err := doc.insertIndex(-1, position)
+		if err != nil {
+			return nil, err
+		}
+		doc.insert(i, name, b)
+		return doc, nil
+	})
+}
+
+// Add or replace the named rule of the policy at endpoint. If ctx expects a
+// digest, the current rule is decoded into the type of rule and checked
+// against it.
+func putRule(ctx context.Context, c api.Client, endpoint, name string, rule interface{}, position RulePosition) error {
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	var doc ruleDocument
+	return c.ModifyDocument(ctx, endpoint, &doc, func() (interface{}, error) {
+		current := doc.index(name)
+		if err := checkRuleDigest(ctx, endpoint, name, doc, current, reflect.TypeOf(rule)); err != nil {
+			return nil, err
+		}
+		if current >= 0 {
+			doc.remove(current)
+		}
+		i, err := doc.insertIndex(current, position)
+		if err != nil {
+			return nil, err
+		}
+		doc.insert(i, name, b)
+		return doc, nil
+	})
+}
+
+// Check the named rule at index i of the policy at endpoint, decoded into a
+// value of type t, against the digest expected by ctx. A rule that no longer
+// exists was changed too.
+func checkRuleDigest(ctx context.Context, endpoint, name string, doc ruleDocument, i int, t reflect.Type) error {
+	var current interface{}
+	if i >= 0 {
+		rule := reflect.New(t)
+		if err := json.Unmarshal(doc.rules[i], rule.Interface()); err != nil {
+			return err
+		}
+		current = rule.Elem().Interface()
+	}
+	return api.CheckDigest(ctx, fmt.Sprintf("rule '%s' of %s", name, endpoint), current)
+}
+
+// Delete the named rule of the policy at endpoint, if it exists.
+func deleteRule(ctx context.Context, c api.Client, endpoint, name string) error {
+	var doc ruleDocument
+	return c.ModifyDocument(ctx, endpoint, &doc, func() (interface{}, error) {
+		if i := doc.index(name); i >= 0 {
+			doc.remove(i)
+		}
+		return doc, nil
+	})
+}
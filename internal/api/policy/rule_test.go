@@ -0,0 +1,192 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+// Serve a single policy document that PUT replaces.
+func newRuleTestClient(t *testing.T, doc string) (api.Client, func() map[string]interface{}) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			io.WriteString(w, doc)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			doc = string(b)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := api.Client{
+		Config:     api.APIClientConfig{ConsoleURL: server.URL},
+		HTTPClient: server.Client(),
+	}
+	current := func() map[string]interface{} {
+		var ans map[string]interface{}
+		if err := json.Unmarshal([]byte(doc), &ans); err != nil {
+			t.Fatalf("error decoding document: %s", err)
+		}
+		return ans
+	}
+	return c, current
+}
+
+func ruleNames(doc map[string]interface{}) []string {
+	names := make([]string, 0)
+	for _, rule := range doc["rules"].([]interface{}) {
+		names = append(names, rule.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestPutRule(t *testing.T) {
+	cases := []struct {
+		name     string
+		position RulePosition
+		expected []string
+	}{
+		{"new", RulePosition{}, []string{"new", "a", "b", "Default"}},
+		{"new", RulePosition{Position: RulePositionTop}, []string{"new", "a", "b", "Default"}},
+		{"new", RulePosition{Position: RulePositionBottom}, []string{"a", "b", "Default", "new"}},
+		{"new", RulePosition{Position: RulePositionBefore, RelativeTo: "b"}, []string{"a", "new", "b", "Default"}},
+		{"new", RulePosition{Position: RulePositionAfter, RelativeTo: "b"}, []string{"a", "b", "new", "Default"}},
+		{"b", RulePosition{}, []string{"a", "b", "Default"}},
+		{"b", RulePosition{Position: RulePositionTop}, []string{"b", "a", "Default"}},
+		{"a", RulePosition{Position: RulePositionAfter, RelativeTo: "b"}, []string{"b", "a", "Default"}},
+		{"Default", RulePosition{Position: RulePositionBefore, RelativeTo: "a"}, []string{"Default", "a", "b"}},
+	}
+	for _, tc := range cases {
+		c, current := newRuleTestClient(t, `{"learningDisabled":true,"rules":[{"name":"a"},{"name":"b","unknownField":1},{"name":"Default"}]}`)
+
+		rule := RuntimeContainerRule{Name: tc.name, Notes: "updated"}
+		if err := PutRuntimeContainerRule(context.Background(), c, rule, tc.position); err != nil {
+			t.Fatalf("%s %+v: %s", tc.name, tc.position, err)
+		}
+
+		doc := current()
+		if actual := ruleNames(doc); !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s %+v: got rules %v, expected %v", tc.name, tc.position, actual, tc.expected)
+		}
+		if doc["learningDisabled"] != true {
+			t.Errorf("%s %+v: other fields of the policy were not kept", tc.name, tc.position)
+		}
+		for _, r := range doc["rules"].([]interface{}) {
+			r := r.(map[string]interface{})
+			switch {
+			case r["name"] == tc.name && r["notes"] != "updated":
+				t.Errorf("%s %+v: rule was not updated", tc.name, tc.position)
+			case r["name"] == "b" && tc.name != "b" && r["unknownField"] == nil:
+				t.Errorf("%s %+v: other rules were not kept as they are", tc.name, tc.position)
+			}
+		}
+	}
+}
+
+func TestPutRuleUnknownRelativeTo(t *testing.T) {
+	c, _ := newRuleTestClient(t, `{"rules":[{"name":"a"}]}`)
+	err := PutVulnerabilityImageRule(context.Background(), c, VulnerabilityImageRule{Name: "new"}, RulePosition{Position: RulePositionAfter, RelativeTo: "missing"})
+	if err == nil {
+		t.Error("expected an error for a missing relative rule")
+	}
+}
+
+func TestGetAndDeleteRule(t *testing.T) {
+	c, current := newRuleTestClient(t, `{"policyType":"containerVulnerability","rules":[{"name":"a","effect":"alert"},{"name":"b"}]}`)
+
+	rule, err := GetVulnerabilityImageRule(context.Background(), c, "a")
+	if err != nil {
+		t.Fatalf("error getting rule: %s", err)
+	}
+	if rule.Effect != "alert" {
+		t.Errorf("got effect %q, expected %q", rule.Effect, "alert")
+	}
+	if _, err := GetVulnerabilityImageRule(context.Background(), c, "missing"); !api.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if err := DeleteVulnerabilityImageRule(context.Background(), c, "a"); err != nil {
+		t.Fatalf("error deleting rule: %s", err)
+	}
+	doc := current()
+	if actual := ruleNames(doc); !reflect.DeepEqual(actual, []string{"b"}) {
+		t.Errorf("got rules %v, expected [b]", actual)
+	}
+	if doc["policyType"] != "containerVulnerability" {
+		t.Error("other fields of the policy were not kept")
+	}
+}
+
+func TestCreateRule(t *testing.T) {
+	c, current := newRuleTestClient(t, `{"rules":[{"name":"a"},{"name":"b","notes":"owned elsewhere"}]}`)
+
+	if err := CreateRuntimeContainerRule(context.Background(), c, RuntimeContainerRule{Name: "new"}, RulePosition{Position: RulePositionBottom}); err != nil {
+		t.Fatalf("error creating rule: %s", err)
+	}
+	if actual := ruleNames(current()); !reflect.DeepEqual(actual, []string{"a", "b", "new"}) {
+		t.Errorf("got rules %v, expected [a b new]", actual)
+	}
+
+	err := CreateRuntimeContainerRule(context.Background(), c, RuntimeContainerRule{Name: "b", Notes: "taken over"}, RulePosition{})
+	if !api.IsConflict(err) {
+		t.Errorf("expected a conflict error, got %v", err)
+	}
+	doc := current()
+	if actual := ruleNames(doc); !reflect.DeepEqual(actual, []string{"a", "b", "new"}) {
+		t.Errorf("got rules %v, expected [a b new]", actual)
+	}
+	if notes := doc["rules"].([]interface{})[1].(map[string]interface{})["notes"]; notes != "owned elsewhere" {
+		t.Errorf("existing rule was changed, notes are %v", notes)
+	}
+}
+
+func TestPutRuleConflict(t *testing.T) {
+	read := RuntimeContainerRule{Name: "a", Notes: "read"}
+	digest, err := api.Digest(read)
+	if err != nil {
+		t.Fatalf("error getting digest: %s", err)
+	}
+	ctx := api.WithDigest(context.Background(), digest)
+
+	cases := []struct {
+		name     string
+		doc      string
+		conflict bool
+	}{
+		{"unchanged", `{"rules":[{"name":"a","notes":"read"},{"name":"b"}]}`, false},
+		// Other rules are not compared.
+		{"other rule changed", `{"rules":[{"name":"a","notes":"read"},{"name":"b","notes":"changed"}]}`, false},
+		{"rule changed", `{"rules":[{"name":"a","notes":"changed"},{"name":"b"}]}`, true},
+		{"rule deleted", `{"rules":[{"name":"b"}]}`, true},
+	}
+	for _, tc := range cases {
+		c, current := newRuleTestClient(t, tc.doc)
+
+		err := PutRuntimeContainerRule(ctx, c, RuntimeContainerRule{Name: "a", Notes: "mine"}, RulePosition{})
+		rules := current()["rules"].([]interface{})
+		if tc.conflict {
+			if !api.IsConflict(err) {
+				t.Errorf("%s: expected a conflict error, got %v", tc.name, err)
+			}
+			for _, rule := range rules {
+				if rule.(map[string]interface{})["notes"] == "mine" {
+					t.Errorf("%s: rule was written despite the conflict", tc.name)
+				}
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: error putting rule: %s", tc.name, err)
+		}
+		if notes := rules[0].(map[string]interface{})["notes"]; notes != "mine" {
+			t.Errorf("%s: rule notes are %v, expected mine", tc.name, notes)
+		}
+	}
+}
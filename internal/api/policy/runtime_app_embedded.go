@@ -0,0 +1,100 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const RuntimeAppEmbeddedEndpoint = "api/v1/policies/runtime/app-embedded"
+
+type RuntimeAppEmbeddedPolicy struct {
+	Rules []RuntimeAppEmbeddedRule `json:"rules,omitempty"`
+}
+
+// App-Embedded runtime rules have the same sections as container runtime
+// rules.
+type RuntimeAppEmbeddedRule struct {
+	AdvancedProtectionEffect       string                       `json:"advancedProtectionEffect"`
+	CloudMetadataEnforcementEffect string                       `json:"cloudMetadataEnforcementEffect"`
+	Collections                    []collection.Collection      `json:"collections,omitempty"`
+	CustomRules                    []RuntimeContainerCustomRule `json:"customRules,omitempty"`
+	Disabled                       bool                         `json:"disabled"`
+	Dns                            RuntimeContainerDns          `json:"dns,omitempty"`
+	Filesystem                     RuntimeContainerFilesystem   `json:"filesystem,omitempty"`
+	Name                           string                       `json:"name,omitempty"`
+	Network                        RuntimeContainerNetwork      `json:"network,omitempty"`
+	Notes                          string                       `json:"notes,omitempty"`
+	PreviousName                   string                       `json:"previousName,omitempty"`
+	Processes                      RuntimeContainerProcesses    `json:"processes,omitempty"`
+}
+
+// Get the current App-Embedded runtime policy.
+func GetRuntimeAppEmbedded(ctx context.Context, c api.Client) (RuntimeAppEmbeddedPolicy, error) {
+	var ans RuntimeAppEmbeddedPolicy
+	if err := c.Request(ctx, http.MethodGet, RuntimeAppEmbeddedEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting App-Embedded runtime policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current App-Embedded runtime policy.
+func UpdateRuntimeAppEmbedded(ctx context.Context, c api.Client, policy RuntimeAppEmbeddedPolicy) error {
+	return c.UpdateDocument(ctx, RuntimeAppEmbeddedEndpoint, policy)
+}
+
+// Get an App-Embedded runtime policy rule by name.
+func GetRuntimeAppEmbeddedRule(ctx context.Context, c api.Client, name string) (*RuntimeAppEmbeddedRule, error) {
+	var ans RuntimeAppEmbeddedRule
+	if err := getRule(ctx, c, RuntimeAppEmbeddedEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting App-Embedded runtime policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add an App-Embedded runtime policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateRuntimeAppEmbeddedRule(ctx context.Context, c api.Client, rule RuntimeAppEmbeddedRule, position RulePosition) error {
+	if err := createRule(ctx, c, RuntimeAppEmbeddedEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating App-Embedded runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace an App-Embedded runtime policy rule, leaving the other
+// rules of the policy as they are.
+func PutRuntimeAppEmbeddedRule(ctx context.Context, c api.Client, rule RuntimeAppEmbeddedRule, position RulePosition) error {
+	if err := putRule(ctx, c, RuntimeAppEmbeddedEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating App-Embedded runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete an App-Embedded runtime policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteRuntimeAppEmbeddedRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, RuntimeAppEmbeddedEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting App-Embedded runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default App-Embedded runtime policy.
+func DefaultRuntimeAppEmbedded() (RuntimeAppEmbeddedPolicy, error) {
+	var ans RuntimeAppEmbeddedPolicy
+	err := loadDefault("runtime_app_embedded.json", &ans)
+	return ans, err
+}
+
+// Reset the App-Embedded runtime policy to the Console default.
+func ResetRuntimeAppEmbedded(ctx context.Context, c api.Client) error {
+	policy, err := DefaultRuntimeAppEmbedded()
+	if err != nil {
+		return err
+	}
+	return UpdateRuntimeAppEmbedded(ctx, c, policy)
+}
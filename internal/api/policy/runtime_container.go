@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -105,28 +106,84 @@ type RuntimeContainerDeniedList struct {
 }
 
 // Get the current container runtime policy.
-func GetRuntimeContainer(c api.Client) (RuntimeContainerPolicy, error) {
+func GetRuntimeContainer(ctx context.Context, c api.Client) (RuntimeContainerPolicy, error) {
 	var ans RuntimeContainerPolicy
-	if err := c.Request(http.MethodGet, RuntimeContainerEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting container runtime policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, RuntimeContainerEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting container runtime policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current container runtime policy.
-func UpdateRuntimeContainer(c api.Client, policy RuntimeContainerPolicy) error {
-	return c.Request(http.MethodPut, RuntimeContainerEndpoint, nil, policy, nil)
+func UpdateRuntimeContainer(ctx context.Context, c api.Client, policy RuntimeContainerPolicy) error {
+	return c.UpdateDocument(ctx, RuntimeContainerEndpoint, policy)
 }
 
 // Add new container runtime policy rule
-func SetRuntimeContainerRule(c api.Client, policy RuntimeContainerPolicy) error {
+func SetRuntimeContainerRule(ctx context.Context, c api.Client, policy RuntimeContainerPolicy) error {
+	unlock := c.Lock(RuntimeContainerEndpoint)
+	defer unlock()
+
 	var err error
 	for _, val := range policy.Rules {
-		err = c.Request(http.MethodPost, RuntimeContainerEndpoint, nil, val, nil)
+		err = c.Request(ctx, http.MethodPost, RuntimeContainerEndpoint, nil, val, nil)
 
 		if err != nil {
-			return fmt.Errorf("error creating container runtime policy rule: %s", err)
+			return fmt.Errorf("error creating container runtime policy rule: %w", err)
 		}
 	}
 	return nil
 }
+
+// Get a container runtime policy rule by name.
+func GetRuntimeContainerRule(ctx context.Context, c api.Client, name string) (*RuntimeContainerRule, error) {
+	var ans RuntimeContainerRule
+	if err := getRule(ctx, c, RuntimeContainerEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting container runtime policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a container runtime policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateRuntimeContainerRule(ctx context.Context, c api.Client, rule RuntimeContainerRule, position RulePosition) error {
+	if err := createRule(ctx, c, RuntimeContainerEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating container runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a container runtime policy rule, leaving the other rules
+// of the policy as they are.
+func PutRuntimeContainerRule(ctx context.Context, c api.Client, rule RuntimeContainerRule, position RulePosition) error {
+	if err := putRule(ctx, c, RuntimeContainerEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating container runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a container runtime policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteRuntimeContainerRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, RuntimeContainerEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting container runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default container runtime policy.
+func DefaultRuntimeContainer() (RuntimeContainerPolicy, error) {
+	var ans RuntimeContainerPolicy
+	err := loadDefault("runtime_container.json", &ans)
+	return ans, err
+}
+
+// Reset the container runtime policy to the Console default.
+func ResetRuntimeContainer(ctx context.Context, c api.Client) error {
+	policy, err := DefaultRuntimeContainer()
+	if err != nil {
+		return err
+	}
+	return UpdateRuntimeContainer(ctx, c, policy)
+}
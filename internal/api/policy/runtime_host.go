@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -106,15 +107,67 @@ type RuntimeHostPort struct {
 }
 
 // Get the current host runtime policy.
-func GetRuntimeHost(c api.Client) (RuntimeHostPolicy, error) {
+func GetRuntimeHost(ctx context.Context, c api.Client) (RuntimeHostPolicy, error) {
 	var ans RuntimeHostPolicy
-	if err := c.Request(http.MethodGet, RuntimeHostEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting host runtime policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, RuntimeHostEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting host runtime policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current host runtime policy.
-func UpdateRuntimeHost(c api.Client, policy RuntimeHostPolicy) error {
-	return c.Request(http.MethodPut, RuntimeHostEndpoint, nil, policy, nil)
+func UpdateRuntimeHost(ctx context.Context, c api.Client, policy RuntimeHostPolicy) error {
+	return c.UpdateDocument(ctx, RuntimeHostEndpoint, policy)
+}
+
+// Get a host runtime policy rule by name.
+func GetRuntimeHostRule(ctx context.Context, c api.Client, name string) (*RuntimeHostRule, error) {
+	var ans RuntimeHostRule
+	if err := getRule(ctx, c, RuntimeHostEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting host runtime policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a host runtime policy rule, leaving the other rules of the policy as
+// they are. Fails if the policy already has a rule with the same name.
+func CreateRuntimeHostRule(ctx context.Context, c api.Client, rule RuntimeHostRule, position RulePosition) error {
+	if err := createRule(ctx, c, RuntimeHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating host runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a host runtime policy rule, leaving the other rules of the
+// policy as they are.
+func PutRuntimeHostRule(ctx context.Context, c api.Client, rule RuntimeHostRule, position RulePosition) error {
+	if err := putRule(ctx, c, RuntimeHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating host runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a host runtime policy rule, leaving the other rules of the policy
+// as they are.
+func DeleteRuntimeHostRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, RuntimeHostEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting host runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default host runtime policy.
+func DefaultRuntimeHost() (RuntimeHostPolicy, error) {
+	var ans RuntimeHostPolicy
+	err := loadDefault("runtime_host.json", &ans)
+	return ans, err
+}
+
+// Reset the host runtime policy to the Console default.
+func ResetRuntimeHost(ctx context.Context, c api.Client) error {
+	policy, err := DefaultRuntimeHost()
+	if err != nil {
+		return err
+	}
+	return UpdateRuntimeHost(ctx, c, policy)
 }
@@ -0,0 +1,98 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const RuntimeServerlessEndpoint = "api/v1/policies/runtime/serverless"
+
+type RuntimeServerlessPolicy struct {
+	Rules []RuntimeServerlessRule `json:"rules,omitempty"`
+}
+
+// Serverless runtime rules have the same sections as container runtime rules.
+type RuntimeServerlessRule struct {
+	AdvancedProtectionEffect string                       `json:"advancedProtectionEffect"`
+	Collections              []collection.Collection      `json:"collections,omitempty"`
+	CustomRules              []RuntimeContainerCustomRule `json:"customRules,omitempty"`
+	Disabled                 bool                         `json:"disabled"`
+	Dns                      RuntimeContainerDns          `json:"dns,omitempty"`
+	Filesystem               RuntimeContainerFilesystem   `json:"filesystem,omitempty"`
+	Name                     string                       `json:"name,omitempty"`
+	Network                  RuntimeContainerNetwork      `json:"network,omitempty"`
+	Notes                    string                       `json:"notes,omitempty"`
+	PreviousName             string                       `json:"previousName,omitempty"`
+	Processes                RuntimeContainerProcesses    `json:"processes,omitempty"`
+}
+
+// Get the current serverless runtime policy.
+func GetRuntimeServerless(ctx context.Context, c api.Client) (RuntimeServerlessPolicy, error) {
+	var ans RuntimeServerlessPolicy
+	if err := c.Request(ctx, http.MethodGet, RuntimeServerlessEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting serverless runtime policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current serverless runtime policy.
+func UpdateRuntimeServerless(ctx context.Context, c api.Client, policy RuntimeServerlessPolicy) error {
+	return c.UpdateDocument(ctx, RuntimeServerlessEndpoint, policy)
+}
+
+// Get a serverless runtime policy rule by name.
+func GetRuntimeServerlessRule(ctx context.Context, c api.Client, name string) (*RuntimeServerlessRule, error) {
+	var ans RuntimeServerlessRule
+	if err := getRule(ctx, c, RuntimeServerlessEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting serverless runtime policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a serverless runtime policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateRuntimeServerlessRule(ctx context.Context, c api.Client, rule RuntimeServerlessRule, position RulePosition) error {
+	if err := createRule(ctx, c, RuntimeServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating serverless runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a serverless runtime policy rule, leaving the other rules
+// of the policy as they are.
+func PutRuntimeServerlessRule(ctx context.Context, c api.Client, rule RuntimeServerlessRule, position RulePosition) error {
+	if err := putRule(ctx, c, RuntimeServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating serverless runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a serverless runtime policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteRuntimeServerlessRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, RuntimeServerlessEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting serverless runtime policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default serverless runtime policy.
+func DefaultRuntimeServerless() (RuntimeServerlessPolicy, error) {
+	var ans RuntimeServerlessPolicy
+	err := loadDefault("runtime_serverless.json", &ans)
+	return ans, err
+}
+
+// Reset the serverless runtime policy to the Console default.
+func ResetRuntimeServerless(ctx context.Context, c api.Client) error {
+	policy, err := DefaultRuntimeServerless()
+	if err != nil {
+		return err
+	}
+	return UpdateRuntimeServerless(ctx, c, policy)
+}
@@ -0,0 +1,142 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const (
+	TrustEndpoint       = "api/v1/trust/policy"
+	TrustGroupsEndpoint = "api/v1/trust/groups"
+)
+
+// The trusted images policy, which alerts on or blocks containers whose
+// images are not trusted.
+type TrustPolicy struct {
+	Enabled bool        `json:"enabled"`
+	Id      string      `json:"_id,omitempty"`
+	Rules   []TrustRule `json:"rules"`
+}
+
+type TrustRule struct {
+	AllowedGroups []string                `json:"allowedGroups,omitempty"`
+	BlockMessage  string                  `json:"blockMsg,omitempty"`
+	Collections   []collection.Collection `json:"collections,omitempty"`
+	DeniedGroups  []string                `json:"deniedGroups,omitempty"`
+	Disabled      bool                    `json:"disabled"`
+	Effect        string                  `json:"effect,omitempty"`
+	Name          string                  `json:"name,omitempty"`
+	Notes         string                  `json:"notes,omitempty"`
+}
+
+// A trust group is a set of images, selected by name or by the layers of their
+// base image, that the rules of the trusted images policy allow or deny.
+type TrustGroup struct {
+	Images []string `json:"images,omitempty"`
+	Layers []string `json:"layers,omitempty"`
+	Name   string   `json:"_id,omitempty"`
+}
+
+// Get the current trusted images policy.
+func GetTrust(ctx context.Context, c api.Client) (TrustPolicy, error) {
+	var ans TrustPolicy
+	if err := c.Request(ctx, http.MethodGet, TrustEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting trusted images policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current trusted images policy.
+func UpdateTrust(ctx context.Context, c api.Client, policy TrustPolicy) error {
+	return c.UpdateDocument(ctx, TrustEndpoint, policy)
+}
+
+// Get a trusted images policy rule by name.
+func GetTrustRule(ctx context.Context, c api.Client, name string) (*TrustRule, error) {
+	var ans TrustRule
+	if err := getRule(ctx, c, TrustEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting trusted images policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a trusted images policy rule, leaving the other rules of the policy as
+// they are. Fails if the policy already has a rule with the same name.
+func CreateTrustRule(ctx context.Context, c api.Client, rule TrustRule, position RulePosition) error {
+	if err := createRule(ctx, c, TrustEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating trusted images policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a trusted images policy rule, leaving the other rules of
+// the policy as they are.
+func PutTrustRule(ctx context.Context, c api.Client, rule TrustRule, position RulePosition) error {
+	if err := putRule(ctx, c, TrustEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating trusted images policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a trusted images policy rule, leaving the other rules of the policy
+// as they are.
+func DeleteTrustRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, TrustEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting trusted images policy rule: %w", err)
+	}
+	return nil
+}
+
+// Reset the trusted images policy to the Console default, which is disabled
+// and has no rules.
+func ResetTrust(ctx context.Context, c api.Client) error {
+	var policy TrustPolicy
+	return c.ModifyDocument(ctx, TrustEndpoint, &policy, func() (interface{}, error) {
+		return TrustPolicy{
+			Id:    policy.Id,
+			Rules: []TrustRule{},
+		}, nil
+	})
+}
+
+// Get all trust groups.
+func ListTrustGroups(ctx context.Context, c api.Client) ([]TrustGroup, error) {
+	var ans []TrustGroup
+	if err := c.Request(ctx, http.MethodGet, TrustGroupsEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing trust groups: %w", err)
+	}
+	return ans, nil
+}
+
+// Get a specific trust group.
+func GetTrustGroup(ctx context.Context, c api.Client, name string) (*TrustGroup, error) {
+	groups, err := ListTrustGroups(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range groups {
+		if val.Name == name {
+			return &val, nil
+		}
+	}
+	return nil, api.NotFoundError("trust group '%s' not found", name)
+}
+
+// Create a new trust group.
+func CreateTrustGroup(ctx context.Context, c api.Client, group TrustGroup) error {
+	return c.Request(ctx, http.MethodPost, TrustGroupsEndpoint, nil, group, nil)
+}
+
+// Update an existing trust group.
+func UpdateTrustGroup(ctx context.Context, c api.Client, group TrustGroup) error {
+	return c.Request(ctx, http.MethodPut, fmt.Sprintf("%s/%s", TrustGroupsEndpoint, group.Name), nil, group, nil)
+}
+
+// Delete an existing trust group.
+func DeleteTrustGroup(ctx context.Context, c api.Client, name string) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", TrustGroupsEndpoint, name), nil, nil, nil)
+}
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -70,29 +71,135 @@ type VulnerabilityCoderepoGraceDaysPolicy struct {
 }
 
 // Get the current CI coderepo vulnerability policy.
-func GetVulnerabilityCiCoderepo(c api.Client) (VulnerabilityCoderepoPolicy, error) {
+func GetVulnerabilityCiCoderepo(ctx context.Context, c api.Client) (VulnerabilityCoderepoPolicy, error) {
 	var ans VulnerabilityCoderepoPolicy
-	if err := c.Request(http.MethodGet, VulnerabilityCiCodereposEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting CI coderepo vulnerability policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityCiCodereposEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI coderepo vulnerability policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current coderepo vulnerability policy.
-func GetVulnerabilityCoderepo(c api.Client) (VulnerabilityCoderepoPolicy, error) {
+func GetVulnerabilityCoderepo(ctx context.Context, c api.Client) (VulnerabilityCoderepoPolicy, error) {
 	var ans VulnerabilityCoderepoPolicy
-	if err := c.Request(http.MethodGet, VulnerabilityCodereposEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting coderepo vulnerability policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityCodereposEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting coderepo vulnerability policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current CI coderepo vulnerability policy.
-func UpdateVulnerabilityCiCoderepo(c api.Client, policy VulnerabilityCoderepoPolicy) error {
-	return c.Request(http.MethodPut, VulnerabilityCiCodereposEndpoint, nil, policy, nil)
+func UpdateVulnerabilityCiCoderepo(ctx context.Context, c api.Client, policy VulnerabilityCoderepoPolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityCiCodereposEndpoint, policy)
 }
 
 // Update the current coderepo vulnerability policy.
-func UpdateVulnerabilityCoderepo(c api.Client, policy VulnerabilityCoderepoPolicy) error {
-	return c.Request(http.MethodPut, VulnerabilityCodereposEndpoint, nil, policy, nil)
+func UpdateVulnerabilityCoderepo(ctx context.Context, c api.Client, policy VulnerabilityCoderepoPolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityCodereposEndpoint, policy)
+}
+
+// Get a coderepo vulnerability policy rule by name.
+func GetVulnerabilityCoderepoRule(ctx context.Context, c api.Client, name string) (*VulnerabilityCoderepoRule, error) {
+	var ans VulnerabilityCoderepoRule
+	if err := getRule(ctx, c, VulnerabilityCodereposEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting coderepo vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a coderepo vulnerability policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateVulnerabilityCoderepoRule(ctx context.Context, c api.Client, rule VulnerabilityCoderepoRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a coderepo vulnerability policy rule, leaving the other
+// rules of the policy as they are.
+func PutVulnerabilityCoderepoRule(ctx context.Context, c api.Client, rule VulnerabilityCoderepoRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a coderepo vulnerability policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteVulnerabilityCoderepoRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityCodereposEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI coderepo vulnerability policy rule by name.
+func GetVulnerabilityCiCoderepoRule(ctx context.Context, c api.Client, name string) (*VulnerabilityCoderepoRule, error) {
+	var ans VulnerabilityCoderepoRule
+	if err := getRule(ctx, c, VulnerabilityCiCodereposEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI coderepo vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI coderepo vulnerability policy rule, leaving the other rules of
+// the policy as they are. Fails if the policy already has a rule with the
+// same name.
+func CreateVulnerabilityCiCoderepoRule(ctx context.Context, c api.Client, rule VulnerabilityCoderepoRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityCiCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI coderepo vulnerability policy rule, leaving the other
+// rules of the policy as they are.
+func PutVulnerabilityCiCoderepoRule(ctx context.Context, c api.Client, rule VulnerabilityCoderepoRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityCiCodereposEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI coderepo vulnerability policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteVulnerabilityCiCoderepoRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityCiCodereposEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI coderepo vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default CI coderepo vulnerability policy.
+func DefaultVulnerabilityCiCoderepo() (VulnerabilityCoderepoPolicy, error) {
+	var ans VulnerabilityCoderepoPolicy
+	err := loadDefault("vulnerability_ci_coderepos.json", &ans)
+	return ans, err
+}
+
+// Get the Console default coderepo vulnerability policy.
+func DefaultVulnerabilityCoderepo() (VulnerabilityCoderepoPolicy, error) {
+	var ans VulnerabilityCoderepoPolicy
+	err := loadDefault("vulnerability_coderepos.json", &ans)
+	return ans, err
+}
+
+// Reset the CI coderepo vulnerability policy to the Console default.
+func ResetVulnerabilityCiCoderepo(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityCiCoderepo()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityCiCoderepo(ctx, c, policy)
+}
+
+// Reset the coderepo vulnerability policy to the Console default.
+func ResetVulnerabilityCoderepo(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityCoderepo()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityCoderepo(ctx, c, policy)
 }
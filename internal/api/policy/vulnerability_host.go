@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -55,15 +56,68 @@ type VulnerabilityHostThreshold struct {
 }
 
 // Get the current host vulnerability policy.
-func GetVulnerabilityHost(c api.Client) (VulnerabilityHostPolicy, error) {
+func GetVulnerabilityHost(ctx context.Context, c api.Client) (VulnerabilityHostPolicy, error) {
 	var ans VulnerabilityHostPolicy
-	if err := c.Request(http.MethodGet, VulnerabilityHostEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting host vulnerability policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityHostEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting host vulnerability policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current host vulnerability policy.
-func UpdateVulnerabilityHost(c api.Client, policy VulnerabilityHostPolicy) error {
-	return c.Request(http.MethodPut, VulnerabilityHostEndpoint, nil, policy, nil)
+func UpdateVulnerabilityHost(ctx context.Context, c api.Client, policy VulnerabilityHostPolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityHostEndpoint, policy)
+}
+
+// Get a host vulnerability policy rule by name.
+func GetVulnerabilityHostRule(ctx context.Context, c api.Client, name string) (*VulnerabilityHostRule, error) {
+	var ans VulnerabilityHostRule
+	if err := getRule(ctx, c, VulnerabilityHostEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting host vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a host vulnerability policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateVulnerabilityHostRule(ctx context.Context, c api.Client, rule VulnerabilityHostRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating host vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a host vulnerability policy rule, leaving the other rules
+// of the policy as they are.
+func PutVulnerabilityHostRule(ctx context.Context, c api.Client, rule VulnerabilityHostRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityHostEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating host vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a host vulnerability policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteVulnerabilityHostRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityHostEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting host vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default host vulnerability policy.
+func DefaultVulnerabilityHost() (VulnerabilityHostPolicy, error) {
+	var ans VulnerabilityHostPolicy
+	err := loadDefault("vulnerability_host.json", &ans)
+	return ans, err
+}
+
+// Reset the host vulnerability policy to the Console default.
+func ResetVulnerabilityHost(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityHost()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityHost(ctx, c, policy)
 }
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -69,29 +70,135 @@ type VulnerabilityImageGraceDaysPolicy struct {
 }
 
 // Get the current CI image vulnerability policy.
-func GetVulnerabilityCiImage(c api.Client) (VulnerabilityImagePolicy, error) {
+func GetVulnerabilityCiImage(ctx context.Context, c api.Client) (VulnerabilityImagePolicy, error) {
 	var ans VulnerabilityImagePolicy
-	if err := c.Request(http.MethodGet, VulnerabilityCiImagesEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting CI image vulnerability policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityCiImagesEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI image vulnerability policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Get the current image vulnerability policy.
-func GetVulnerabilityImage(c api.Client) (VulnerabilityImagePolicy, error) {
+func GetVulnerabilityImage(ctx context.Context, c api.Client) (VulnerabilityImagePolicy, error) {
 	var ans VulnerabilityImagePolicy
-	if err := c.Request(http.MethodGet, VulnerabilityImagesEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting image vulnerability policy: %s", err)
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityImagesEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting image vulnerability policy: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current CI image vulnerability policy.
-func UpdateVulnerabilityCiImage(c api.Client, policy VulnerabilityImagePolicy) error {
-	return c.Request(http.MethodPut, VulnerabilityCiImagesEndpoint, nil, policy, nil)
+func UpdateVulnerabilityCiImage(ctx context.Context, c api.Client, policy VulnerabilityImagePolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityCiImagesEndpoint, policy)
 }
 
 // Update the current image vulnerability policy.
-func UpdateVulnerabilityImage(c api.Client, policy VulnerabilityImagePolicy) error {
-	return c.Request(http.MethodPut, VulnerabilityImagesEndpoint, nil, policy, nil)
+func UpdateVulnerabilityImage(ctx context.Context, c api.Client, policy VulnerabilityImagePolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityImagesEndpoint, policy)
+}
+
+// Get an image vulnerability policy rule by name.
+func GetVulnerabilityImageRule(ctx context.Context, c api.Client, name string) (*VulnerabilityImageRule, error) {
+	var ans VulnerabilityImageRule
+	if err := getRule(ctx, c, VulnerabilityImagesEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting image vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add an image vulnerability policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateVulnerabilityImageRule(ctx context.Context, c api.Client, rule VulnerabilityImageRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace an image vulnerability policy rule, leaving the other rules
+// of the policy as they are.
+func PutVulnerabilityImageRule(ctx context.Context, c api.Client, rule VulnerabilityImageRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete an image vulnerability policy rule, leaving the other rules of the
+// policy as they are.
+func DeleteVulnerabilityImageRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityImagesEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI image vulnerability policy rule by name.
+func GetVulnerabilityCiImageRule(ctx context.Context, c api.Client, name string) (*VulnerabilityImageRule, error) {
+	var ans VulnerabilityImageRule
+	if err := getRule(ctx, c, VulnerabilityCiImagesEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI image vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI image vulnerability policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateVulnerabilityCiImageRule(ctx context.Context, c api.Client, rule VulnerabilityImageRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityCiImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI image vulnerability policy rule, leaving the other
+// rules of the policy as they are.
+func PutVulnerabilityCiImageRule(ctx context.Context, c api.Client, rule VulnerabilityImageRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityCiImagesEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI image vulnerability policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteVulnerabilityCiImageRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityCiImagesEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI image vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default CI image vulnerability policy.
+func DefaultVulnerabilityCiImage() (VulnerabilityImagePolicy, error) {
+	var ans VulnerabilityImagePolicy
+	err := loadDefault("vulnerability_ci_images.json", &ans)
+	return ans, err
+}
+
+// Get the Console default image vulnerability policy.
+func DefaultVulnerabilityImage() (VulnerabilityImagePolicy, error) {
+	var ans VulnerabilityImagePolicy
+	err := loadDefault("vulnerability_images.json", &ans)
+	return ans, err
+}
+
+// Reset the CI image vulnerability policy to the Console default.
+func ResetVulnerabilityCiImage(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityCiImage()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityCiImage(ctx, c, policy)
+}
+
+// Reset the image vulnerability policy to the Console default.
+func ResetVulnerabilityImage(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityImage()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityImage(ctx, c, policy)
 }
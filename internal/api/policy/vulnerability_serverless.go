@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const (
+	VulnerabilityServerlessEndpoint   = "api/v1/policies/vulnerability/serverless"
+	VulnerabilityCiServerlessEndpoint = "api/v1/policies/vulnerability/ci/serverless"
+)
+
+type VulnerabilityServerlessPolicy struct {
+	Rules []VulnerabilityServerlessRule `json:"rules,omitempty"`
+	Type  string                        `json:"policyType,omitempty"`
+}
+
+// Serverless rules have the same thresholds, CVE rules and tag rules as
+// image rules.
+type VulnerabilityServerlessRule struct {
+	AlertThreshold VulnerabilityImageThreshold `json:"alertThreshold,omitempty"`
+	BlockThreshold VulnerabilityImageThreshold `json:"blockThreshold,omitempty"`
+	Collections    []collection.Collection     `json:"collections,omitempty"`
+	CveRules       []VulnerabilityImageCveRule `json:"cveRules,omitempty"`
+	Disabled       bool                        `json:"disabled"`
+	Effect         string                      `json:"effect,omitempty"`
+	GraceDays      int                         `json:"graceDays,omitempty"`
+	Name           string                      `json:"name,omitempty"`
+	Notes          string                      `json:"notes,omitempty"`
+	OnlyFixed      bool                        `json:"onlyFixed"`
+	TagRules       []VulnerabilityImageTagRule `json:"tags,omitempty"`
+	Verbose        bool                        `json:"verbose"`
+}
+
+// Get the current CI serverless vulnerability policy.
+func GetVulnerabilityCiServerless(ctx context.Context, c api.Client) (VulnerabilityServerlessPolicy, error) {
+	var ans VulnerabilityServerlessPolicy
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityCiServerlessEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting CI serverless vulnerability policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Get the current serverless vulnerability policy.
+func GetVulnerabilityServerless(ctx context.Context, c api.Client) (VulnerabilityServerlessPolicy, error) {
+	var ans VulnerabilityServerlessPolicy
+	if err := c.Request(ctx, http.MethodGet, VulnerabilityServerlessEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting serverless vulnerability policy: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current CI serverless vulnerability policy.
+func UpdateVulnerabilityCiServerless(ctx context.Context, c api.Client, policy VulnerabilityServerlessPolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityCiServerlessEndpoint, policy)
+}
+
+// Update the current serverless vulnerability policy.
+func UpdateVulnerabilityServerless(ctx context.Context, c api.Client, policy VulnerabilityServerlessPolicy) error {
+	return c.UpdateDocument(ctx, VulnerabilityServerlessEndpoint, policy)
+}
+
+// Get a serverless vulnerability policy rule by name.
+func GetVulnerabilityServerlessRule(ctx context.Context, c api.Client, name string) (*VulnerabilityServerlessRule, error) {
+	var ans VulnerabilityServerlessRule
+	if err := getRule(ctx, c, VulnerabilityServerlessEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting serverless vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a serverless vulnerability policy rule, leaving the other rules of the
+// policy as they are. Fails if the policy already has a rule with the same
+// name.
+func CreateVulnerabilityServerlessRule(ctx context.Context, c api.Client, rule VulnerabilityServerlessRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a serverless vulnerability policy rule, leaving the other
+// rules of the policy as they are.
+func PutVulnerabilityServerlessRule(ctx context.Context, c api.Client, rule VulnerabilityServerlessRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a serverless vulnerability policy rule, leaving the other rules of
+// the policy as they are.
+func DeleteVulnerabilityServerlessRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityServerlessEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get a CI serverless vulnerability policy rule by name.
+func GetVulnerabilityCiServerlessRule(ctx context.Context, c api.Client, name string) (*VulnerabilityServerlessRule, error) {
+	var ans VulnerabilityServerlessRule
+	if err := getRule(ctx, c, VulnerabilityCiServerlessEndpoint, name, &ans); err != nil {
+		return nil, fmt.Errorf("error getting CI serverless vulnerability policy rule: %w", err)
+	}
+	return &ans, nil
+}
+
+// Add a CI serverless vulnerability policy rule, leaving the other rules of
+// the policy as they are. Fails if the policy already has a rule with the
+// same name.
+func CreateVulnerabilityCiServerlessRule(ctx context.Context, c api.Client, rule VulnerabilityServerlessRule, position RulePosition) error {
+	if err := createRule(ctx, c, VulnerabilityCiServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error creating CI serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Add or replace a CI serverless vulnerability policy rule, leaving the
+// other rules of the policy as they are.
+func PutVulnerabilityCiServerlessRule(ctx context.Context, c api.Client, rule VulnerabilityServerlessRule, position RulePosition) error {
+	if err := putRule(ctx, c, VulnerabilityCiServerlessEndpoint, rule.Name, rule, position); err != nil {
+		return fmt.Errorf("error updating CI serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Delete a CI serverless vulnerability policy rule, leaving the other rules
+// of the policy as they are.
+func DeleteVulnerabilityCiServerlessRule(ctx context.Context, c api.Client, name string) error {
+	if err := deleteRule(ctx, c, VulnerabilityCiServerlessEndpoint, name); err != nil {
+		return fmt.Errorf("error deleting CI serverless vulnerability policy rule: %w", err)
+	}
+	return nil
+}
+
+// Get the Console default CI serverless vulnerability policy.
+func DefaultVulnerabilityCiServerless() (VulnerabilityServerlessPolicy, error) {
+	var ans VulnerabilityServerlessPolicy
+	err := loadDefault("vulnerability_ci_serverless.json", &ans)
+	return ans, err
+}
+
+// Get the Console default serverless vulnerability policy.
+func DefaultVulnerabilityServerless() (VulnerabilityServerlessPolicy, error) {
+	var ans VulnerabilityServerlessPolicy
+	err := loadDefault("vulnerability_serverless.json", &ans)
+	return ans, err
+}
+
+// Reset the CI serverless vulnerability policy to the Console default.
+func ResetVulnerabilityCiServerless(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityCiServerless()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityCiServerless(ctx, c, policy)
+}
+
+// Reset the serverless vulnerability policy to the Console default.
+func ResetVulnerabilityServerless(ctx context.Context, c api.Client) error {
+	policy, err := DefaultVulnerabilityServerless()
+	if err != nil {
+		return err
+	}
+	return UpdateVulnerabilityServerless(ctx, c, policy)
+}
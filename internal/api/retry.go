@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30
+)
+
+// The wait before the first retry. Each later retry waits twice as long as
+// the previous one, up to the configured maximum.
+var retryBaseWait = time.Second
+
+// Send a request, retrying it while the Console is throttling or temporarily
+// unavailable.
+func (c *Client) doWithRetries(ctx context.Context, method, endpoint string, query, data, response interface{}, token string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := c.do(ctx, method, endpoint, query, data, response, token)
+		if attempt >= c.Config.MaxRetries || !retryable(method, res, err) {
+			return res, err
+		}
+
+		timer := time.NewTimer(c.retryWait(attempt, res))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Throttled requests were not processed, so they are retried regardless of
+// the method. Gateway errors and dropped connections leave it unknown whether
+// the Console applied the request, so only idempotent requests are retried.
+func retryable(method string, res *http.Response, err error) bool {
+	if res == nil {
+		return idempotent(method) && connectionError(err)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func connectionError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Get the wait before the next retry. A Retry-After header from the Console
+// takes precedence over the exponential backoff, but never exceeds the
+// configured maximum.
+func (c *Client) retryWait(attempt int, res *http.Response) time.Duration {
+	max := time.Duration(c.Config.RetryMaxWait) * time.Second
+	if max <= 0 {
+		max = DefaultRetryMaxWait * time.Second
+	}
+
+	if res != nil {
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			if wait > max {
+				return max
+			}
+			return wait
+		}
+	}
+
+	wait := max
+	if attempt < 32 && retryBaseWait<<attempt < max {
+		wait = retryBaseWait << attempt
+	}
+	// Jitter spreads out retries from concurrent resource operations.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// Parse a Retry-After header given either in seconds or as an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
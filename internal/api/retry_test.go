@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A server that fails the first failures requests with the given handler and
+// then answers with an empty list.
+type flakyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests int
+}
+
+func newFlakyServer(failures int, fail http.HandlerFunc) *flakyServer {
+	s := &flakyServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		n := s.requests
+		s.mu.Unlock()
+
+		if n <= failures {
+			fail(w, r)
+			return
+		}
+		w.Write([]byte(`[{"name":"All"}]`))
+	}))
+	return s
+}
+
+func (s *flakyServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *flakyServer) client(maxRetries int) Client {
+	return Client{
+		Config:     APIClientConfig{ConsoleURL: s.URL, MaxRetries: maxRetries},
+		HTTPClient: s.Client(),
+	}
+}
+
+func withStatus(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"err":"try again"}`))
+	}
+}
+
+func resetConnection(w http.ResponseWriter, r *http.Request) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		panic(err)
+	}
+	conn.Close()
+}
+
+func shortBackoff(t *testing.T) {
+	prev := retryBaseWait
+	retryBaseWait = time.Millisecond
+	t.Cleanup(func() { retryBaseWait = prev })
+}
+
+func TestRetryThrottled(t *testing.T) {
+	shortBackoff(t)
+	server := newFlakyServer(2, withStatus(http.StatusTooManyRequests))
+	defer server.Close()
+	client := server.client(3)
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), http.MethodPost, "api/v1/collections", nil, nil, &ans); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+	if len(ans) != 1 || ans[0]["name"] != "All" {
+		t.Errorf("response of retried request was not decoded: %v", ans)
+	}
+	if n := server.Requests(); n != 3 {
+		t.Errorf("sent %d requests, expected 3", n)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	shortBackoff(t)
+	server := newFlakyServer(10, withStatus(http.StatusServiceUnavailable))
+	defer server.Close()
+	client := server.client(2)
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), http.MethodGet, "api/v1/collections", nil, nil, &ans); err == nil {
+		t.Errorf("expected request to fail after exhausting retries")
+	}
+	if n := server.Requests(); n != 3 {
+		t.Errorf("sent %d requests, expected 3", n)
+	}
+}
+
+func TestRetryDisabled(t *testing.T) {
+	shortBackoff(t)
+	server := newFlakyServer(1, withStatus(http.StatusTooManyRequests))
+	defer server.Close()
+	client := server.client(0)
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), http.MethodGet, "api/v1/collections", nil, nil, &ans); err == nil {
+		t.Errorf("expected request to fail without retries")
+	}
+	if n := server.Requests(); n != 1 {
+		t.Errorf("sent %d requests, expected 1", n)
+	}
+}
+
+func TestRetryOnlyIdempotent(t *testing.T) {
+	shortBackoff(t)
+	cases := map[string]bool{
+		http.MethodGet:    true,
+		http.MethodPut:    true,
+		http.MethodDelete: true,
+		http.MethodPost:   false,
+	}
+	for method, retried := range cases {
+		for name, fail := range map[string]http.HandlerFunc{
+			"gateway":    withStatus(http.StatusBadGateway),
+			"connection": resetConnection,
+		} {
+			server := newFlakyServer(1, fail)
+			client := server.client(3)
+
+			var ans []map[string]interface{}
+			err := client.Request(context.Background(), method, "api/v1/collections", nil, nil, &ans)
+			if retried && err != nil {
+				t.Errorf("%s %s: expected request to be retried: %s", method, name, err)
+			}
+			if !retried && err == nil {
+				t.Errorf("%s %s: expected request not to be retried", method, name)
+			}
+			server.Close()
+		}
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	client := Client{Config: APIClientConfig{RetryMaxWait: 5}}
+	max := 5 * time.Second
+
+	for attempt := 0; attempt < 40; attempt++ {
+		if wait := client.retryWait(attempt, nil); wait < 0 || wait > max {
+			t.Errorf("wait for attempt %d is %s, expected at most %s", attempt, wait, max)
+		}
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if wait := client.retryWait(0, res); wait != 2*time.Second {
+		t.Errorf("wait is %s, expected Retry-After of 2s", wait)
+	}
+
+	res.Header.Set("Retry-After", "3600")
+	if wait := client.retryWait(0, res); wait != max {
+		t.Errorf("wait is %s, expected Retry-After to be capped at %s", wait, max)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		header string
+		wait   time.Duration
+		ok     bool
+	}{
+		{"", 0, false},
+		{"garbage", 0, false},
+		{"-1", 0, false},
+		{"0", 0, true},
+		{"120", 2 * time.Minute, true},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+	}
+	for _, tc := range cases {
+		wait, ok := retryAfter(tc.header)
+		if wait != tc.wait || ok != tc.ok {
+			t.Errorf("retryAfter(%q) = %s, %t, expected %s, %t", tc.header, wait, ok, tc.wait, tc.ok)
+		}
+	}
+}
+
+func TestRetryCanceled(t *testing.T) {
+	server := newFlakyServer(10, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer server.Close()
+	client := server.client(3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	var ans []map[string]interface{}
+	err := client.Request(ctx, http.MethodGet, "api/v1/collections", nil, nil, &ans)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected retries to be canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("canceled request took %s", elapsed)
+	}
+}
@@ -1,6 +1,7 @@
 package rule
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -22,17 +23,17 @@ type CustomRule struct {
 }
 
 // Get all custom rules.
-func ListCustomRules(c api.Client) ([]CustomRule, error) {
+func ListCustomRules(ctx context.Context, c api.Client) ([]CustomRule, error) {
 	var ans []CustomRule
-	if err := c.Request(http.MethodGet, CustomRulesEndpoint, nil, nil, &ans); err != nil {
-		return nil, fmt.Errorf("error listing custom rules: %s", err)
+	if err := c.Request(ctx, http.MethodGet, CustomRulesEndpoint, nil, nil, &ans); err != nil {
+		return nil, fmt.Errorf("error listing custom rules: %w", err)
 	}
 	return ans, nil
 }
 
 // Get a specific custom rule by ID.
-func GetCustomRuleById(c api.Client, id int) (*CustomRule, error) {
-	rules, err := ListCustomRules(c)
+func GetCustomRuleById(ctx context.Context, c api.Client, id int) (*CustomRule, error) {
+	rules, err := ListCustomRules(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +42,12 @@ func GetCustomRuleById(c api.Client, id int) (*CustomRule, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("custom rule '%d' not found", id)
+	return nil, api.NotFoundError("custom rule '%d' not found", id)
 }
 
 // Get a specific custom rule by name.
-func GetCustomRuleByName(c api.Client, name string) (*CustomRule, error) {
-	rules, err := ListCustomRules(c)
+func GetCustomRuleByName(ctx context.Context, c api.Client, name string) (*CustomRule, error) {
+	rules, err := ListCustomRules(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -55,23 +56,23 @@ func GetCustomRuleByName(c api.Client, name string) (*CustomRule, error) {
 			return &val, nil
 		}
 	}
-	return nil, fmt.Errorf("custom rule '%s' not found", name)
+	return nil, api.NotFoundError("custom rule '%s' not found", name)
 }
 
 // Create a new custom rule.
-func CreateCustomRule(c api.Client, rule CustomRule) (int, error) {
-	id, err := GenerateCustomRuleId(c)
+func CreateCustomRule(ctx context.Context, c api.Client, rule CustomRule) (int, error) {
+	id, err := GenerateCustomRuleId(ctx, c)
 	if err != nil {
 		return -1, err
 	}
 	rule.Id = id
-	return id, UpdateCustomRule(c, rule)
+	return id, UpdateCustomRule(ctx, c, rule)
 }
 
 // Helper method to generate an ID for new custom rule.
 // Finds the maximum custom rule ID and increments it by 1.
-func GenerateCustomRuleId(c api.Client) (int, error) {
-	rules, err := ListCustomRules(c)
+func GenerateCustomRuleId(ctx context.Context, c api.Client) (int, error) {
+	rules, err := ListCustomRules(ctx, c)
 	if err != nil {
 		return -1, err
 	}
@@ -87,11 +88,11 @@ func GenerateCustomRuleId(c api.Client) (int, error) {
 }
 
 // Update an existing custom rule.
-func UpdateCustomRule(c api.Client, rule CustomRule) error {
-	return c.Request(http.MethodPut, fmt.Sprintf("%s/%d", CustomRulesEndpoint, rule.Id), nil, rule, nil)
+func UpdateCustomRule(ctx context.Context, c api.Client, rule CustomRule) error {
+	return c.Request(ctx, http.MethodPut, fmt.Sprintf("%s/%d", CustomRulesEndpoint, rule.Id), nil, rule, nil)
 }
 
 // Delete an existing custom rule.
-func DeleteCustomRule(c api.Client, id int) error {
-	return c.Request(http.MethodDelete, fmt.Sprintf("%s/%d", CustomRulesEndpoint, id), nil, nil, nil)
+func DeleteCustomRule(ctx context.Context, c api.Client, id int) error {
+	return c.Request(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", CustomRulesEndpoint, id), nil, nil, nil)
 }
@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+)
+
+// Console version the default settings fixtures were captured from, which is
+// the same as for the default policies.
+const DefaultsVersion = policy.DefaultsVersion
+
+//go:embed defaults
+var defaultsFS embed.FS
+
+// Load the default settings fixture with the given file name into out.
+func loadDefault(name string, out interface{}) error {
+	b, err := defaultsFS.ReadFile(path.Join("defaults", DefaultsVersion, name))
+	if err != nil {
+		return fmt.Errorf("error reading default settings '%s': %s", name, err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("error parsing default settings '%s': %s", name, err)
+	}
+	return nil
+}
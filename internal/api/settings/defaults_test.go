@@ -0,0 +1,52 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"path"
+	"reflect"
+	"testing"
+)
+
+var defaultSettingsCases = []struct {
+	fixture  string
+	settings func() (interface{}, error)
+}{
+	{"logging.json", func() (interface{}, error) { return DefaultLoggingSettings() }},
+}
+
+func TestDefaultSettingsFixtures(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, tc := range defaultSettingsCases {
+		covered[tc.fixture] = true
+
+		ans, err := tc.settings()
+		if err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+
+		// Every field in the fixture must map onto the SDK type, otherwise
+		// it would be silently dropped when resetting the settings.
+		b, err := defaultsFS.ReadFile(path.Join("defaults", DefaultsVersion, tc.fixture))
+		if err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		strict := reflect.New(reflect.TypeOf(ans))
+		if err := dec.Decode(strict.Interface()); err != nil {
+			t.Errorf("%s: %s", tc.fixture, err)
+		}
+	}
+
+	files, err := fs.ReadDir(defaultsFS, path.Join("defaults", DefaultsVersion))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if !covered[f.Name()] {
+			t.Errorf("fixture %s is not used by any default settings", f.Name())
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+)
+
+const SettingsLdapEndpoint = "api/v1/settings/ldap"
+
+type LdapSettings struct {
+	AccountUpn           bool        `json:"accountUpn"`
+	CaCert               string      `json:"caCert"`
+	Enabled              bool        `json:"enabled"`
+	GroupSearchBase      string      `json:"groupSearchBase"`
+	Password             auth.Secret `json:"password"`
+	SearchBase           string      `json:"searchBase"`
+	Url                  string      `json:"url"`
+	User                 string      `json:"user"`
+	UserSearchBase       string      `json:"userSearchBase"`
+	UserSearchIdentifier string      `json:"userSearchIdentifier"`
+}
+
+// Get the current LDAP settings.
+func GetLdapSettings(ctx context.Context, c api.Client) (LdapSettings, error) {
+	var ans LdapSettings
+	if err := c.Request(ctx, http.MethodGet, SettingsLdapEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting LDAP settings: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current LDAP settings.
+func UpdateLdapSettings(ctx context.Context, c api.Client, ldap LdapSettings) error {
+	return c.UpdateDocument(ctx, SettingsLdapEndpoint, ldap)
+}
+
+// Reset the LDAP settings to the Console default, in which LDAP is disabled.
+func ResetLdapSettings(ctx context.Context, c api.Client) error {
+	return UpdateLdapSettings(ctx, c, LdapSettings{})
+}
@@ -0,0 +1,121 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+const SettingsLoggingEndpoint = "api/v1/settings/logging"
+
+type LoggingSettings struct {
+	ConsoleAddress          string        `json:"consoleAddress"`
+	EnableMetricsCollection bool          `json:"enableMetricsCollection"`
+	IncludeRuntimeLink      bool          `json:"includeRuntimeLink"`
+	Stdout                  LoggerSetting `json:"stdout"`
+	Syslog                  SyslogSetting `json:"syslog"`
+}
+
+type LoggerSetting struct {
+	AllProcEvents bool `json:"allProcEvents"`
+	Enabled       bool `json:"enabled"`
+	VerboseScan   bool `json:"verboseScan"`
+}
+
+type SyslogSetting struct {
+	Address       string `json:"addr"`
+	AllProcEvents bool   `json:"allProcEvents"`
+	Cert          string `json:"cert"`
+	Enabled       bool   `json:"enabled"`
+	Identifier    string `json:"id"`
+	VerboseScan   bool   `json:"verboseScan"`
+}
+
+// Get the current logging settings.
+func GetLoggingSettings(ctx context.Context, c api.Client) (LoggingSettings, error) {
+	var ans LoggingSettings
+	if err := c.Request(ctx, http.MethodGet, SettingsLoggingEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting logging settings: %w", err)
+	}
+	return ans, nil
+}
+
+// The logging settings document. Fields the SDK does not know about, at any
+// level, are kept as they are, so that settings made on the Console are not
+// wiped.
+type loggingDocument struct {
+	fields   json.RawMessage
+	settings LoggingSettings
+}
+
+func (doc *loggingDocument) UnmarshalJSON(b []byte) error {
+	doc.fields = append(doc.fields[:0], b...)
+	return nil
+}
+
+func (doc loggingDocument) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(doc.settings)
+	if err != nil {
+		return nil, err
+	}
+	return mergeJSON(doc.fields, b)
+}
+
+// Merge the JSON object overlay into the JSON object base, recursing into
+// objects present in both. Any other value of overlay replaces the value of
+// base.
+func mergeJSON(base, overlay json.RawMessage) (json.RawMessage, error) {
+	var baseFields, overlayFields map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseFields); err != nil || baseFields == nil {
+		return overlay, nil
+	}
+	if err := json.Unmarshal(overlay, &overlayFields); err != nil || overlayFields == nil {
+		return overlay, nil
+	}
+	for k, v := range overlayFields {
+		merged, err := mergeJSON(baseFields[k], v)
+		if err != nil {
+			return nil, err
+		}
+		baseFields[k] = merged
+	}
+	return json.Marshal(baseFields)
+}
+
+// Update the current logging settings. If ctx expects a digest, the current
+// settings are checked against it.
+func UpdateLoggingSettings(ctx context.Context, c api.Client, logging LoggingSettings) error {
+	var doc loggingDocument
+	return c.ModifyDocument(ctx, SettingsLoggingEndpoint, &doc, func() (interface{}, error) {
+		var current LoggingSettings
+		if len(doc.fields) > 0 {
+			if err := json.Unmarshal(doc.fields, &current); err != nil {
+				return nil, err
+			}
+		}
+		if err := api.CheckDigest(ctx, SettingsLoggingEndpoint, current); err != nil {
+			return nil, err
+		}
+		doc.settings = logging
+		return doc, nil
+	})
+}
+
+// Get the Console default logging settings.
+func DefaultLoggingSettings() (LoggingSettings, error) {
+	var ans LoggingSettings
+	err := loadDefault("logging.json", &ans)
+	return ans, err
+}
+
+// Reset the logging settings to the Console default.
+func ResetLoggingSettings(ctx context.Context, c api.Client) error {
+	logging, err := DefaultLoggingSettings()
+	if err != nil {
+		return err
+	}
+	return UpdateLoggingSettings(ctx, c, logging)
+}
@@ -0,0 +1,75 @@
+package settings
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+func TestUpdateLoggingSettingsKeepsUnknownFields(t *testing.T) {
+	c, current := newSettingsTestClient(t, `{"consoleAddress":"old","unknownField":1,`+
+		`"stdout":{"enabled":false,"unknownField":2},"syslog":{"enabled":true,"addr":"udp://old:514"}}`)
+
+	logging := LoggingSettings{
+		ConsoleAddress: "new",
+		Stdout:         LoggerSetting{Enabled: true},
+	}
+	if err := UpdateLoggingSettings(context.Background(), c, logging); err != nil {
+		t.Fatalf("error updating logging settings: %s", err)
+	}
+
+	doc := current()
+	if doc["consoleAddress"] != "new" {
+		t.Errorf("console address is %v, expected new", doc["consoleAddress"])
+	}
+	if doc["unknownField"] != float64(1) {
+		t.Error("unknown field of the settings was not kept")
+	}
+	stdout := doc["stdout"].(map[string]interface{})
+	if stdout["enabled"] != true || stdout["unknownField"] != float64(2) {
+		t.Errorf("stdout is %v, expected enabled with the unknown field kept", stdout)
+	}
+	if syslog := doc["syslog"].(map[string]interface{}); syslog["enabled"] != false || syslog["addr"] != "" {
+		t.Errorf("syslog is %v, expected disabled", syslog)
+	}
+
+	if err := ResetLoggingSettings(context.Background(), c); err != nil {
+		t.Fatalf("error resetting logging settings: %s", err)
+	}
+	doc = current()
+	if doc["unknownField"] != float64(1) || doc["stdout"].(map[string]interface{})["unknownField"] != float64(2) {
+		t.Errorf("settings are %v after reset, expected unknown fields kept", doc)
+	}
+	reset, err := GetLoggingSettings(context.Background(), c)
+	if err != nil {
+		t.Fatalf("error getting logging settings: %s", err)
+	}
+	if defaults, _ := DefaultLoggingSettings(); !reflect.DeepEqual(reset, defaults) {
+		t.Errorf("settings are %+v after reset, expected the defaults %+v", reset, defaults)
+	}
+}
+
+func TestUpdateLoggingSettingsConflict(t *testing.T) {
+	c, current := newSettingsTestClient(t, `{"consoleAddress":"read","unknownField":1}`)
+	read, err := GetLoggingSettings(context.Background(), c)
+	if err != nil {
+		t.Fatalf("error getting logging settings: %s", err)
+	}
+	digest, err := api.Digest(read)
+	if err != nil {
+		t.Fatalf("error getting digest: %s", err)
+	}
+	ctx := api.WithDigest(context.Background(), digest)
+
+	if err := UpdateLoggingSettings(ctx, c, LoggingSettings{ConsoleAddress: "mine"}); err != nil {
+		t.Fatalf("error updating unchanged logging settings: %s", err)
+	}
+	if err := UpdateLoggingSettings(ctx, c, LoggingSettings{ConsoleAddress: "again"}); !api.IsConflict(err) {
+		t.Errorf("expected a conflict error, got %v", err)
+	}
+	if doc := current(); doc["consoleAddress"] != "mine" {
+		t.Errorf("console address is %v, expected mine", doc["consoleAddress"])
+	}
+}
@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+)
+
+const (
+	SettingsOauthEndpoint = "api/v1/settings/oauth"
+	SettingsOidcEndpoint  = "api/v1/settings/oidc"
+)
+
+// The settings of an OAuth 2.0 or OpenID Connect identity provider.
+type ProviderSettings struct {
+	AuthUrl         string      `json:"authURL"`
+	Cert            string      `json:"cert"`
+	ClientId        string      `json:"clientID"`
+	ClientSecret    auth.Secret `json:"clientSecret"`
+	Enabled         bool        `json:"enabled"`
+	GroupClaim      string      `json:"groupClaim"`
+	GroupScope      string      `json:"groupScope"`
+	OpenIdIssuerUrl string      `json:"openIDIssuesURL"`
+	ProviderAlias   string      `json:"providerAlias"`
+	ProviderName    string      `json:"providerName"`
+	TokenUrl        string      `json:"tokenURL"`
+	UserClaim       string      `json:"userClaim"`
+}
+
+// Get the current OAuth settings.
+func GetOauthSettings(ctx context.Context, c api.Client) (ProviderSettings, error) {
+	var ans ProviderSettings
+	if err := c.Request(ctx, http.MethodGet, SettingsOauthEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting OAuth settings: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current OAuth settings.
+func UpdateOauthSettings(ctx context.Context, c api.Client, oauth ProviderSettings) error {
+	return c.UpdateDocument(ctx, SettingsOauthEndpoint, oauth)
+}
+
+// Reset the OAuth settings to the Console default, in which OAuth is disabled.
+func ResetOauthSettings(ctx context.Context, c api.Client) error {
+	return UpdateOauthSettings(ctx, c, ProviderSettings{})
+}
+
+// Get the current OpenID Connect settings.
+func GetOidcSettings(ctx context.Context, c api.Client) (ProviderSettings, error) {
+	var ans ProviderSettings
+	if err := c.Request(ctx, http.MethodGet, SettingsOidcEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting OpenID Connect settings: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current OpenID Connect settings.
+func UpdateOidcSettings(ctx context.Context, c api.Client, oidc ProviderSettings) error {
+	return c.UpdateDocument(ctx, SettingsOidcEndpoint, oidc)
+}
+
+// Reset the OpenID Connect settings to the Console default, in which OpenID
+// Connect is disabled.
+func ResetOidcSettings(ctx context.Context, c api.Client) error {
+	return UpdateOidcSettings(ctx, c, ProviderSettings{})
+}
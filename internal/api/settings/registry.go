@@ -1,6 +1,8 @@
 package settings
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -32,19 +34,173 @@ type RegistrySpecification struct {
 }
 
 // Get the current registry scan settings.
-func GetRegistrySettings(c api.Client) (RegistrySettings, error) {
+func GetRegistrySettings(ctx context.Context, c api.Client) (RegistrySettings, error) {
 	var ans RegistrySettings
-	if err := c.Request(http.MethodGet, SettingsRegistryEndpoint, nil, nil, &ans); err != nil {
-		return ans, fmt.Errorf("error getting registry settings: %s", err)
+	if err := c.Request(ctx, http.MethodGet, SettingsRegistryEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting registry settings: %w", err)
 	}
 	return ans, nil
 }
 
 // Update the current registry scan settings.
-func UpdateRegistrySettings(c api.Client, registry RegistrySettings) error {
-	return c.Request(http.MethodPut, SettingsRegistryEndpoint, nil, registry, nil)
+func UpdateRegistrySettings(ctx context.Context, c api.Client, registry RegistrySettings) error {
+	return c.UpdateDocument(ctx, SettingsRegistryEndpoint, registry)
 }
 
-func AddRegistrySetting(c api.Client, registry RegistrySpecification) error {
-	return c.Request(http.MethodPost, SettingsRegistryEndpoint, nil, registry, nil)
+// Add a registry to the registry scan settings.
+func AddRegistrySetting(ctx context.Context, c api.Client, registry RegistrySpecification) error {
+	unlock := c.Lock(SettingsRegistryEndpoint)
+	defer unlock()
+
+	return c.Request(ctx, http.MethodPost, SettingsRegistryEndpoint, nil, registry, nil)
+}
+
+// The fields that identify a registry specification, as the Console does not
+// give specifications an ID. Two specifications with the same key scan the
+// same images.
+type RegistryKey struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Os         string
+}
+
+func (s RegistrySpecification) Key() RegistryKey {
+	return RegistryKey{
+		Registry:   s.Registry,
+		Repository: s.Repository,
+		Tag:        s.Tag,
+		Os:         s.Os,
+	}
+}
+
+func (k RegistryKey) String() string {
+	return fmt.Sprintf("registry '%s' repository '%s' tag '%s' os '%s'", k.Registry, k.Repository, k.Tag, k.Os)
+}
+
+// The parts of the registry settings needed to manage single specifications.
+// Other fields and specifications are kept as they are, including fields the
+// SDK does not know about.
+type registryDocument struct {
+	fields         map[string]json.RawMessage
+	specifications []json.RawMessage
+	keys           []RegistryKey
+}
+
+func (doc *registryDocument) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &doc.fields); err != nil {
+		return err
+	}
+	doc.specifications = nil
+	if specifications, ok := doc.fields["specifications"]; ok {
+		if err := json.Unmarshal(specifications, &doc.specifications); err != nil {
+			return err
+		}
+	}
+	doc.keys = make([]RegistryKey, 0, len(doc.specifications))
+	for _, val := range doc.specifications {
+		var spec RegistrySpecification
+		if err := json.Unmarshal(val, &spec); err != nil {
+			return err
+		}
+		doc.keys = append(doc.keys, spec.Key())
+	}
+	return nil
+}
+
+func (doc registryDocument) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]json.RawMessage, len(doc.fields)+1)
+	for k, v := range doc.fields {
+		fields[k] = v
+	}
+	specifications, err := json.Marshal(doc.specifications)
+	if err != nil {
+		return nil, err
+	}
+	fields["specifications"] = specifications
+	return json.Marshal(fields)
+}
+
+// Get the index of the specification with the given key, or -1 if there is
+// no such specification.
+func (doc registryDocument) index(key RegistryKey) int {
+	for i, k := range doc.keys {
+		if k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get the registry specification with the given key.
+func GetRegistrySpecification(ctx context.Context, c api.Client, key RegistryKey) (*RegistrySpecification, error) {
+	var doc registryDocument
+	if err := c.Request(ctx, http.MethodGet, SettingsRegistryEndpoint, nil, nil, &doc); err != nil {
+		return nil, fmt.Errorf("error getting registry settings: %w", err)
+	}
+	i := doc.index(key)
+	if i < 0 {
+		return nil, api.NotFoundError("registry specification for %s not found", key)
+	}
+	var ans RegistrySpecification
+	if err := json.Unmarshal(doc.specifications[i], &ans); err != nil {
+		return nil, err
+	}
+	return &ans, nil
+}
+
+// Add a registry specification to the registry scan settings. Fails if there
+// already is a specification with the same key, so that a specification
+// managed elsewhere is not taken over.
+func CreateRegistrySpecification(ctx context.Context, c api.Client, spec RegistrySpecification) error {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	var doc registryDocument
+	return c.ModifyDocument(ctx, SettingsRegistryEndpoint, &doc, func() (interface{}, error) {
+		if doc.index(spec.Key()) >= 0 {
+			return nil, api.ConflictError("registry specification for %s already exists", spec.Key())
+		}
+		doc.specifications = append(doc.specifications, b)
+		return doc, nil
+	})
+}
+
+// Replace the registry specification with the same key, keeping its place
+// among the other specifications. If ctx expects a digest, the current
+// specification is checked against it.
+func UpdateRegistrySpecification(ctx context.Context, c api.Client, spec RegistrySpecification) error {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	var doc registryDocument
+	return c.ModifyDocument(ctx, SettingsRegistryEndpoint, &doc, func() (interface{}, error) {
+		i := doc.index(spec.Key())
+		if i < 0 {
+			return nil, api.NotFoundError("registry specification for %s not found", spec.Key())
+		}
+		var current RegistrySpecification
+		if err := json.Unmarshal(doc.specifications[i], &current); err != nil {
+			return nil, err
+		}
+		if err := api.CheckDigest(ctx, fmt.Sprintf("registry specification for %s", spec.Key()), current); err != nil {
+			return nil, err
+		}
+		doc.specifications[i] = b
+		return doc, nil
+	})
+}
+
+// Delete the registry specification with the given key, if it exists.
+func DeleteRegistrySpecification(ctx context.Context, c api.Client, key RegistryKey) error {
+	var doc registryDocument
+	return c.ModifyDocument(ctx, SettingsRegistryEndpoint, &doc, func() (interface{}, error) {
+		if i := doc.index(key); i >= 0 {
+			doc.specifications = append(doc.specifications[:i], doc.specifications[i+1:]...)
+			doc.keys = append(doc.keys[:i], doc.keys[i+1:]...)
+		}
+		return doc, nil
+	})
 }
@@ -0,0 +1,122 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+)
+
+// Serve a single settings document that PUT replaces.
+func newSettingsTestClient(t *testing.T, doc string) (api.Client, func() map[string]interface{}) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			io.WriteString(w, doc)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			doc = string(b)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := api.Client{
+		Config:     api.APIClientConfig{ConsoleURL: server.URL},
+		HTTPClient: server.Client(),
+	}
+	current := func() map[string]interface{} {
+		var ans map[string]interface{}
+		if err := json.Unmarshal([]byte(doc), &ans); err != nil {
+			t.Fatalf("error decoding document: %s", err)
+		}
+		return ans
+	}
+	return c, current
+}
+
+func repositories(doc map[string]interface{}) []string {
+	ans := make([]string, 0)
+	for _, spec := range doc["specifications"].([]interface{}) {
+		ans = append(ans, spec.(map[string]interface{})["repository"].(string))
+	}
+	return ans
+}
+
+const registryTestDoc = `{"harborScannerUrlSuffix":"x","specifications":[` +
+	`{"registry":"r","repository":"a","tag":"*","os":"linux","cap":5},` +
+	`{"registry":"r","repository":"b","tag":"*","os":"linux","unknownField":1}]}`
+
+func TestCreateRegistrySpecification(t *testing.T) {
+	c, current := newSettingsTestClient(t, registryTestDoc)
+
+	spec := RegistrySpecification{Registry: "r", Repository: "c", Tag: "*", Os: "linux"}
+	if err := CreateRegistrySpecification(context.Background(), c, spec); err != nil {
+		t.Fatalf("error creating specification: %s", err)
+	}
+
+	doc := current()
+	if actual := repositories(doc); !reflect.DeepEqual(actual, []string{"a", "b", "c"}) {
+		t.Errorf("got repositories %v, expected [a b c]", actual)
+	}
+	if doc["harborScannerUrlSuffix"] != "x" {
+		t.Error("other fields of the registry settings were not kept")
+	}
+	if doc["specifications"].([]interface{})[1].(map[string]interface{})["unknownField"] == nil {
+		t.Error("other specifications were not kept as they are")
+	}
+
+	spec.Cap = 10
+	if err := CreateRegistrySpecification(context.Background(), c, spec); !api.IsConflict(err) {
+		t.Errorf("expected a conflict error for an existing specification, got %v", err)
+	}
+}
+
+func TestUpdateRegistrySpecification(t *testing.T) {
+	c, current := newSettingsTestClient(t, registryTestDoc)
+
+	spec := RegistrySpecification{Registry: "r", Repository: "a", Tag: "*", Os: "linux", Cap: 10}
+	if err := UpdateRegistrySpecification(context.Background(), c, spec); err != nil {
+		t.Fatalf("error updating specification: %s", err)
+	}
+
+	doc := current()
+	if actual := repositories(doc); !reflect.DeepEqual(actual, []string{"a", "b"}) {
+		t.Errorf("got repositories %v, expected [a b]", actual)
+	}
+	if val := doc["specifications"].([]interface{})[0].(map[string]interface{})["cap"]; val != 10.0 {
+		t.Errorf("got cap %v, expected 10", val)
+	}
+
+	spec.Os = "windows"
+	if err := UpdateRegistrySpecification(context.Background(), c, spec); !api.IsNotFound(err) {
+		t.Errorf("expected a not found error for a missing specification, got %v", err)
+	}
+}
+
+func TestGetAndDeleteRegistrySpecification(t *testing.T) {
+	c, current := newSettingsTestClient(t, registryTestDoc)
+	key := RegistryKey{Registry: "r", Repository: "a", Tag: "*", Os: "linux"}
+
+	spec, err := GetRegistrySpecification(context.Background(), c, key)
+	if err != nil {
+		t.Fatalf("error getting specification: %s", err)
+	}
+	if spec.Cap != 5 {
+		t.Errorf("got cap %d, expected 5", spec.Cap)
+	}
+	if _, err := GetRegistrySpecification(context.Background(), c, RegistryKey{Registry: "r", Repository: "a"}); !api.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if err := DeleteRegistrySpecification(context.Background(), c, key); err != nil {
+		t.Fatalf("error deleting specification: %s", err)
+	}
+	if actual := repositories(current()); !reflect.DeepEqual(actual, []string{"b"}) {
+		t.Errorf("got repositories %v, expected [b]", actual)
+	}
+}
@@ -0,0 +1,45 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+)
+
+const SettingsSamlEndpoint = "api/v1/settings/saml"
+
+type SamlSettings struct {
+	AppId         string      `json:"appId"`
+	AppSecret     auth.Secret `json:"appSecret"`
+	Audience      string      `json:"audience"`
+	Cert          string      `json:"cert"`
+	ConsoleUrl    string      `json:"consoleURL"`
+	Enabled       bool        `json:"enabled"`
+	Issuer        string      `json:"issuer"`
+	ProviderAlias string      `json:"providerAlias"`
+	TenantId      string      `json:"tenantId"`
+	Type          string      `json:"type"`
+	Url           string      `json:"url"`
+}
+
+// Get the current SAML settings.
+func GetSamlSettings(ctx context.Context, c api.Client) (SamlSettings, error) {
+	var ans SamlSettings
+	if err := c.Request(ctx, http.MethodGet, SettingsSamlEndpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting SAML settings: %w", err)
+	}
+	return ans, nil
+}
+
+// Update the current SAML settings.
+func UpdateSamlSettings(ctx context.Context, c api.Client, saml SamlSettings) error {
+	return c.UpdateDocument(ctx, SettingsSamlEndpoint, saml)
+}
+
+// Reset the SAML settings to the Console default, in which SAML is disabled.
+func ResetSamlSettings(ctx context.Context, c api.Client) error {
+	return UpdateSamlSettings(ctx, c, SamlSettings{})
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// Build the TLS configuration from the configured CA bundle and client
+// certificate. The system trust store is used in addition to the CA bundle.
+func newTLSConfig(config APIClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SkipCertVerification,
+	}
+
+	if config.CACertFile != "" || config.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if config.CACertFile != "" {
+			pem, err := ioutil.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading CA certificate file: %s", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no PEM-encoded certificates found in CA certificate file %s", config.CACertFile)
+			}
+		}
+		if config.CACertPEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+				return nil, fmt.Errorf("no PEM-encoded certificates found in CA certificate PEM")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mutual TLS")
+		}
+		certPEM, err := readPEM(config.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client certificate: %s", err)
+		}
+		keyPEM, err := readPEM(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("error reading client key: %s", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// Client certificates and keys are given either as PEM or as a path to a PEM file.
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(value)
+}
+
+// Explain how to fix a failed certificate verification, which otherwise
+// surfaces as a bare x509 error.
+func certificateError(err error) error {
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		hostname         x509.HostnameError
+		invalid          x509.CertificateInvalidError
+	)
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid) {
+		return fmt.Errorf("failed to verify the Console's TLS certificate: %w. Set ca_cert_file or ca_cert_pem to trust the CA that issued it, or set skip_cert_verification to disable verification", err)
+	}
+	return err
+}
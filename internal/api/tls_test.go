@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+)
+
+// Start a Console serving HTTPS, optionally requiring a client certificate
+// issued by clientCA.
+func newTLSConsole(t *testing.T, clientCA *x509.Certificate) (*consoletest.Server, string) {
+	console := consoletest.NewUnstartedServer()
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA)
+		console.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  pool,
+		}
+	}
+	console.StartTLS()
+	t.Cleanup(console.Close)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: console.Certificate().Raw})
+	return console, string(caPEM)
+}
+
+// Create a self-signed client certificate and return it with its key as PEM.
+func newClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "consoletest client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return cert, string(certPEM), string(keyPEM)
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAPIClientVerifiesCertificate(t *testing.T) {
+	console, _ := newTLSConsole(t, nil)
+
+	_, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err == nil || !strings.Contains(err.Error(), "ca_cert_file") {
+		t.Errorf("expected an untrusted certificate to fail with a hint, got %v", err)
+	}
+}
+
+func TestAPIClientCACert(t *testing.T) {
+	console, caPEM := newTLSConsole(t, nil)
+
+	cases := map[string]APIClientConfig{
+		"pem":  {CACertPEM: caPEM},
+		"file": {CACertFile: writeFile(t, "ca.pem", caPEM)},
+		"skip": {SkipCertVerification: true},
+	}
+	for name, config := range cases {
+		config.ConsoleURL = console.URL
+		config.Username = console.Username
+		config.Password = console.Password
+		if _, err := APIClient(context.Background(), config); err != nil {
+			t.Errorf("%s: error creating client: %s", name, err)
+		}
+	}
+}
+
+func TestAPIClientInvalidCACert(t *testing.T) {
+	config := APIClientConfig{CACertPEM: "not a certificate"}
+	if _, err := newTLSConfig(config); err == nil {
+		t.Errorf("expected an invalid CA certificate to fail")
+	}
+}
+
+func TestAPIClientClientCert(t *testing.T) {
+	clientCA, certPEM, keyPEM := newClientCert(t)
+	console, caPEM := newTLSConsole(t, clientCA)
+
+	cases := []struct {
+		name       string
+		clientCert string
+		clientKey  string
+		ok         bool
+	}{
+		{"no certificate", "", "", false},
+		{"pem", certPEM, keyPEM, true},
+		{"file", writeFile(t, "client.pem", certPEM), writeFile(t, "client-key.pem", keyPEM), true},
+		{"missing key", certPEM, "", false},
+	}
+	for _, tc := range cases {
+		_, err := APIClient(context.Background(), APIClientConfig{
+			ConsoleURL: console.URL,
+			Username:   console.Username,
+			Password:   console.Password,
+			CACertPEM:  caPEM,
+			ClientCert: tc.clientCert,
+			ClientKey:  tc.clientKey,
+		})
+		if tc.ok && err != nil {
+			t.Errorf("%s: error creating client: %s", tc.name, err)
+		}
+		if !tc.ok && err == nil {
+			t.Errorf("%s: expected creating client to fail", tc.name)
+		}
+	}
+}
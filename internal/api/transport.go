@@ -0,0 +1,64 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Create the HTTP client used to talk to the Console.
+func newHTTPClient(config APIClientConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := newProxyFunc(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start from the default transport to keep its timeouts and connection pooling.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = proxy
+	return &http.Client{Transport: transport}, nil
+}
+
+// Select the proxy for a request. The configured proxy URL and exclusions
+// take precedence over the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables. Proxy credentials apply to whichever proxy is selected.
+func newProxyFunc(config APIClientConfig) (func(*http.Request) (*url.URL, error), error) {
+	proxyConfig := httpproxy.FromEnvironment()
+	if config.ProxyURL != "" {
+		u, err := url.Parse(config.ProxyURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", config.ProxyURL)
+		}
+		proxyConfig.HTTPProxy = config.ProxyURL
+		proxyConfig.HTTPSProxy = config.ProxyURL
+	}
+	if config.NoProxy != "" {
+		proxyConfig.NoProxy = config.NoProxy
+	}
+	if config.ProxyPassword != "" && config.ProxyUsername == "" {
+		return nil, fmt.Errorf("a proxy username is required with a proxy password")
+	}
+
+	proxyForURL := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		u, err := proxyForURL(req.URL)
+		if err != nil || u == nil {
+			return u, err
+		}
+		if config.ProxyUsername != "" {
+			// The proxy URL is shared by all requests, so the credentials
+			// are added to a copy.
+			p := *u
+			p.User = url.UserPassword(config.ProxyUsername, config.ProxyPassword)
+			return &p, nil
+		}
+		return u, nil
+	}, nil
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+)
+
+// Start a forward proxy to the Console that requires basic authentication.
+func newProxy(t *testing.T, console *consoletest.Server, username, password string) (*httptest.Server, *int32) {
+	target, err := url.Parse(console.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forward := httputil.NewSingleHostReverseProxy(target)
+
+	var requests int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		req := &http.Request{Header: http.Header{"Authorization": r.Header["Proxy-Authorization"]}}
+		if u, p, ok := req.BasicAuth(); !ok || u != username || p != password {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+		forward.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+	return proxy, &requests
+}
+
+func TestAPIClientProxy(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+	proxy, requests := newProxy(t, console, "proxyuser", "proxypass")
+
+	// Requests to loopback addresses never use a proxy, so the Console is
+	// addressed by a name only the proxy can resolve.
+	client, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL:    "http://console.example.test",
+		Username:      console.Username,
+		Password:      console.Password,
+		ProxyURL:      proxy.URL,
+		ProxyUsername: "proxyuser",
+		ProxyPassword: "proxypass",
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	var ans []map[string]interface{}
+	if err := client.Request(context.Background(), http.MethodGet, "api/v1/collections", nil, nil, &ans); err != nil {
+		t.Fatalf("error in request: %s", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 2 {
+		t.Errorf("proxy received %d requests, expected 2", n)
+	}
+}
+
+func TestAPIClientProxyUnauthorized(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+	proxy, _ := newProxy(t, console, "proxyuser", "proxypass")
+
+	_, err := APIClient(context.Background(), APIClientConfig{
+		ConsoleURL: "http://console.example.test",
+		Username:   console.Username,
+		Password:   console.Password,
+		ProxyURL:   proxy.URL,
+	})
+	if err == nil {
+		t.Errorf("expected a request without proxy credentials to fail")
+	}
+}
+
+func TestProxyFunc(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://env-proxy.example.test:3128")
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.test:3128")
+	t.Setenv("NO_PROXY", "")
+
+	cases := []struct {
+		name     string
+		config   APIClientConfig
+		console  string
+		expected string
+	}{
+		{"environment", APIClientConfig{}, "https://console.example.test", "http://env-proxy.example.test:3128"},
+		{"proxy url", APIClientConfig{ProxyURL: "http://proxy.example.test:8080"}, "https://console.example.test", "http://proxy.example.test:8080"},
+		{"credentials", APIClientConfig{ProxyURL: "http://proxy.example.test:8080", ProxyUsername: "u", ProxyPassword: "p"}, "https://console.example.test", "http://u:p@proxy.example.test:8080"},
+		{"credentials for environment proxy", APIClientConfig{ProxyUsername: "u", ProxyPassword: "p"}, "http://console.example.test", "http://u:p@env-proxy.example.test:3128"},
+		{"no proxy", APIClientConfig{ProxyURL: "http://proxy.example.test:8080", NoProxy: "example.test"}, "https://console.example.test", ""},
+		{"no proxy for other domain", APIClientConfig{ProxyURL: "http://proxy.example.test:8080", NoProxy: "example.com"}, "https://console.example.test", "http://proxy.example.test:8080"},
+	}
+	for _, tc := range cases {
+		proxy, err := newProxyFunc(tc.config)
+		if err != nil {
+			t.Fatalf("%s: error creating proxy function: %s", tc.name, err)
+		}
+		req, err := http.NewRequest(http.MethodGet, tc.console, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := proxy(req)
+		if err != nil {
+			t.Fatalf("%s: error selecting proxy: %s", tc.name, err)
+		}
+		actual := ""
+		if u != nil {
+			actual = u.String()
+		}
+		if actual != tc.expected {
+			t.Errorf("%s: proxy is %q, expected %q", tc.name, actual, tc.expected)
+		}
+	}
+}
+
+// Terraform sends requests in parallel, and the proxy function must not
+// change the proxy URL it shares between them. Run with -race.
+func TestProxyFuncConcurrent(t *testing.T) {
+	proxy, err := newProxyFunc(APIClientConfig{ProxyURL: "http://proxy.example.test:8080", ProxyUsername: "u", ProxyPassword: "p"})
+	if err != nil {
+		t.Fatalf("error creating proxy function: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodGet, "https://console.example.test", nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			u, err := proxy(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if actual := u.String(); actual != "http://u:p@proxy.example.test:8080" {
+				errs <- fmt.Errorf("proxy is %q, expected %q", actual, "http://u:p@proxy.example.test:8080")
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestProxyFuncInvalid(t *testing.T) {
+	cases := map[string]APIClientConfig{
+		"invalid url":               {ProxyURL: "://proxy"},
+		"password without username": {ProxyURL: "http://proxy.example.test", ProxyPassword: "p"},
+	}
+	for name, config := range cases {
+		if _, err := newProxyFunc(config); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
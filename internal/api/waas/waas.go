@@ -0,0 +1,304 @@
+// Package waas manages the Web Application and API Security (WAAS) policies,
+// which protect web applications and APIs from OWASP Top 10 attacks, bots
+// and requests that do not match their API specification.
+package waas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+)
+
+const (
+	ContainerEndpoint   = "api/v1/policies/firewall/app/container"
+	HostEndpoint        = "api/v1/policies/firewall/app/host"
+	AppEmbeddedEndpoint = "api/v1/policies/firewall/app/app-embedded"
+	ServerlessEndpoint  = "api/v1/policies/firewall/app/serverless"
+	OutOfBandEndpoint   = "api/v1/policies/firewall/app/out-of-band"
+	APISpecEndpoint     = "api/v1/policies/firewall/app/apispec"
+)
+
+type Policy struct {
+	Id      string `json:"_id,omitempty"`
+	MaxPort int    `json:"maxPort,omitempty"`
+	MinPort int    `json:"minPort,omitempty"`
+	Rules   []Rule `json:"rules"`
+}
+
+type Rule struct {
+	AllowMalformedHttpHeaderNames bool                    `json:"allowMalformedHttpHeaderNames,omitempty"`
+	Applications                  []Application           `json:"applicationsSpec,omitempty"`
+	Collections                   []collection.Collection `json:"collections,omitempty"`
+	Disabled                      bool                    `json:"disabled"`
+	Name                          string                  `json:"name,omitempty"`
+	Notes                         string                  `json:"notes,omitempty"`
+	ReadTimeoutSeconds            int                     `json:"readTimeoutSeconds,omitempty"`
+	SkipApiLearning               bool                    `json:"skipAPILearning,omitempty"`
+}
+
+// An application protected by a rule, with its API specification and the
+// effects of the protections applied to it.
+type Application struct {
+	ApiSpec             ApiSpec        `json:"apiSpec,omitempty"`
+	AppId               string         `json:"appID,omitempty"`
+	AttackTools         Protection     `json:"attackTools,omitempty"`
+	BotProtection       BotProtection  `json:"botProtectionSpec,omitempty"`
+	ClickjackingEnabled bool           `json:"clickjackingEnabled"`
+	CodeInjection       Protection     `json:"codeInjection,omitempty"`
+	CommandInjection    Protection     `json:"cmdi,omitempty"`
+	CsrfEnabled         bool           `json:"csrfEnabled"`
+	CustomRules         []CustomRule   `json:"customRules,omitempty"`
+	IntelGathering      IntelGathering `json:"intelGathering,omitempty"`
+	LocalFileInclusion  Protection     `json:"lfi,omitempty"`
+	MalformedRequest    Protection     `json:"malformedReq,omitempty"`
+	Shellshock          Protection     `json:"shellshock,omitempty"`
+	SqlInjection        Protection     `json:"sqli,omitempty"`
+	Xss                 Protection     `json:"xss,omitempty"`
+}
+
+type ApiSpec struct {
+	Description              string     `json:"description,omitempty"`
+	Effect                   string     `json:"effect,omitempty"`
+	Endpoints                []Endpoint `json:"endpoints,omitempty"`
+	FallbackEffect           string     `json:"fallbackEffect,omitempty"`
+	Paths                    []Path     `json:"paths,omitempty"`
+	QueryParamFallbackEffect string     `json:"queryParamFallbackEffect,omitempty"`
+	SkipLearning             bool       `json:"skipLearning,omitempty"`
+}
+
+type Endpoint struct {
+	BasePath     string `json:"basePath,omitempty"`
+	ExposedPort  int    `json:"exposedPort,omitempty"`
+	Grpc         bool   `json:"grpc,omitempty"`
+	Host         string `json:"host,omitempty"`
+	Http2        bool   `json:"http2,omitempty"`
+	InternalPort int    `json:"internalPort,omitempty"`
+	Tls          bool   `json:"tls,omitempty"`
+}
+
+type Path struct {
+	Methods []Method `json:"methods,omitempty"`
+	Path    string   `json:"path,omitempty"`
+}
+
+type Method struct {
+	Method     string  `json:"method,omitempty"`
+	Parameters []Param `json:"parameters,omitempty"`
+}
+
+type Param struct {
+	AllowEmptyValue bool    `json:"allowEmptyValue,omitempty"`
+	Array           bool    `json:"array,omitempty"`
+	Explode         bool    `json:"explode,omitempty"`
+	Location        string  `json:"location,omitempty"`
+	Max             float64 `json:"max,omitempty"`
+	Min             float64 `json:"min,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Required        bool    `json:"required,omitempty"`
+	Style           string  `json:"style,omitempty"`
+	Type            string  `json:"type,omitempty"`
+}
+
+// The effect of a protection against an attack, such as SQL injection, and
+// the parts of a request it does not inspect.
+type Protection struct {
+	Effect          string           `json:"effect,omitempty"`
+	ExceptionFields []ExceptionField `json:"exceptionFields,omitempty"`
+}
+
+type ExceptionField struct {
+	Key      string `json:"key,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+type IntelGathering struct {
+	InfoLeakageEffect         string `json:"infoLeakageEffect,omitempty"`
+	RemoveFingerprintsEnabled bool   `json:"removeFingerprintsEnabled,omitempty"`
+}
+
+type BotProtection struct {
+	InterstitialPage  bool             `json:"interstitialPage,omitempty"`
+	JsInjection       JsInjection      `json:"jsInjectionSpec,omitempty"`
+	KnownBots         KnownBots        `json:"knownBotProtectionsSpec,omitempty"`
+	SessionValidation string           `json:"sessionValidation,omitempty"`
+	UnknownBots       UnknownBots      `json:"unknownBotProtectionSpec,omitempty"`
+	UserDefinedBots   []UserDefinedBot `json:"userDefinedBots,omitempty"`
+}
+
+type JsInjection struct {
+	Enabled       bool   `json:"enabled,omitempty"`
+	TimeoutEffect string `json:"timeoutEffect,omitempty"`
+}
+
+type KnownBots struct {
+	Archiving            string `json:"archiving,omitempty"`
+	BusinessAnalytics    string `json:"businessAnalytics,omitempty"`
+	CareerSearch         string `json:"careerSearch,omitempty"`
+	ContentFeedClients   string `json:"contentFeedClients,omitempty"`
+	Educational          string `json:"educational,omitempty"`
+	Financial            string `json:"financial,omitempty"`
+	MediaSearch          string `json:"mediaSearch,omitempty"`
+	News                 string `json:"news,omitempty"`
+	SearchEngineCrawlers string `json:"searchEngineCrawlers,omitempty"`
+}
+
+type UnknownBots struct {
+	ApiLibraries         string           `json:"apiLibraries,omitempty"`
+	BotImpersonation     string           `json:"botImpersonation,omitempty"`
+	BrowserImpersonation string           `json:"browserImpersonation,omitempty"`
+	Generic              string           `json:"generic,omitempty"`
+	HeadlessBrowsers     string           `json:"headlessBrowsers,omitempty"`
+	HttpLibraries        string           `json:"httpLibraries,omitempty"`
+	RequestAnomalies     RequestAnomalies `json:"requestAnomalies,omitempty"`
+	WebAutomationTools   string           `json:"webAutomationTools,omitempty"`
+	WebScrapers          string           `json:"webScrapers,omitempty"`
+}
+
+type RequestAnomalies struct {
+	Effect    string `json:"effect,omitempty"`
+	Threshold int    `json:"threshold,omitempty"`
+}
+
+type UserDefinedBot struct {
+	Effect       string   `json:"effect,omitempty"`
+	HeaderName   string   `json:"headerName,omitempty"`
+	HeaderValues []string `json:"headerValues,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Subnets      []string `json:"subnets,omitempty"`
+}
+
+// A reference to a custom rule of type waas-request or waas-response.
+type CustomRule struct {
+	Action string `json:"action,omitempty"`
+	Effect string `json:"effect,omitempty"`
+	Id     int    `json:"_id,omitempty"`
+}
+
+// Get the current container WAAS policy.
+func GetContainer(ctx context.Context, c api.Client) (Policy, error) {
+	return get(ctx, c, ContainerEndpoint, "container")
+}
+
+// Get the current host WAAS policy.
+func GetHost(ctx context.Context, c api.Client) (Policy, error) {
+	return get(ctx, c, HostEndpoint, "host")
+}
+
+// Get the current App-Embedded WAAS policy.
+func GetAppEmbedded(ctx context.Context, c api.Client) (Policy, error) {
+	return get(ctx, c, AppEmbeddedEndpoint, "App-Embedded")
+}
+
+// Get the current serverless WAAS policy.
+func GetServerless(ctx context.Context, c api.Client) (Policy, error) {
+	return get(ctx, c, ServerlessEndpoint, "serverless")
+}
+
+// Get the current out-of-band WAAS policy.
+func GetOutOfBand(ctx context.Context, c api.Client) (Policy, error) {
+	return get(ctx, c, OutOfBandEndpoint, "out-of-band")
+}
+
+// Update the current container WAAS policy.
+func UpdateContainer(ctx context.Context, c api.Client, policy Policy) error {
+	return update(ctx, c, ContainerEndpoint, policy)
+}
+
+// Update the current host WAAS policy.
+func UpdateHost(ctx context.Context, c api.Client, policy Policy) error {
+	return update(ctx, c, HostEndpoint, policy)
+}
+
+// Update the current App-Embedded WAAS policy.
+func UpdateAppEmbedded(ctx context.Context, c api.Client, policy Policy) error {
+	return update(ctx, c, AppEmbeddedEndpoint, policy)
+}
+
+// Update the current serverless WAAS policy.
+func UpdateServerless(ctx context.Context, c api.Client, policy Policy) error {
+	return update(ctx, c, ServerlessEndpoint, policy)
+}
+
+// Update the current out-of-band WAAS policy.
+func UpdateOutOfBand(ctx context.Context, c api.Client, policy Policy) error {
+	return update(ctx, c, OutOfBandEndpoint, policy)
+}
+
+// Reset the container WAAS policy to the Console default, which has no rules.
+func ResetContainer(ctx context.Context, c api.Client) error {
+	return reset(ctx, c, ContainerEndpoint)
+}
+
+// Reset the host WAAS policy to the Console default, which has no rules.
+func ResetHost(ctx context.Context, c api.Client) error {
+	return reset(ctx, c, HostEndpoint)
+}
+
+// Reset the App-Embedded WAAS policy to the Console default, which has no
+// rules.
+func ResetAppEmbedded(ctx context.Context, c api.Client) error {
+	return reset(ctx, c, AppEmbeddedEndpoint)
+}
+
+// Reset the serverless WAAS policy to the Console default, which has no rules.
+func ResetServerless(ctx context.Context, c api.Client) error {
+	return reset(ctx, c, ServerlessEndpoint)
+}
+
+// Reset the out-of-band WAAS policy to the Console default, which has no
+// rules.
+func ResetOutOfBand(ctx context.Context, c api.Client) error {
+	return reset(ctx, c, OutOfBandEndpoint)
+}
+
+// Convert an OpenAPI 2 or 3 specification in JSON format into an API
+// specification for an application.
+func ImportAPISpec(ctx context.Context, c api.Client, spec string) (ApiSpec, error) {
+	var ans ApiSpec
+	if !json.Valid([]byte(spec)) {
+		return ans, fmt.Errorf("error importing OpenAPI specification: not valid JSON")
+	}
+	if err := c.Request(ctx, http.MethodPost, APISpecEndpoint, nil, json.RawMessage(spec), &ans); err != nil {
+		return ans, fmt.Errorf("error importing OpenAPI specification: %w", err)
+	}
+	return ans, nil
+}
+
+func get(ctx context.Context, c api.Client, endpoint, kind string) (Policy, error) {
+	var ans Policy
+	if err := c.Request(ctx, http.MethodGet, endpoint, nil, nil, &ans); err != nil {
+		return ans, fmt.Errorf("error getting %s WAAS policy: %w", kind, err)
+	}
+	return ans, nil
+}
+
+// Replace the policy. Only the container and host policies have a port range
+// that WAAS proxies listen on, and the current range is kept if the policy
+// sets none. If ctx expects a digest, the current policy is checked against
+// it.
+func update(ctx context.Context, c api.Client, endpoint string, policy Policy) error {
+	var current Policy
+	return c.ModifyDocument(ctx, endpoint, &current, func() (interface{}, error) {
+		if err := api.CheckDigest(ctx, endpoint, current); err != nil {
+			return nil, err
+		}
+		if policy.MinPort == 0 && policy.MaxPort == 0 {
+			policy.MinPort, policy.MaxPort = current.MinPort, current.MaxPort
+		}
+		return policy, nil
+	})
+}
+
+// Remove all rules, keeping the other settings of the policy, such as the
+// port range of the container and host policies.
+func reset(ctx context.Context, c api.Client, endpoint string) error {
+	var policy Policy
+	return c.ModifyDocument(ctx, endpoint, &policy, func() (interface{}, error) {
+		policy.Rules = []Rule{}
+		return policy, nil
+	})
+}
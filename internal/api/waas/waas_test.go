@@ -0,0 +1,94 @@
+package waas
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+)
+
+func newTestClient(t *testing.T) api.Client {
+	console := consoletest.NewServer()
+	t.Cleanup(console.Close)
+
+	client, err := api.APIClient(context.Background(), api.APIClientConfig{
+		ConsoleURL: console.URL,
+		Username:   console.Username,
+		Password:   console.Password,
+	})
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	return *client
+}
+
+func TestImportAPISpec(t *testing.T) {
+	c := newTestClient(t)
+
+	spec, err := ImportAPISpec(context.Background(), c, `{
+		"openapi": "3.0.0",
+		"info": {"title": "Pets"},
+		"paths": {
+			"/pets/{id}": {"get": {"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}}]}},
+			"/pets": {"get": {}, "post": {}}
+		}
+	}`)
+	if err != nil {
+		t.Fatalf("error importing specification: %s", err)
+	}
+
+	expected := ApiSpec{
+		Description: "Pets",
+		Paths: []Path{
+			{Path: "/pets", Methods: []Method{{Method: "GET"}, {Method: "POST"}}},
+			{Path: "/pets/{id}", Methods: []Method{{Method: "GET", Parameters: []Param{{Name: "id", Location: "path", Required: true, Type: "integer"}}}}},
+		},
+	}
+	if !reflect.DeepEqual(spec, expected) {
+		t.Errorf("got %+v, expected %+v", spec, expected)
+	}
+
+	if _, err := ImportAPISpec(context.Background(), c, `openapi: 3.0.0`); err == nil {
+		t.Error("expected an error for a specification that is not JSON")
+	}
+}
+
+func TestKeepPortRange(t *testing.T) {
+	c := newTestClient(t)
+
+	policy := Policy{
+		MinPort: 30000,
+		MaxPort: 31000,
+		Rules:   []Rule{{Name: "rule"}},
+	}
+	if err := UpdateContainer(context.Background(), c, policy); err != nil {
+		t.Fatalf("error updating policy: %s", err)
+	}
+	if err := UpdateContainer(context.Background(), c, Policy{Rules: []Rule{{Name: "other"}}}); err != nil {
+		t.Fatalf("error updating policy: %s", err)
+	}
+	updated, err := GetContainer(context.Background(), c)
+	if err != nil {
+		t.Fatalf("error getting policy: %s", err)
+	}
+	if updated.MinPort != 30000 || updated.MaxPort != 31000 {
+		t.Errorf("port range was not kept on update: %d-%d", updated.MinPort, updated.MaxPort)
+	}
+	if err := ResetContainer(context.Background(), c); err != nil {
+		t.Fatalf("error resetting policy: %s", err)
+	}
+
+	var actual map[string]interface{}
+	if err := c.Request(context.Background(), http.MethodGet, ContainerEndpoint, nil, nil, &actual); err != nil {
+		t.Fatalf("error getting policy: %s", err)
+	}
+	if rules, _ := actual["rules"].([]interface{}); rules == nil || len(rules) != 0 {
+		t.Errorf("got rules %v, expected an empty list", actual["rules"])
+	}
+	if actual["minPort"] != float64(30000) || actual["maxPort"] != float64(31000) {
+		t.Errorf("port range was not kept: %v", actual)
+	}
+}
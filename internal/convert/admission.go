@@ -10,31 +10,38 @@ func SchemaToAdmissionRules(d *schema.ResourceData) ([]policy.AdmissionRule, err
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.AdmissionRule{}
-
-			parsedRule.Description = presentRule["description"].(string)
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Script = presentRule["script"].(string)
-
-			parsedRules = append(parsedRules, parsedRule)
+			parsedRules = append(parsedRules, SchemaToAdmissionRule(val.(map[string]interface{})))
 		}
 	}
 	return parsedRules, nil
 }
 
+func SchemaToAdmissionRule(presentRule map[string]interface{}) policy.AdmissionRule {
+	parsedRule := policy.AdmissionRule{}
+
+	parsedRule.Description = presentRule["description"].(string)
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Script = presentRule["script"].(string)
+
+	return parsedRule
+}
+
 func AdmissionRulesToSchema(in []policy.AdmissionRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["description"] = val.Description
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["name"] = val.Name
-		m["script"] = val.Script
-		ans = append(ans, m)
+		ans = append(ans, AdmissionRuleToSchema(val))
 	}
 	return ans
 }
+
+func AdmissionRuleToSchema(val policy.AdmissionRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["description"] = val.Description
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["script"] = val.Script
+	return m
+}
@@ -2,6 +2,7 @@ package convert
 
 import (
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/alertprofile"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -260,6 +261,16 @@ func AlertProfilePoliciesToSchema(d *alertprofile.Policy) interface{} {
 		}
 	}
 
+	if d.VmVulnerability.Enabled {
+		alertTriggerPolicies["vm_vulnerability"] = []interface{}{
+			map[string]interface{}{
+				"enabled":   d.VmVulnerability.Enabled,
+				"all_rules": d.VmVulnerability.Allrules,
+				"rules":     d.VmVulnerability.Rules,
+			},
+		}
+	}
+
 	if d.WaasHealth.Enabled {
 		alertTriggerPolicies["waas_health"] = []interface{}{
 			map[string]interface{}{
@@ -290,16 +301,7 @@ func SchemaToAlertprofile(d *schema.ResourceData) (alertprofile.AlertProfile, er
 		parsedAlertProfile.Owner = val.(string)
 	}
 
-	if wc, ok := d.GetOk("webhook"); ok {
-		for _, val := range wc.([]interface{}) {
-			parsedAlertProfile.Webhook.Url = val.(map[string]interface{})["url"].(string)
-			parsedAlertProfile.Webhook.CredentialId = val.(map[string]interface{})["credential_id"].(string)
-			parsedAlertProfile.Webhook.CaCert = val.(map[string]interface{})["custom_ca"].(string)
-			parsedAlertProfile.Webhook.Json = val.(map[string]interface{})["custom_json"].(string)
-		}
-	}
-
-	parsedAlertProfile.Webhook.Enabled = true // Currently only supporting webhook alert profiles
+	schemaToAlertProfileChannels(d, &parsedAlertProfile)
 
 	if alertTriggers, ok := d.GetOk("policy"); ok {
 		for _, alertTrigger := range alertTriggers.([]interface{}) {
@@ -384,6 +386,15 @@ func SchemaToAlertprofile(d *schema.ResourceData) (alertprofile.AlertProfile, er
 				}
 			}
 
+			for _, cv := range alertTrigger.(map[string]interface{})["container_runtime"].([]interface{}) {
+				parsedAlertProfile.Policy.ContainerRuntime.Enabled = cv.(map[string]interface{})["enabled"].(bool)
+				parsedAlertProfile.Policy.ContainerRuntime.Allrules = cv.(map[string]interface{})["all_rules"].(bool)
+
+				for _, rule := range cv.(map[string]interface{})["rules"].([]interface{}) {
+					parsedAlertProfile.Policy.ContainerRuntime.Rules = append(parsedAlertProfile.Policy.ContainerRuntime.Rules, rule.(string))
+				}
+			}
+
 			for _, cv := range alertTrigger.(map[string]interface{})["container_vulnerability"].([]interface{}) {
 				parsedAlertProfile.Policy.ContainerVulnerability.Enabled = cv.(map[string]interface{})["enabled"].(bool)
 				parsedAlertProfile.Policy.ContainerVulnerability.Allrules = cv.(map[string]interface{})["all_rules"].(bool)
@@ -541,3 +552,196 @@ func SchemaToAlertprofile(d *schema.ResourceData) (alertprofile.AlertProfile, er
 
 	return parsedAlertProfile, nil
 }
+
+// Alert channels are enabled by their block being present.
+func schemaToAlertProfileChannels(d *schema.ResourceData, parsedAlertProfile *alertprofile.AlertProfile) {
+	if _, ok := d.GetOk("email"); ok {
+		parsedAlertProfile.Email = alertprofile.Email{
+			Enabled:      true,
+			CredentialId: d.Get("email.0.credential_id").(string),
+			From:         d.Get("email.0.from").(string),
+			Port:         d.Get("email.0.port").(int),
+			Recipients:   SchemaToStringSlice(d.Get("email.0.recipients").([]interface{})),
+			SmtpAddress:  d.Get("email.0.smtp_address").(string),
+			Ssl:          d.Get("email.0.ssl").(bool),
+		}
+	}
+	if _, ok := d.GetOk("gcp_pubsub"); ok {
+		parsedAlertProfile.GcpPubsub = alertprofile.GcpPubsub{
+			Enabled:      true,
+			CredentialId: d.Get("gcp_pubsub.0.credential_id").(string),
+			Topic:        d.Get("gcp_pubsub.0.topic").(string),
+		}
+	}
+	if _, ok := d.GetOk("jira"); ok {
+		parsedAlertProfile.Jira = alertprofile.Jira{
+			Enabled:      true,
+			Assignee:     d.Get("jira.0.assignee").(string),
+			BaseUrl:      d.Get("jira.0.base_url").(string),
+			CaCert:       d.Get("jira.0.ca_cert").(string),
+			CredentialId: d.Get("jira.0.credential_id").(string),
+			IssueType:    d.Get("jira.0.issue_type").(string),
+			Labels:       SchemaToStringSlice(d.Get("jira.0.labels").([]interface{})),
+			Priority:     d.Get("jira.0.priority").(string),
+			ProjectKey:   d.Get("jira.0.project_key").(string),
+		}
+	}
+	if _, ok := d.GetOk("pagerduty"); ok {
+		parsedAlertProfile.PagerDuty = alertprofile.PagerDuty{
+			Enabled:    true,
+			RoutingKey: auth.Secret{Plain: d.Get("pagerduty.0.routing_key").(string)},
+			Severity:   d.Get("pagerduty.0.severity").(string),
+			Summary:    d.Get("pagerduty.0.summary").(string),
+		}
+	}
+	if _, ok := d.GetOk("security_center"); ok {
+		parsedAlertProfile.SecurityCenter = alertprofile.SecurityCenter{
+			Enabled:      true,
+			CredentialId: d.Get("security_center.0.credential_id").(string),
+			SourceID:     d.Get("security_center.0.source_id").(string),
+		}
+	}
+	if _, ok := d.GetOk("security_hub"); ok {
+		parsedAlertProfile.SecurityHub = alertprofile.SecurityHub{
+			Enabled:      true,
+			AccountID:    d.Get("security_hub.0.account_id").(string),
+			CredentialId: d.Get("security_hub.0.credential_id").(string),
+			Region:       d.Get("security_hub.0.region").(string),
+		}
+	}
+	if _, ok := d.GetOk("service_now"); ok {
+		parsedAlertProfile.ServiceNow = alertprofile.ServiceNow{
+			Enabled:      true,
+			Address:      d.Get("service_now.0.address").(string),
+			Assignee:     d.Get("service_now.0.assignee").(string),
+			CaCert:       d.Get("service_now.0.ca_cert").(string),
+			CredentialId: d.Get("service_now.0.credential_id").(string),
+		}
+	}
+	if _, ok := d.GetOk("slack"); ok {
+		parsedAlertProfile.Slack = alertprofile.Slack{
+			Enabled:    true,
+			Channels:   SchemaToStringSlice(d.Get("slack.0.channels").([]interface{})),
+			Users:      SchemaToStringSlice(d.Get("slack.0.users").([]interface{})),
+			WebhookUrl: d.Get("slack.0.webhook_url").(string),
+		}
+	}
+	if _, ok := d.GetOk("splunk"); ok {
+		parsedAlertProfile.Splunk = alertprofile.Splunk{
+			Enabled:    true,
+			AuthToken:  auth.Secret{Plain: d.Get("splunk.0.auth_token").(string)},
+			Json:       d.Get("splunk.0.custom_json").(string),
+			SourceType: d.Get("splunk.0.source_type").(string),
+			Url:        d.Get("splunk.0.url").(string),
+		}
+	}
+	if _, ok := d.GetOk("sqs"); ok {
+		parsedAlertProfile.Sqs = alertprofile.Sqs{
+			Enabled:      true,
+			CredentialId: d.Get("sqs.0.credential_id").(string),
+			QueueUrl:     d.Get("sqs.0.queue_url").(string),
+			Region:       d.Get("sqs.0.region").(string),
+		}
+	}
+	if _, ok := d.GetOk("webhook"); ok {
+		parsedAlertProfile.Webhook = alertprofile.Webhook{
+			Enabled:      true,
+			CaCert:       d.Get("webhook.0.custom_ca").(string),
+			CredentialId: d.Get("webhook.0.credential_id").(string),
+			Json:         d.Get("webhook.0.custom_json").(string),
+			Url:          d.Get("webhook.0.url").(string),
+		}
+	}
+	if _, ok := d.GetOk("xsoar"); ok {
+		parsedAlertProfile.Xsoar = alertprofile.Xsoar{
+			Enabled: true,
+			ApiKey:  auth.Secret{Plain: d.Get("xsoar.0.api_key").(string)},
+			CaCert:  d.Get("xsoar.0.ca_cert").(string),
+			Url:     d.Get("xsoar.0.url").(string),
+		}
+	}
+}
+
+// Converts the alert channels of an alert profile to schema blocks, keyed by
+// block name. Disabled channels have no block. Secrets are left empty, as the
+// Console only returns their encrypted form.
+func AlertProfileChannelsToSchema(in alertprofile.AlertProfile) map[string][]interface{} {
+	ans := make(map[string][]interface{})
+	ans["email"] = alertProfileChannelToSchema(in.Email.Enabled, map[string]interface{}{
+		"credential_id": in.Email.CredentialId,
+		"from":          in.Email.From,
+		"port":          in.Email.Port,
+		"recipients":    in.Email.Recipients,
+		"smtp_address":  in.Email.SmtpAddress,
+		"ssl":           in.Email.Ssl,
+	})
+	ans["gcp_pubsub"] = alertProfileChannelToSchema(in.GcpPubsub.Enabled, map[string]interface{}{
+		"credential_id": in.GcpPubsub.CredentialId,
+		"topic":         in.GcpPubsub.Topic,
+	})
+	ans["jira"] = alertProfileChannelToSchema(in.Jira.Enabled, map[string]interface{}{
+		"assignee":      in.Jira.Assignee,
+		"base_url":      in.Jira.BaseUrl,
+		"ca_cert":       in.Jira.CaCert,
+		"credential_id": in.Jira.CredentialId,
+		"issue_type":    in.Jira.IssueType,
+		"labels":        in.Jira.Labels,
+		"priority":      in.Jira.Priority,
+		"project_key":   in.Jira.ProjectKey,
+	})
+	ans["pagerduty"] = alertProfileChannelToSchema(in.PagerDuty.Enabled, map[string]interface{}{
+		"routing_key": in.PagerDuty.RoutingKey.Plain,
+		"severity":    in.PagerDuty.Severity,
+		"summary":     in.PagerDuty.Summary,
+	})
+	ans["security_center"] = alertProfileChannelToSchema(in.SecurityCenter.Enabled, map[string]interface{}{
+		"credential_id": in.SecurityCenter.CredentialId,
+		"source_id":     in.SecurityCenter.SourceID,
+	})
+	ans["security_hub"] = alertProfileChannelToSchema(in.SecurityHub.Enabled, map[string]interface{}{
+		"account_id":    in.SecurityHub.AccountID,
+		"credential_id": in.SecurityHub.CredentialId,
+		"region":        in.SecurityHub.Region,
+	})
+	ans["service_now"] = alertProfileChannelToSchema(in.ServiceNow.Enabled, map[string]interface{}{
+		"address":       in.ServiceNow.Address,
+		"assignee":      in.ServiceNow.Assignee,
+		"ca_cert":       in.ServiceNow.CaCert,
+		"credential_id": in.ServiceNow.CredentialId,
+	})
+	ans["slack"] = alertProfileChannelToSchema(in.Slack.Enabled, map[string]interface{}{
+		"channels":    in.Slack.Channels,
+		"users":       in.Slack.Users,
+		"webhook_url": in.Slack.WebhookUrl,
+	})
+	ans["splunk"] = alertProfileChannelToSchema(in.Splunk.Enabled, map[string]interface{}{
+		"auth_token":  in.Splunk.AuthToken.Plain,
+		"custom_json": in.Splunk.Json,
+		"source_type": in.Splunk.SourceType,
+		"url":         in.Splunk.Url,
+	})
+	ans["sqs"] = alertProfileChannelToSchema(in.Sqs.Enabled, map[string]interface{}{
+		"credential_id": in.Sqs.CredentialId,
+		"queue_url":     in.Sqs.QueueUrl,
+		"region":        in.Sqs.Region,
+	})
+	ans["webhook"] = alertProfileChannelToSchema(in.Webhook.Enabled, map[string]interface{}{
+		"credential_id": in.Webhook.CredentialId,
+		"custom_ca":     in.Webhook.CaCert,
+		"custom_json":   in.Webhook.Json,
+		"url":           in.Webhook.Url,
+	})
+	ans["xsoar"] = alertProfileChannelToSchema(in.Xsoar.Enabled, map[string]interface{}{
+		"api_key": in.Xsoar.ApiKey.Plain,
+		"ca_cert": in.Xsoar.CaCert,
+		"url":     in.Xsoar.Url,
+	})
+	return ans
+}
+
+func alertProfileChannelToSchema(enabled bool, m map[string]interface{}) []interface{} {
+	if !enabled {
+		return nil
+	}
+	return []interface{}{m}
+}
@@ -0,0 +1,78 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Rule IDs are assigned in the order of the rules, first the container rules
+// and then the host rules, so that they are unique within the policy.
+func SchemaToCnnsPolicy(d *schema.ResourceData) policy.CnnsPolicy {
+	parsedPolicy := policy.CnnsPolicy{
+		ContainerEnabled:            d.Get("container_enabled").(bool),
+		ContainerEnforcementEnabled: d.Get("container_enforcement_enabled").(bool),
+		HostEnabled:                 d.Get("host_enabled").(bool),
+		HostEnforcementEnabled:      d.Get("host_enforcement_enabled").(bool),
+	}
+	parsedPolicy.ContainerRules = schemaToCnnsRules(d.Get("container_rule").([]interface{}), 1)
+	parsedPolicy.HostRules = schemaToCnnsRules(d.Get("host_rule").([]interface{}), len(parsedPolicy.ContainerRules)+1)
+	return parsedPolicy
+}
+
+func schemaToCnnsRules(in []interface{}, firstId int) []policy.CnnsRule {
+	parsedRules := make([]policy.CnnsRule, 0, len(in))
+	for i, val := range in {
+		presentRule := val.(map[string]interface{})
+		parsedPorts := make([]policy.CnnsPort, 0)
+		for _, port := range presentRule["port"].([]interface{}) {
+			presentPort := port.(map[string]interface{})
+			parsedPorts = append(parsedPorts, policy.CnnsPort{
+				End:   presentPort["end"].(int),
+				Start: presentPort["start"].(int),
+			})
+		}
+		parsedRules = append(parsedRules, policy.CnnsRule{
+			Destination: presentRule["destination"].(string),
+			Disabled:    presentRule["disabled"].(bool),
+			Effect:      presentRule["effect"].(string),
+			Id:          firstId + i,
+			Name:        presentRule["name"].(string),
+			Ports:       parsedPorts,
+			Source:      presentRule["source"].(string),
+		})
+	}
+	return parsedRules
+}
+
+func CnnsRulesToSchema(in []policy.CnnsRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ports := make([]interface{}, 0, len(val.Ports))
+		for _, port := range val.Ports {
+			m := make(map[string]interface{})
+			m["end"] = port.End
+			m["start"] = port.Start
+			ports = append(ports, m)
+		}
+		m := make(map[string]interface{})
+		m["destination"] = val.Destination
+		m["disabled"] = val.Disabled
+		m["effect"] = val.Effect
+		m["name"] = val.Name
+		m["port"] = ports
+		m["source"] = val.Source
+		ans = append(ans, m)
+	}
+	return ans
+}
+
+func SchemaToCnnsEntity(d *schema.ResourceData) policy.CnnsEntity {
+	return policy.CnnsEntity{
+		Collections: PolicySchemaToCollections(d.Get("collections").([]interface{})),
+		Description: d.Get("description").(string),
+		Domain:      d.Get("domain").(string),
+		Name:        d.Get("name").(string),
+		Subnet:      d.Get("subnet").(string),
+		Type:        d.Get("type").(string),
+	}
+}
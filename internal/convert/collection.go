@@ -79,3 +79,25 @@ func SchemaToCollection(d *schema.ResourceData) collection.Collection {
 	}
 	return ans
 }
+
+// Converts a collection object to the attributes of the collection data
+// sources.
+func CollectionToSchema(in collection.Collection) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["account_ids"] = in.AccountIds
+	m["application_ids"] = in.AppIds
+	m["clusters"] = in.Clusters
+	m["code_repositories"] = in.CodeRepos
+	m["color"] = in.Color
+	m["containers"] = in.Containers
+	m["description"] = in.Description
+	m["functions"] = in.Functions
+	m["hosts"] = in.Hosts
+	m["images"] = in.Images
+	m["labels"] = in.Labels
+	m["name"] = in.Name
+	m["namespaces"] = in.Namespaces
+	m["prisma"] = in.Prisma
+	m["system"] = in.System
+	return m
+}
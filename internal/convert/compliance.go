@@ -11,116 +11,130 @@ func SchemaToComplianceCiRules(d *schema.ResourceData) ([]policy.ComplianceRule,
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.ComplianceRule{}
-
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
-
-			presentChecks := presentRule["compliance_check"].([]interface{})
-			parsedConditions := policy.ComplianceConditions{
-				Checks: make([]policy.ComplianceCheck, 0, len(presentChecks)),
-			}
-			for _, val := range presentChecks {
-				presentCheck := val.(map[string]interface{})
-				parsedConditions.Checks = append(parsedConditions.Checks, policy.ComplianceCheck{
-					Block: presentCheck["block"].(bool),
-					Id:    presentCheck["id"].(int),
-				})
-			}
-			parsedRule.Conditions = parsedConditions
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Notes = presentRule["notes"].(string)
-			parsedRule.Verbose = presentRule["verbose"].(bool)
-
-			parsedRules = append(parsedRules, parsedRule)
+			parsedRules = append(parsedRules, SchemaToComplianceCiRule(val.(map[string]interface{})))
 		}
 	}
 	return parsedRules, nil
 }
 
+func SchemaToComplianceCiRule(presentRule map[string]interface{}) policy.ComplianceRule {
+	parsedRule := policy.ComplianceRule{}
+
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+
+	presentChecks := presentRule["compliance_check"].([]interface{})
+	parsedConditions := policy.ComplianceConditions{
+		Checks: make([]policy.ComplianceCheck, 0, len(presentChecks)),
+	}
+	for _, val := range presentChecks {
+		presentCheck := val.(map[string]interface{})
+		parsedConditions.Checks = append(parsedConditions.Checks, policy.ComplianceCheck{
+			Block: presentCheck["block"].(bool),
+			Id:    presentCheck["id"].(int),
+		})
+	}
+	parsedRule.Conditions = parsedConditions
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.Verbose = presentRule["verbose"].(bool)
+
+	return parsedRule
+}
+
 func SchemaToComplianceDeployedRules(d *schema.ResourceData) ([]policy.ComplianceRule, error) {
 	parsedRules := make([]policy.ComplianceRule, 0)
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.ComplianceRule{}
-
-			parsedRule.BlockMessage = presentRule["block_message"].(string)
-
-			presentCollections := presentRule["collections"].([]interface{})
-			parsedCollections := make([]collection.Collection, 0, len(presentCollections))
-			for _, val := range presentCollections {
-				parsedCollection := collection.Collection{
-					Name: val.(string),
-				}
-				parsedCollections = append(parsedCollections, parsedCollection)
-			}
-			parsedRule.Collections = parsedCollections
-
-			presentChecks := presentRule["compliance_check"].([]interface{})
-			parsedConditions := policy.ComplianceConditions{
-				Checks: make([]policy.ComplianceCheck, 0, len(presentChecks)),
-			}
-			for _, val := range presentChecks {
-				presentCheck := val.(map[string]interface{})
-				parsedConditions.Checks = append(parsedConditions.Checks, policy.ComplianceCheck{
-					Block: presentCheck["block"].(bool),
-					Id:    presentCheck["id"].(int),
-				})
-			}
-			parsedRule.Conditions = parsedConditions
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Notes = presentRule["notes"].(string)
-			parsedRule.ShowPassedChecks = presentRule["show_passed_checks"].(bool)
-			parsedRule.Verbose = presentRule["verbose"].(bool)
-
-			parsedRules = append(parsedRules, parsedRule)
+			parsedRules = append(parsedRules, SchemaToComplianceDeployedRule(val.(map[string]interface{})))
 		}
 	}
 	return parsedRules, nil
 }
 
+func SchemaToComplianceDeployedRule(presentRule map[string]interface{}) policy.ComplianceRule {
+	parsedRule := policy.ComplianceRule{}
+
+	parsedRule.BlockMessage = presentRule["block_message"].(string)
+
+	presentCollections := presentRule["collections"].([]interface{})
+	parsedCollections := make([]collection.Collection, 0, len(presentCollections))
+	for _, val := range presentCollections {
+		parsedCollection := collection.Collection{
+			Name: val.(string),
+		}
+		parsedCollections = append(parsedCollections, parsedCollection)
+	}
+	parsedRule.Collections = parsedCollections
+
+	presentChecks := presentRule["compliance_check"].([]interface{})
+	parsedConditions := policy.ComplianceConditions{
+		Checks: make([]policy.ComplianceCheck, 0, len(presentChecks)),
+	}
+	for _, val := range presentChecks {
+		presentCheck := val.(map[string]interface{})
+		parsedConditions.Checks = append(parsedConditions.Checks, policy.ComplianceCheck{
+			Block: presentCheck["block"].(bool),
+			Id:    presentCheck["id"].(int),
+		})
+	}
+	parsedRule.Conditions = parsedConditions
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.ShowPassedChecks = presentRule["show_passed_checks"].(bool)
+	parsedRule.Verbose = presentRule["verbose"].(bool)
+
+	return parsedRule
+}
+
 func ComplianceCiRulesToSchema(in []policy.ComplianceRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["compliance_check"] = complianceConditionsToSchema(val.Conditions)
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["name"] = val.Name
-		m["notes"] = val.Notes
-		m["verbose"] = val.Verbose
-		ans = append(ans, m)
+		ans = append(ans, ComplianceCiRuleToSchema(val))
 	}
 	return ans
 }
 
+func ComplianceCiRuleToSchema(val policy.ComplianceRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["compliance_check"] = complianceConditionsToSchema(val.Conditions)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["verbose"] = val.Verbose
+	return m
+}
+
 func ComplianceDeployedRulesToSchema(in []policy.ComplianceRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["block_message"] = val.BlockMessage
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["compliance_check"] = complianceConditionsToSchema(val.Conditions)
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["name"] = val.Name
-		m["notes"] = val.Notes
-		m["show_passed_checks"] = val.ShowPassedChecks
-		m["verbose"] = val.Verbose
-		ans = append(ans, m)
+		ans = append(ans, ComplianceDeployedRuleToSchema(val))
 	}
 	return ans
 }
 
+func ComplianceDeployedRuleToSchema(val policy.ComplianceRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["block_message"] = val.BlockMessage
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["compliance_check"] = complianceConditionsToSchema(val.Conditions)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["show_passed_checks"] = val.ShowPassedChecks
+	m["verbose"] = val.Verbose
+	return m
+}
+
 func complianceConditionsToSchema(in policy.ComplianceConditions) []interface{} {
 	ans := make([]interface{}, 0, len(in.Checks))
 	for _, val := range in.Checks {
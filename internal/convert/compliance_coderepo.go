@@ -10,62 +10,69 @@ func SchemaToComplianceCoderepoRules(d *schema.ResourceData) ([]policy.Complianc
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.ComplianceCoderepoRule{}
+			parsedRules = append(parsedRules, SchemaToComplianceCoderepoRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToComplianceCoderepoRule(presentRule map[string]interface{}) policy.ComplianceCoderepoRule {
+	parsedRule := policy.ComplianceCoderepoRule{}
 
-			if len(presentRule["license"].([]interface{})) > 0 && presentRule["license"].([]interface{})[0] != nil {
-				presentLicense := presentRule["license"].([]interface{})[0].(map[string]interface{})
-				if len(presentLicense["critical"].([]interface{})) > 0 && presentLicense["critical"].([]interface{})[0] != nil {
-					parsedRule.License.Critical = SchemaToStringSlice(presentLicense["critical"].([]interface{}))
-				}
-				if len(presentLicense["high"].([]interface{})) > 0 && presentLicense["high"].([]interface{})[0] != nil {
-					parsedRule.License.High = SchemaToStringSlice(presentLicense["high"].([]interface{}))
-				}
-				if len(presentLicense["medium"].([]interface{})) > 0 && presentLicense["medium"].([]interface{})[0] != nil {
-					parsedRule.License.Medium = SchemaToStringSlice(presentLicense["medium"].([]interface{}))
-				}
-				if len(presentLicense["low"].([]interface{})) > 0 && presentLicense["low"].([]interface{})[0] != nil {
-					parsedRule.License.Low = SchemaToStringSlice(presentLicense["low"].([]interface{}))
-				}
-				if presentLicense["alert_threshold"].([]interface{})[0] != nil {
-					presentAlertThreshold := presentLicense["alert_threshold"].([]interface{})[0].(map[string]interface{})
-					parsedRule.License.AlertThreshold = policy.ComplianceCoderepoThreshold{
-						Enabled: presentAlertThreshold["enabled"].(bool),
-						Value:   presentAlertThreshold["value"].(int),
-					}
-				}
-			} else {
-				parsedRule.License = policy.ComplianceCoderepoLicense{}
+	if len(presentRule["license"].([]interface{})) > 0 && presentRule["license"].([]interface{})[0] != nil {
+		presentLicense := presentRule["license"].([]interface{})[0].(map[string]interface{})
+		if len(presentLicense["critical"].([]interface{})) > 0 && presentLicense["critical"].([]interface{})[0] != nil {
+			parsedRule.License.Critical = SchemaToStringSlice(presentLicense["critical"].([]interface{}))
+		}
+		if len(presentLicense["high"].([]interface{})) > 0 && presentLicense["high"].([]interface{})[0] != nil {
+			parsedRule.License.High = SchemaToStringSlice(presentLicense["high"].([]interface{}))
+		}
+		if len(presentLicense["medium"].([]interface{})) > 0 && presentLicense["medium"].([]interface{})[0] != nil {
+			parsedRule.License.Medium = SchemaToStringSlice(presentLicense["medium"].([]interface{}))
+		}
+		if len(presentLicense["low"].([]interface{})) > 0 && presentLicense["low"].([]interface{})[0] != nil {
+			parsedRule.License.Low = SchemaToStringSlice(presentLicense["low"].([]interface{}))
+		}
+		if presentLicense["alert_threshold"].([]interface{})[0] != nil {
+			presentAlertThreshold := presentLicense["alert_threshold"].([]interface{})[0].(map[string]interface{})
+			parsedRule.License.AlertThreshold = policy.ComplianceCoderepoThreshold{
+				Enabled: presentAlertThreshold["enabled"].(bool),
+				Value:   presentAlertThreshold["value"].(int),
 			}
+		}
+	} else {
+		parsedRule.License = policy.ComplianceCoderepoLicense{}
+	}
 
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
 
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
 
-			parsedRules = append(parsedRules, parsedRule)
-		}
-	}
-	return parsedRules, nil
+	return parsedRule
 }
 
 func ComplianceCoderepoRulesToSchema(in []policy.ComplianceCoderepoRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["license"] = complianceCoderepoLicenseToSchema(val.License)
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["name"] = val.Name
-		m["notes"] = val.Notes
-		ans = append(ans, m)
+		ans = append(ans, ComplianceCoderepoRuleToSchema(val))
 	}
 	return ans
 }
 
+func ComplianceCoderepoRuleToSchema(val policy.ComplianceCoderepoRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["license"] = complianceCoderepoLicenseToSchema(val.License)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	return m
+}
+
 func complianceCoderepoThresholdToSchema(in policy.ComplianceCoderepoThreshold) []interface{} {
 	ans := make([]interface{}, 0, 1)
 	m := make(map[string]interface{})
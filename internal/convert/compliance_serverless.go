@@ -0,0 +1,66 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToComplianceServerlessRules(d *schema.ResourceData) ([]policy.ComplianceRule, error) {
+	parsedRules := make([]policy.ComplianceRule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			parsedRules = append(parsedRules, SchemaToComplianceServerlessRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToComplianceServerlessRule(presentRule map[string]interface{}) policy.ComplianceRule {
+	parsedRule := policy.ComplianceRule{}
+
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+
+	presentChecks := presentRule["compliance_check"].([]interface{})
+	parsedConditions := policy.ComplianceConditions{
+		Checks: make([]policy.ComplianceCheck, 0, len(presentChecks)),
+	}
+	for _, val := range presentChecks {
+		presentCheck := val.(map[string]interface{})
+		parsedConditions.Checks = append(parsedConditions.Checks, policy.ComplianceCheck{
+			Block: presentCheck["block"].(bool),
+			Id:    presentCheck["id"].(int),
+		})
+	}
+	parsedRule.Conditions = parsedConditions
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.ShowPassedChecks = presentRule["show_passed_checks"].(bool)
+	parsedRule.Verbose = presentRule["verbose"].(bool)
+
+	return parsedRule
+}
+
+func ComplianceServerlessRulesToSchema(in []policy.ComplianceRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ans = append(ans, ComplianceServerlessRuleToSchema(val))
+	}
+	return ans
+}
+
+func ComplianceServerlessRuleToSchema(val policy.ComplianceRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["compliance_check"] = complianceConditionsToSchema(val.Conditions)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["show_passed_checks"] = val.ShowPassedChecks
+	m["verbose"] = val.Verbose
+	return m
+}
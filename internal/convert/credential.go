@@ -72,3 +72,22 @@ func CredentialSecretToSchema(in auth.Secret) []interface{} {
 	ans = append(ans, m)
 	return ans
 }
+
+// The API token and secret are left out, as only their encrypted form is
+// returned by the Console.
+func CredentialToSchema(in auth.Credential) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["account_id"] = in.AccountID
+	m["ca_cert"] = in.CaCert
+	m["description"] = in.Description
+	m["external"] = in.External
+	m["ibm_account_guid"] = in.AccountGUID
+	m["name"] = in.Id
+	m["role_arn"] = in.RoleArn
+	m["skip_cert_verification"] = in.SkipVerify
+	m["type"] = in.Type
+	m["url"] = in.Url
+	m["use_aws_role"] = in.UseAWSRole
+	m["use_sts_regional_endpoint"] = in.UseSTSRegionalEndpoint
+	return m
+}
@@ -0,0 +1,33 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/defender"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToDaemonSetOptions(d *schema.ResourceData) defender.DaemonSetOptions {
+	parsedOptions := defender.DaemonSetOptions{
+		Cluster:                d.Get("cluster").(string),
+		CollectPodLabels:       d.Get("collect_pod_labels").(bool),
+		ConsoleAddr:            d.Get("console_address").(string),
+		Cri:                    d.Get("cri").(bool),
+		Istio:                  d.Get("istio").(bool),
+		MonitorServiceAccounts: d.Get("monitor_service_accounts").(bool),
+		Namespace:              d.Get("namespace").(string),
+		NodeSelector:           d.Get("node_selector").(string),
+		Orchestration:          d.Get("orchestration").(string),
+		Privileged:             d.Get("privileged").(bool),
+		Selinux:                d.Get("selinux").(bool),
+	}
+	if _, ok := d.GetOk("proxy"); ok {
+		parsedOptions.Proxy = &defender.ProxySettings{
+			Ca:        d.Get("proxy.0.ca").(string),
+			HttpProxy: d.Get("proxy.0.http_proxy").(string),
+			NoProxy:   d.Get("proxy.0.no_proxy").(string),
+			Password:  auth.Secret{Plain: d.Get("proxy.0.password").(string)},
+			User:      d.Get("proxy.0.user").(string),
+		}
+	}
+	return parsedOptions
+}
@@ -1,8 +1,6 @@
 package convert
 
 import (
-	"strings"
-
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -10,19 +8,19 @@ import (
 func SchemaToGroup(d *schema.ResourceData) (auth.Group, error) {
 	parsedGroup := auth.Group{}
 
-	if val, ok := d.GetOk("groupid"); ok {
+	if val, ok := d.GetOk("group_id"); ok {
 		parsedGroup.Id = val.(string)
 	}
-	if val, ok := d.GetOk("ldapgroup"); ok {
+	if val, ok := d.GetOk("ldap_group"); ok {
 		parsedGroup.LdapGroup = val.(bool)
 	}
 	if val, ok := d.GetOk("name"); ok {
 		parsedGroup.Name = val.(string)
 	}
-	if val, ok := d.GetOk("oauthgroup"); ok {
+	if val, ok := d.GetOk("oauth_group"); ok {
 		parsedGroup.OauthGroup = val.(bool)
 	}
-	if val, ok := d.GetOk("oidcgroup"); ok {
+	if val, ok := d.GetOk("oidc_group"); ok {
 		parsedGroup.OidcGroup = val.(bool)
 	}
 	if val, ok := d.GetOk("permissions"); ok {
@@ -31,7 +29,7 @@ func SchemaToGroup(d *schema.ResourceData) (auth.Group, error) {
 	if val, ok := d.GetOk("role"); ok {
 		parsedGroup.Role = val.(string)
 	}
-	if val, ok := d.GetOk("samlgroup"); ok {
+	if val, ok := d.GetOk("saml_group"); ok {
 		parsedGroup.SamlGroup = val.(bool)
 	}
 	if val, ok := d.GetOk("users"); ok {
@@ -67,7 +65,7 @@ func GroupPermissionsToSchema(in []auth.GroupPermission) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
 		m := make(map[string]interface{})
-		m["collections"] = strings.Join(val.Collections, ",")
+		m["collections"] = val.Collections
 		m["project"] = val.Project
 		ans = append(ans, m)
 	}
@@ -81,3 +79,17 @@ func GroupUsersToSchema(in []auth.GroupUser) []interface{} {
 	}
 	return ans
 }
+
+func GroupToSchema(in auth.Group) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["group_id"] = in.Id
+	m["ldap_group"] = in.LdapGroup
+	m["name"] = in.Name
+	m["oauth_group"] = in.OauthGroup
+	m["oidc_group"] = in.OidcGroup
+	m["permissions"] = GroupPermissionsToSchema(in.Permissions)
+	m["role"] = in.Role
+	m["saml_group"] = in.SamlGroup
+	m["users"] = GroupUsersToSchema(in.Users)
+	return m
+}
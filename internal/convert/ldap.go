@@ -0,0 +1,36 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToLdapSettings(d *schema.ResourceData) settings.LdapSettings {
+	return settings.LdapSettings{
+		AccountUpn:           d.Get("account_upn").(bool),
+		CaCert:               d.Get("ca_cert").(string),
+		Enabled:              d.Get("enabled").(bool),
+		GroupSearchBase:      d.Get("group_search_base").(string),
+		Password:             auth.Secret{Plain: d.Get("password").(string)},
+		SearchBase:           d.Get("search_base").(string),
+		Url:                  d.Get("url").(string),
+		User:                 d.Get("user").(string),
+		UserSearchBase:       d.Get("user_search_base").(string),
+		UserSearchIdentifier: d.Get("user_search_identifier").(string),
+	}
+}
+
+// The password and the CA certificate are write-only, so they are left out.
+func LdapSettingsToSchema(in settings.LdapSettings) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["account_upn"] = in.AccountUpn
+	m["enabled"] = in.Enabled
+	m["group_search_base"] = in.GroupSearchBase
+	m["search_base"] = in.SearchBase
+	m["url"] = in.Url
+	m["user"] = in.User
+	m["user_search_base"] = in.UserSearchBase
+	m["user_search_identifier"] = in.UserSearchIdentifier
+	return m
+}
@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToLoggingSettings(d *schema.ResourceData) settings.LoggingSettings {
+	parsedLoggingSettings := settings.LoggingSettings{
+		ConsoleAddress:          d.Get("console_address").(string),
+		EnableMetricsCollection: d.Get("enable_metrics_collection").(bool),
+		IncludeRuntimeLink:      d.Get("include_runtime_link").(bool),
+	}
+	if _, ok := d.GetOk("stdout"); ok {
+		parsedLoggingSettings.Stdout = settings.LoggerSetting{
+			Enabled:       true,
+			AllProcEvents: d.Get("stdout.0.all_proc_events").(bool),
+			VerboseScan:   d.Get("stdout.0.verbose_scan").(bool),
+		}
+	}
+	if _, ok := d.GetOk("syslog"); ok {
+		parsedLoggingSettings.Syslog = settings.SyslogSetting{
+			Enabled:       true,
+			Address:       d.Get("syslog.0.address").(string),
+			AllProcEvents: d.Get("syslog.0.all_proc_events").(bool),
+			Cert:          d.Get("syslog.0.cert").(string),
+			Identifier:    d.Get("syslog.0.identifier").(string),
+			VerboseScan:   d.Get("syslog.0.verbose_scan").(bool),
+		}
+	}
+	return parsedLoggingSettings
+}
+
+// Disabled loggers are represented by the absence of their block.
+func LoggingStdoutToSchema(in settings.LoggerSetting) []interface{} {
+	if !in.Enabled {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"all_proc_events": in.AllProcEvents,
+		"verbose_scan":    in.VerboseScan,
+	}}
+}
+
+func LoggingSyslogToSchema(in settings.SyslogSetting) []interface{} {
+	if !in.Enabled {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"address":         in.Address,
+		"all_proc_events": in.AllProcEvents,
+		"cert":            in.Cert,
+		"identifier":      in.Identifier,
+		"verbose_scan":    in.VerboseScan,
+	}}
+}
@@ -0,0 +1,61 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToOauthSettings(d *schema.ResourceData) settings.ProviderSettings {
+	return settings.ProviderSettings{
+		AuthUrl:       d.Get("auth_url").(string),
+		Cert:          d.Get("cert").(string),
+		ClientId:      d.Get("client_id").(string),
+		ClientSecret:  auth.Secret{Plain: d.Get("client_secret").(string)},
+		Enabled:       d.Get("enabled").(bool),
+		GroupScope:    d.Get("group_scope").(string),
+		ProviderAlias: d.Get("provider_alias").(string),
+		ProviderName:  d.Get("provider_name").(string),
+		TokenUrl:      d.Get("token_url").(string),
+	}
+}
+
+// The client secret and the certificate are write-only, so they are left out.
+func OauthSettingsToSchema(in settings.ProviderSettings) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["auth_url"] = in.AuthUrl
+	m["client_id"] = in.ClientId
+	m["enabled"] = in.Enabled
+	m["group_scope"] = in.GroupScope
+	m["provider_alias"] = in.ProviderAlias
+	m["provider_name"] = in.ProviderName
+	m["token_url"] = in.TokenUrl
+	return m
+}
+
+func SchemaToOidcSettings(d *schema.ResourceData) settings.ProviderSettings {
+	return settings.ProviderSettings{
+		Cert:            d.Get("cert").(string),
+		ClientId:        d.Get("client_id").(string),
+		ClientSecret:    auth.Secret{Plain: d.Get("client_secret").(string)},
+		Enabled:         d.Get("enabled").(bool),
+		GroupClaim:      d.Get("group_claim").(string),
+		GroupScope:      d.Get("group_scope").(string),
+		OpenIdIssuerUrl: d.Get("issuer_url").(string),
+		ProviderAlias:   d.Get("provider_alias").(string),
+		UserClaim:       d.Get("user_claim").(string),
+	}
+}
+
+// The client secret and the certificate are write-only, so they are left out.
+func OidcSettingsToSchema(in settings.ProviderSettings) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["client_id"] = in.ClientId
+	m["enabled"] = in.Enabled
+	m["group_claim"] = in.GroupClaim
+	m["group_scope"] = in.GroupScope
+	m["issuer_url"] = in.OpenIdIssuerUrl
+	m["provider_alias"] = in.ProviderAlias
+	m["user_claim"] = in.UserClaim
+	return m
+}
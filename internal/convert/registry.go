@@ -37,27 +37,31 @@ func SchemaToRegistrySpecification(d *schema.ResourceData) []settings.RegistrySp
 func RegistrySpecificationToSchema(s []settings.RegistrySpecification) []interface{} {
 	ans := make([]interface{}, 0, len(s))
 	for _, v := range s {
-		m := make(map[string]interface{})
-		m["cap"] = v.Cap
-		m["collections"] = v.Collections
-		m["credential"] = v.Credential
-		m["excluded_repositories"] = v.ExcludedRepositories
-		m["excluded_tags"] = v.ExcludedTags
-		m["harbor_deployment_security"] = v.HarborDeploymentSecurity
-		m["jfrog_repo_types"] = v.JfrogRepoTypes
-		m["namespace"] = v.Namespace
-		m["os"] = v.Os
-		m["tag"] = v.Tag
-		m["registry"] = v.Registry
-		m["repository"] = v.Repository
-		m["scanners"] = v.Scanners
-		m["type"] = v.Version
-		m["version_pattern"] = v.VersionPattern
-		ans = append(ans, m)
+		ans = append(ans, RegistryToSchema(v))
 	}
 	return ans
 }
 
+func RegistryToSchema(v settings.RegistrySpecification) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["cap"] = v.Cap
+	m["collections"] = v.Collections
+	m["credential"] = v.Credential
+	m["excluded_repositories"] = v.ExcludedRepositories
+	m["excluded_tags"] = v.ExcludedTags
+	m["harbor_deployment_security"] = v.HarborDeploymentSecurity
+	m["jfrog_repo_types"] = v.JfrogRepoTypes
+	m["namespace"] = v.Namespace
+	m["os"] = v.Os
+	m["tag"] = v.Tag
+	m["registry"] = v.Registry
+	m["repository"] = v.Repository
+	m["scanners"] = v.Scanners
+	m["type"] = v.Version
+	m["version_pattern"] = v.VersionPattern
+	return m
+}
+
 func SchemaToRegistry(d *schema.ResourceData) settings.RegistrySpecification {
 	parsedRegistrySpecification := settings.RegistrySpecification{}
 
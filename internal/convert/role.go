@@ -40,3 +40,11 @@ func RbacRolePermissionsToSchema(in []auth.RolePermission) []interface{} {
 	}
 	return ans
 }
+
+func RbacRoleToSchema(in auth.Role) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["description"] = in.Description
+	m["name"] = in.Name
+	m["permission"] = RbacRolePermissionsToSchema(in.Permissions)
+	return m
+}
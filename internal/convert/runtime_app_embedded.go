@@ -0,0 +1,61 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToRuntimeAppEmbeddedRules(d *schema.ResourceData) ([]policy.RuntimeAppEmbeddedRule, error) {
+	parsedRules := make([]policy.RuntimeAppEmbeddedRule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			parsedRules = append(parsedRules, SchemaToRuntimeAppEmbeddedRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToRuntimeAppEmbeddedRule(presentRule map[string]interface{}) policy.RuntimeAppEmbeddedRule {
+	parsedRule := policy.RuntimeAppEmbeddedRule{}
+
+	parsedRule.AdvancedProtectionEffect = presentRule["advanced_protection_effect"].(string)
+	parsedRule.CloudMetadataEnforcementEffect = presentRule["cloud_metadata_enforcement_effect"].(string)
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+	parsedRule.CustomRules = schemaToRuntimeContainerCustomRules(presentRule["custom_rule"].([]interface{}))
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Dns = schemaToRuntimeContainerDns(presentRule["dns"].([]interface{}))
+	parsedRule.Filesystem = schemaToRuntimeContainerFilesystem(presentRule["filesystem"].([]interface{}))
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Network = schemaToRuntimeContainerNetwork(presentRule["network"].([]interface{}))
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.PreviousName = presentRule["previous_name"].(string)
+	parsedRule.Processes = schemaToRuntimeContainerProcesses(presentRule["processes"].([]interface{}))
+
+	return parsedRule
+}
+
+func RuntimeAppEmbeddedRulesToSchema(in []policy.RuntimeAppEmbeddedRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ans = append(ans, RuntimeAppEmbeddedRuleToSchema(val))
+	}
+	return ans
+}
+
+func RuntimeAppEmbeddedRuleToSchema(val policy.RuntimeAppEmbeddedRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["advanced_protection_effect"] = val.AdvancedProtectionEffect
+	m["cloud_metadata_enforcement_effect"] = val.CloudMetadataEnforcementEffect
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["custom_rule"] = runtimeContainerCustomRulesToSchema(val.CustomRules)
+	m["disabled"] = val.Disabled
+	m["dns"] = runtimeContainerDnsToSchema(val.Dns)
+	m["filesystem"] = runtimeContainerFileystemToSchema(val.Filesystem)
+	m["name"] = val.Name
+	m["network"] = runtimeContainerNetworkToSchema(val.Network)
+	m["notes"] = val.Notes
+	m["previous_name"] = val.PreviousName
+	m["processes"] = runtimeContainerProcessesToSchema(val.Processes)
+	return m
+}
@@ -10,104 +10,112 @@ func SchemaToRuntimeContainerRules(d *schema.ResourceData) ([]policy.RuntimeCont
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.RuntimeContainerRule{}
-
-			parsedRule.AdvancedProtectionEffect = presentRule["advanced_protection_effect"].(string)
-			parsedRule.CloudMetadataEnforcementEffect = presentRule["cloud_metadata_enforcement_effect"].(string)
-			parsedRule.PreviousName = presentRule["previous_name"].(string)
-			parsedRule.SkipExecSessions = presentRule["skip_exec_sessions"].(bool)
-
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
-
-			presentCustomRules := presentRule["custom_rule"].([]interface{})
-			parsedCustomRules := make([]policy.RuntimeContainerCustomRule, 0, len(presentCustomRules))
-			for _, val := range presentCustomRules {
-				presentCustomRule := val.(map[string]interface{})
-				parsedCustomRules = append(parsedCustomRules, policy.RuntimeContainerCustomRule{
-					Action: presentCustomRule["action"].(string),
-					Effect: presentCustomRule["effect"].(string),
-					Id:     presentCustomRule["id"].(int),
-				})
-			}
-			parsedRule.CustomRules = parsedCustomRules
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-
-			if presentRule["dns"].([]interface{})[0] != nil {
-				presentDns := presentRule["dns"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Dns = policy.RuntimeContainerDns{
-					DefaultEffect: presentDns["default_effect"].(string),
-					Disabled:      presentDns["disabled"].(bool),
-					DomainList:    schemaToRuntimeContainerDnsDomainList(presentDns["domain_list"].([]interface{})),
-				}
-			} else {
-				parsedRule.Dns = policy.RuntimeContainerDns{}
-			}
-
-			if presentRule["filesystem"].([]interface{})[0] != nil {
-				presentFilesystem := presentRule["filesystem"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Filesystem = policy.RuntimeContainerFilesystem{
-					AllowedList:                SchemaToStringSlice(presentFilesystem["allowed_list"].([]interface{})),
-					BackdoorFilesEffect:        presentFilesystem["backdoor_files_effect"].(string),
-					DefaultEffect:              presentFilesystem["default_effect"].(string),
-					DeniedList:                 schemaToRuntimeContainerDeniedList(presentFilesystem["denied_list"].([]interface{})),
-					Disabled:                   presentFilesystem["disabled"].(bool),
-					EncryptedBinariesEffect:    presentFilesystem["encrypted_binaries_effect"].(string),
-					NewFilesEffect:             presentFilesystem["new_files_effect"].(string),
-					SuspiciousElfHeadersEffect: presentFilesystem["suspicious_elf_headers_effect"].(string),
-				}
-			} else {
-				parsedRule.Filesystem = policy.RuntimeContainerFilesystem{}
-			}
+			parsedRules = append(parsedRules, SchemaToRuntimeContainerRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
 
-			parsedRule.KubernetesEnforcementEffect = presentRule["kubernetes_enforcement_effect"].(string)
-			parsedRule.Name = presentRule["name"].(string)
+func SchemaToRuntimeContainerRule(presentRule map[string]interface{}) policy.RuntimeContainerRule {
+	parsedRule := policy.RuntimeContainerRule{}
+
+	parsedRule.AdvancedProtectionEffect = presentRule["advanced_protection_effect"].(string)
+	parsedRule.CloudMetadataEnforcementEffect = presentRule["cloud_metadata_enforcement_effect"].(string)
+	parsedRule.PreviousName = presentRule["previous_name"].(string)
+	parsedRule.SkipExecSessions = presentRule["skip_exec_sessions"].(bool)
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+	parsedRule.CustomRules = schemaToRuntimeContainerCustomRules(presentRule["custom_rule"].([]interface{}))
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Dns = schemaToRuntimeContainerDns(presentRule["dns"].([]interface{}))
+	parsedRule.Filesystem = schemaToRuntimeContainerFilesystem(presentRule["filesystem"].([]interface{}))
+	parsedRule.KubernetesEnforcementEffect = presentRule["kubernetes_enforcement_effect"].(string)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Network = schemaToRuntimeContainerNetwork(presentRule["network"].([]interface{}))
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.Processes = schemaToRuntimeContainerProcesses(presentRule["processes"].([]interface{}))
+	parsedRule.WildFireAnalysis = presentRule["wildfire_analysis"].(string)
+
+	return parsedRule
+}
 
-			if presentRule["network"].([]interface{})[0] != nil {
-				presentNetwork := presentRule["network"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Network = policy.RuntimeContainerNetwork{
-					AllowedIps:         SchemaToStringSlice(presentNetwork["allowed_ips"].([]interface{})),
-					DefaultEffect:      presentNetwork["default_effect"].(string),
-					DeniedIps:          SchemaToStringSlice(presentNetwork["denied_ips"].([]interface{})),
-					DeniedIpsEffect:    presentNetwork["denied_ips_effect"].(string),
-					Disabled:           presentNetwork["disabled"].(bool),
-					ListeningPorts:     schemaToRuntimeContainerNetworkPorts(presentNetwork["listening_ports"].([]interface{})),
-					ModifiedProcEffect: presentNetwork["modified_proc_effect"].(string),
-					OutboundPorts:      schemaToRuntimeContainerNetworkPorts(presentNetwork["outbound_ports"].([]interface{})),
-					PortScanEffect:     presentNetwork["port_scan_effect"].(string),
-					RawSocketsEffect:   presentNetwork["raw_sockets_effect"].(string),
-				}
-			} else {
-				parsedRule.Network = policy.RuntimeContainerNetwork{}
-			}
+func schemaToRuntimeContainerCustomRules(in []interface{}) []policy.RuntimeContainerCustomRule {
+	parsedCustomRules := make([]policy.RuntimeContainerCustomRule, 0, len(in))
+	for _, val := range in {
+		presentCustomRule := val.(map[string]interface{})
+		parsedCustomRules = append(parsedCustomRules, policy.RuntimeContainerCustomRule{
+			Action: presentCustomRule["action"].(string),
+			Effect: presentCustomRule["effect"].(string),
+			Id:     presentCustomRule["id"].(int),
+		})
+	}
+	return parsedCustomRules
+}
 
-			parsedRule.Notes = presentRule["notes"].(string)
+func schemaToRuntimeContainerDns(in []interface{}) policy.RuntimeContainerDns {
+	if len(in) == 0 || in[0] == nil {
+		return policy.RuntimeContainerDns{}
+	}
+	presentDns := in[0].(map[string]interface{})
+	return policy.RuntimeContainerDns{
+		DefaultEffect: presentDns["default_effect"].(string),
+		Disabled:      presentDns["disabled"].(bool),
+		DomainList:    schemaToRuntimeContainerDnsDomainList(presentDns["domain_list"].([]interface{})),
+	}
+}
 
-			if presentRule["processes"].([]interface{})[0] != nil {
-				presentProcesses := presentRule["processes"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Processes = policy.RuntimeContainerProcesses{
-					ModifiedProcessEffect: presentProcesses["modified_process_effect"].(string),
-					CryptoMinersEffect:    presentProcesses["crypto_miners_effect"].(string),
-					LateralMovementEffect: presentProcesses["lateral_movement_effect"].(string),
-					ReverseShellEffect:    presentProcesses["reverse_shell_effect"].(string),
-					SuidBinariesEffect:    presentProcesses["suid_binaries_effect"].(string),
-					DefaultEffect:         presentProcesses["default_effect"].(string),
-					CheckParentChild:      presentProcesses["check_parent_child"].(bool),
-					AllowedList:           SchemaToStringSlice(presentProcesses["allowed_list"].([]interface{})),
-					Disabled:              presentProcesses["disabled"].(bool),
-					DeniedList:            schemaToRuntimeContainerDeniedList(presentProcesses["denied_list"].([]interface{})),
-				}
-			} else {
-				parsedRule.Processes = policy.RuntimeContainerProcesses{}
-			}
+func schemaToRuntimeContainerFilesystem(in []interface{}) policy.RuntimeContainerFilesystem {
+	if len(in) == 0 || in[0] == nil {
+		return policy.RuntimeContainerFilesystem{}
+	}
+	presentFilesystem := in[0].(map[string]interface{})
+	return policy.RuntimeContainerFilesystem{
+		AllowedList:                SchemaToStringSlice(presentFilesystem["allowed_list"].([]interface{})),
+		BackdoorFilesEffect:        presentFilesystem["backdoor_files_effect"].(string),
+		DefaultEffect:              presentFilesystem["default_effect"].(string),
+		DeniedList:                 schemaToRuntimeContainerDeniedList(presentFilesystem["denied_list"].([]interface{})),
+		Disabled:                   presentFilesystem["disabled"].(bool),
+		EncryptedBinariesEffect:    presentFilesystem["encrypted_binaries_effect"].(string),
+		NewFilesEffect:             presentFilesystem["new_files_effect"].(string),
+		SuspiciousElfHeadersEffect: presentFilesystem["suspicious_elf_headers_effect"].(string),
+	}
+}
 
-			parsedRule.WildFireAnalysis = presentRule["wildfire_analysis"].(string)
+func schemaToRuntimeContainerNetwork(in []interface{}) policy.RuntimeContainerNetwork {
+	if len(in) == 0 || in[0] == nil {
+		return policy.RuntimeContainerNetwork{}
+	}
+	presentNetwork := in[0].(map[string]interface{})
+	return policy.RuntimeContainerNetwork{
+		AllowedIps:         SchemaToStringSlice(presentNetwork["allowed_ips"].([]interface{})),
+		DefaultEffect:      presentNetwork["default_effect"].(string),
+		DeniedIps:          SchemaToStringSlice(presentNetwork["denied_ips"].([]interface{})),
+		DeniedIpsEffect:    presentNetwork["denied_ips_effect"].(string),
+		Disabled:           presentNetwork["disabled"].(bool),
+		ListeningPorts:     schemaToRuntimeContainerNetworkPorts(presentNetwork["listening_ports"].([]interface{})),
+		ModifiedProcEffect: presentNetwork["modified_proc_effect"].(string),
+		OutboundPorts:      schemaToRuntimeContainerNetworkPorts(presentNetwork["outbound_ports"].([]interface{})),
+		PortScanEffect:     presentNetwork["port_scan_effect"].(string),
+		RawSocketsEffect:   presentNetwork["raw_sockets_effect"].(string),
+	}
+}
 
-			parsedRules = append(parsedRules, parsedRule)
-		}
+func schemaToRuntimeContainerProcesses(in []interface{}) policy.RuntimeContainerProcesses {
+	if len(in) == 0 || in[0] == nil {
+		return policy.RuntimeContainerProcesses{}
+	}
+	presentProcesses := in[0].(map[string]interface{})
+	return policy.RuntimeContainerProcesses{
+		ModifiedProcessEffect: presentProcesses["modified_process_effect"].(string),
+		CryptoMinersEffect:    presentProcesses["crypto_miners_effect"].(string),
+		LateralMovementEffect: presentProcesses["lateral_movement_effect"].(string),
+		ReverseShellEffect:    presentProcesses["reverse_shell_effect"].(string),
+		SuidBinariesEffect:    presentProcesses["suid_binaries_effect"].(string),
+		DefaultEffect:         presentProcesses["default_effect"].(string),
+		CheckParentChild:      presentProcesses["check_parent_child"].(bool),
+		AllowedList:           SchemaToStringSlice(presentProcesses["allowed_list"].([]interface{})),
+		Disabled:              presentProcesses["disabled"].(bool),
+		DeniedList:            schemaToRuntimeContainerDeniedList(presentProcesses["denied_list"].([]interface{})),
 	}
-	return parsedRules, nil
 }
 
 func schemaToRuntimeContainerNetworkPorts(in []interface{}) policy.RuntimeContainerNetworkPorts {
@@ -164,27 +172,31 @@ func schemaToRuntimeContainerPorts(in []interface{}) []policy.RuntimeContainerPo
 func RuntimeContainerRulesToSchema(in []policy.RuntimeContainerRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["advanced_protection_effect"] = val.AdvancedProtectionEffect
-		m["cloud_metadata_enforcement_effect"] = val.CloudMetadataEnforcementEffect
-		m["previous_name"] = val.PreviousName
-		m["skip_exec_sessions"] = val.SkipExecSessions
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["custom_rule"] = runtimeContainerCustomRulesToSchema(val.CustomRules)
-		m["disabled"] = val.Disabled
-		m["dns"] = runtimeContainerDnsToSchema(val.Dns)
-		m["filesystem"] = runtimeContainerFileystemToSchema(val.Filesystem)
-		m["kubernetes_enforcement_effect"] = val.KubernetesEnforcementEffect
-		m["name"] = val.Name
-		m["network"] = runtimeContainerNetworkToSchema(val.Network)
-		m["notes"] = val.Notes
-		m["processes"] = runtimeContainerProcessesToSchema(val.Processes)
-		m["wildfire_analysis"] = val.WildFireAnalysis
-		ans = append(ans, m)
+		ans = append(ans, RuntimeContainerRuleToSchema(val))
 	}
 	return ans
 }
 
+func RuntimeContainerRuleToSchema(val policy.RuntimeContainerRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["advanced_protection_effect"] = val.AdvancedProtectionEffect
+	m["cloud_metadata_enforcement_effect"] = val.CloudMetadataEnforcementEffect
+	m["previous_name"] = val.PreviousName
+	m["skip_exec_sessions"] = val.SkipExecSessions
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["custom_rule"] = runtimeContainerCustomRulesToSchema(val.CustomRules)
+	m["disabled"] = val.Disabled
+	m["dns"] = runtimeContainerDnsToSchema(val.Dns)
+	m["filesystem"] = runtimeContainerFileystemToSchema(val.Filesystem)
+	m["kubernetes_enforcement_effect"] = val.KubernetesEnforcementEffect
+	m["name"] = val.Name
+	m["network"] = runtimeContainerNetworkToSchema(val.Network)
+	m["notes"] = val.Notes
+	m["processes"] = runtimeContainerProcessesToSchema(val.Processes)
+	m["wildfire_analysis"] = val.WildFireAnalysis
+	return m
+}
+
 func runtimeContainerCustomRulesToSchema(in []policy.RuntimeContainerCustomRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
@@ -10,138 +10,141 @@ func SchemaToRuntimeHostRules(d *schema.ResourceData) ([]policy.RuntimeHostRule,
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.RuntimeHostRule{}
-
-			if presentRule["antimalware"].([]interface{})[0] != nil {
-				presentAntiMalware := presentRule["antimalware"].([]interface{})[0].(map[string]interface{})
-				parsedAntiMalware := policy.RuntimeHostAntiMalware{}
-
-				parsedAntiMalware.AllowedProcesses = SchemaToStringSlice(presentAntiMalware["allowed_processes"].([]interface{}))
-				parsedAntiMalware.CryptoMiner = presentAntiMalware["crypto_miners"].(string)
-				parsedAntiMalware.CustomFeed = presentAntiMalware["custom_feed"].(string)
-
-				if presentAntiMalware["denied_processes"].([]interface{})[0] != nil {
-					presentDeniedProcesses := presentAntiMalware["denied_processes"].([]interface{})[0].(map[string]interface{})
-					parsedAntiMalware.DeniedProcesses = policy.RuntimeHostDeniedProcesses{
-						Effect: presentDeniedProcesses["effect"].(string),
-						Paths:  SchemaToStringSlice(presentDeniedProcesses["paths"].([]interface{})),
-					}
-				} else {
-					parsedAntiMalware.DeniedProcesses = policy.RuntimeHostDeniedProcesses{}
-				}
-
-				parsedAntiMalware.DetectCompilerGeneratedBinary = presentAntiMalware["detect_compiler_generated_binary"].(bool)
-				parsedAntiMalware.EncryptedBinaries = presentAntiMalware["encrypted_binaries"].(string)
-				parsedAntiMalware.ExecutionFlowHijack = presentAntiMalware["execution_flow_hijack"].(string)
-				parsedAntiMalware.IntelligenceFeed = presentAntiMalware["intelligence_feed"].(string)
-				parsedAntiMalware.ReverseShell = presentAntiMalware["reverse_shell"].(string)
-				parsedAntiMalware.ServiceUnknownOriginBinary = presentAntiMalware["service_unknown_origin_binary"].(string)
-				parsedAntiMalware.SkipSshTracking = presentAntiMalware["skip_ssh_tracking"].(bool)
-				parsedAntiMalware.SuspiciousElfHeaders = presentAntiMalware["suspicious_elf_headers"].(string)
-				parsedAntiMalware.TempFsProcesses = presentAntiMalware["temp_filesystem_processes"].(string)
-				parsedAntiMalware.UserUnknownOriginBinary = presentAntiMalware["user_unknown_origin_binary"].(string)
-				parsedAntiMalware.WebShell = presentAntiMalware["webshell"].(string)
-				parsedAntiMalware.WildFireAnalysis = presentAntiMalware["wildfire_analysis"].(string)
-
-				parsedRule.AntiMalware = parsedAntiMalware
-
-			} else {
-				parsedRule.AntiMalware = policy.RuntimeHostAntiMalware{}
-			}
+			parsedRules = append(parsedRules, SchemaToRuntimeHostRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
 
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
-
-			presentCustomRules := presentRule["custom_rule"].([]interface{})
-			parsedCustomRules := make([]policy.RuntimeHostCustomRule, 0, len(presentCustomRules))
-			for _, val := range presentCustomRules {
-				presentCustomRule := val.(map[string]interface{})
-				parsedCustomRules = append(parsedCustomRules, policy.RuntimeHostCustomRule{
-					Action: presentCustomRule["action"].(string),
-					Effect: presentCustomRule["effect"].(string),
-					Id:     presentCustomRule["id"].(int),
-				})
-			}
-			parsedRule.CustomRules = parsedCustomRules
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-
-			if presentRule["dns"].([]interface{})[0] != nil {
-				presentDns := presentRule["dns"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Dns = policy.RuntimeHostDns{
-					Allowed:          SchemaToStringSlice(presentDns["allowed"].([]interface{})),
-					Denied:           SchemaToStringSlice(presentDns["denied"].([]interface{})),
-					DenyEffect:       presentDns["deny_effect"].(string),
-					IntelligenceFeed: presentDns["intelligence_feed"].(string),
-				}
-			} else {
-				parsedRule.Dns = policy.RuntimeHostDns{}
-			}
+func SchemaToRuntimeHostRule(presentRule map[string]interface{}) policy.RuntimeHostRule {
+	parsedRule := policy.RuntimeHostRule{}
 
-			presentFileIntegrityRules := presentRule["file_integrity_rule"].([]interface{})
-			parsedFileIntegrityRules := make([]policy.RuntimeHostFileIntegrityRule, 0, len(presentFileIntegrityRules))
-			for _, val := range presentFileIntegrityRules {
-				presentFileIntegrityRule := val.(map[string]interface{})
-				parsedFileIntegrityRules = append(parsedFileIntegrityRules, policy.RuntimeHostFileIntegrityRule{
-					AllowedProcesses: SchemaToStringSlice(presentFileIntegrityRule["allowed_processes"].([]interface{})),
-					ExcludedFiles:    SchemaToStringSlice(presentFileIntegrityRule["excluded_files"].([]interface{})),
-					Metadata:         presentFileIntegrityRule["metadata"].(bool),
-					Path:             presentFileIntegrityRule["path"].(string),
-					Read:             presentFileIntegrityRule["read"].(bool),
-					Recursive:        presentFileIntegrityRule["recursive"].(bool),
-					Write:            presentFileIntegrityRule["write"].(bool),
-				})
-			}
-			parsedRule.FileIntegrityRules = parsedFileIntegrityRules
-
-			if presentRule["activities"].([]interface{})[0] != nil {
-				presentActivities := presentRule["activities"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Forensic = policy.RuntimeHostForensic{
-					ActivitiesDisabled:       presentActivities["disabled"].(bool),
-					DockerEnabled:            presentActivities["docker_enabled"].(bool),
-					ReadonlyDockerEnabled:    presentActivities["readonly_docker_enabled"].(bool),
-					ServiceActivitiesEnabled: presentActivities["service_activities_enabled"].(bool),
-					SshdEnabled:              presentActivities["sshd_enabled"].(bool),
-					SudoEnabled:              presentActivities["sudo_enabled"].(bool),
-				}
-			} else {
-				parsedRule.Forensic = policy.RuntimeHostForensic{}
-			}
+	if presentRule["antimalware"].([]interface{})[0] != nil {
+		presentAntiMalware := presentRule["antimalware"].([]interface{})[0].(map[string]interface{})
+		parsedAntiMalware := policy.RuntimeHostAntiMalware{}
 
-			presentLogInspectionRules := presentRule["log_inspection_rule"].([]interface{})
-			parsedLogInspectionRules := make([]policy.RuntimeHostLogInspectionRule, 0, len(presentLogInspectionRules))
-			for _, val := range presentLogInspectionRules {
-				presentLogInspectionRule := val.(map[string]interface{})
-				parsedLogInspectionRules = append(parsedLogInspectionRules, policy.RuntimeHostLogInspectionRule{
-					Path:  presentLogInspectionRule["path"].(string),
-					Regex: SchemaToStringSlice(presentLogInspectionRule["regex"].([]interface{})),
-				})
-			}
-			parsedRule.LogInspectionRules = parsedLogInspectionRules
-
-			parsedRule.Name = presentRule["name"].(string)
-
-			if presentRule["network"].([]interface{})[0] != nil {
-				presentNetwork := presentRule["network"].([]interface{})[0].(map[string]interface{})
-				parsedRule.Network = policy.RuntimeHostNetwork{
-					AllowedOutboundIps:   SchemaToStringSlice(presentNetwork["allowed_outbound_ips"].([]interface{})),
-					CustomFeed:           presentNetwork["custom_feed"].(string),
-					DeniedListeningPorts: schemaToRuntimeHostPorts(presentNetwork["denied_listening_port"].([]interface{})),
-					DeniedOutboundIps:    SchemaToStringSlice(presentNetwork["denied_outbound_ips"].([]interface{})),
-					DeniedOutboundPorts:  schemaToRuntimeHostPorts(presentNetwork["denied_outbound_port"].([]interface{})),
-					DenyEffect:           presentNetwork["deny_effect"].(string),
-					IntelligenceFeed:     presentNetwork["intelligence_feed"].(string),
-				}
-			} else {
-				parsedRule.Network = policy.RuntimeHostNetwork{}
+		parsedAntiMalware.AllowedProcesses = SchemaToStringSlice(presentAntiMalware["allowed_processes"].([]interface{}))
+		parsedAntiMalware.CryptoMiner = presentAntiMalware["crypto_miners"].(string)
+		parsedAntiMalware.CustomFeed = presentAntiMalware["custom_feed"].(string)
+
+		if presentAntiMalware["denied_processes"].([]interface{})[0] != nil {
+			presentDeniedProcesses := presentAntiMalware["denied_processes"].([]interface{})[0].(map[string]interface{})
+			parsedAntiMalware.DeniedProcesses = policy.RuntimeHostDeniedProcesses{
+				Effect: presentDeniedProcesses["effect"].(string),
+				Paths:  SchemaToStringSlice(presentDeniedProcesses["paths"].([]interface{})),
 			}
+		} else {
+			parsedAntiMalware.DeniedProcesses = policy.RuntimeHostDeniedProcesses{}
+		}
+
+		parsedAntiMalware.DetectCompilerGeneratedBinary = presentAntiMalware["detect_compiler_generated_binary"].(bool)
+		parsedAntiMalware.EncryptedBinaries = presentAntiMalware["encrypted_binaries"].(string)
+		parsedAntiMalware.ExecutionFlowHijack = presentAntiMalware["execution_flow_hijack"].(string)
+		parsedAntiMalware.IntelligenceFeed = presentAntiMalware["intelligence_feed"].(string)
+		parsedAntiMalware.ReverseShell = presentAntiMalware["reverse_shell"].(string)
+		parsedAntiMalware.ServiceUnknownOriginBinary = presentAntiMalware["service_unknown_origin_binary"].(string)
+		parsedAntiMalware.SkipSshTracking = presentAntiMalware["skip_ssh_tracking"].(bool)
+		parsedAntiMalware.SuspiciousElfHeaders = presentAntiMalware["suspicious_elf_headers"].(string)
+		parsedAntiMalware.TempFsProcesses = presentAntiMalware["temp_filesystem_processes"].(string)
+		parsedAntiMalware.UserUnknownOriginBinary = presentAntiMalware["user_unknown_origin_binary"].(string)
+		parsedAntiMalware.WebShell = presentAntiMalware["webshell"].(string)
+		parsedAntiMalware.WildFireAnalysis = presentAntiMalware["wildfire_analysis"].(string)
+
+		parsedRule.AntiMalware = parsedAntiMalware
+
+	} else {
+		parsedRule.AntiMalware = policy.RuntimeHostAntiMalware{}
+	}
 
-			parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
 
-			parsedRules = append(parsedRules, parsedRule)
+	presentCustomRules := presentRule["custom_rule"].([]interface{})
+	parsedCustomRules := make([]policy.RuntimeHostCustomRule, 0, len(presentCustomRules))
+	for _, val := range presentCustomRules {
+		presentCustomRule := val.(map[string]interface{})
+		parsedCustomRules = append(parsedCustomRules, policy.RuntimeHostCustomRule{
+			Action: presentCustomRule["action"].(string),
+			Effect: presentCustomRule["effect"].(string),
+			Id:     presentCustomRule["id"].(int),
+		})
+	}
+	parsedRule.CustomRules = parsedCustomRules
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+
+	if presentRule["dns"].([]interface{})[0] != nil {
+		presentDns := presentRule["dns"].([]interface{})[0].(map[string]interface{})
+		parsedRule.Dns = policy.RuntimeHostDns{
+			Allowed:          SchemaToStringSlice(presentDns["allowed"].([]interface{})),
+			Denied:           SchemaToStringSlice(presentDns["denied"].([]interface{})),
+			DenyEffect:       presentDns["deny_effect"].(string),
+			IntelligenceFeed: presentDns["intelligence_feed"].(string),
 		}
+	} else {
+		parsedRule.Dns = policy.RuntimeHostDns{}
 	}
-	return parsedRules, nil
+
+	presentFileIntegrityRules := presentRule["file_integrity_rule"].([]interface{})
+	parsedFileIntegrityRules := make([]policy.RuntimeHostFileIntegrityRule, 0, len(presentFileIntegrityRules))
+	for _, val := range presentFileIntegrityRules {
+		presentFileIntegrityRule := val.(map[string]interface{})
+		parsedFileIntegrityRules = append(parsedFileIntegrityRules, policy.RuntimeHostFileIntegrityRule{
+			AllowedProcesses: SchemaToStringSlice(presentFileIntegrityRule["allowed_processes"].([]interface{})),
+			ExcludedFiles:    SchemaToStringSlice(presentFileIntegrityRule["excluded_files"].([]interface{})),
+			Metadata:         presentFileIntegrityRule["metadata"].(bool),
+			Path:             presentFileIntegrityRule["path"].(string),
+			Read:             presentFileIntegrityRule["read"].(bool),
+			Recursive:        presentFileIntegrityRule["recursive"].(bool),
+			Write:            presentFileIntegrityRule["write"].(bool),
+		})
+	}
+	parsedRule.FileIntegrityRules = parsedFileIntegrityRules
+
+	if presentRule["activities"].([]interface{})[0] != nil {
+		presentActivities := presentRule["activities"].([]interface{})[0].(map[string]interface{})
+		parsedRule.Forensic = policy.RuntimeHostForensic{
+			ActivitiesDisabled:       presentActivities["disabled"].(bool),
+			DockerEnabled:            presentActivities["docker_enabled"].(bool),
+			ReadonlyDockerEnabled:    presentActivities["readonly_docker_enabled"].(bool),
+			ServiceActivitiesEnabled: presentActivities["service_activities_enabled"].(bool),
+			SshdEnabled:              presentActivities["sshd_enabled"].(bool),
+			SudoEnabled:              presentActivities["sudo_enabled"].(bool),
+		}
+	} else {
+		parsedRule.Forensic = policy.RuntimeHostForensic{}
+	}
+
+	presentLogInspectionRules := presentRule["log_inspection_rule"].([]interface{})
+	parsedLogInspectionRules := make([]policy.RuntimeHostLogInspectionRule, 0, len(presentLogInspectionRules))
+	for _, val := range presentLogInspectionRules {
+		presentLogInspectionRule := val.(map[string]interface{})
+		parsedLogInspectionRules = append(parsedLogInspectionRules, policy.RuntimeHostLogInspectionRule{
+			Path:  presentLogInspectionRule["path"].(string),
+			Regex: SchemaToStringSlice(presentLogInspectionRule["regex"].([]interface{})),
+		})
+	}
+	parsedRule.LogInspectionRules = parsedLogInspectionRules
+
+	parsedRule.Name = presentRule["name"].(string)
+
+	if presentRule["network"].([]interface{})[0] != nil {
+		presentNetwork := presentRule["network"].([]interface{})[0].(map[string]interface{})
+		parsedRule.Network = policy.RuntimeHostNetwork{
+			AllowedOutboundIps:   SchemaToStringSlice(presentNetwork["allowed_outbound_ips"].([]interface{})),
+			CustomFeed:           presentNetwork["custom_feed"].(string),
+			DeniedListeningPorts: schemaToRuntimeHostPorts(presentNetwork["denied_listening_port"].([]interface{})),
+			DeniedOutboundIps:    SchemaToStringSlice(presentNetwork["denied_outbound_ips"].([]interface{})),
+			DeniedOutboundPorts:  schemaToRuntimeHostPorts(presentNetwork["denied_outbound_port"].([]interface{})),
+			DenyEffect:           presentNetwork["deny_effect"].(string),
+			IntelligenceFeed:     presentNetwork["intelligence_feed"].(string),
+		}
+	} else {
+		parsedRule.Network = policy.RuntimeHostNetwork{}
+	}
+
+	parsedRule.Notes = presentRule["notes"].(string)
+
+	return parsedRule
 }
 
 func schemaToRuntimeHostPorts(in []interface{}) []policy.RuntimeHostPort {
@@ -160,23 +163,27 @@ func schemaToRuntimeHostPorts(in []interface{}) []policy.RuntimeHostPort {
 func RuntimeHostRulesToSchema(in []policy.RuntimeHostRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["activities"] = runtimeHostActivitiesToSchema(val.Forensic)
-		m["antimalware"] = runtimeHostAntiMalwareToSchema(val.AntiMalware)
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["custom_rule"] = runtimeHostCustomRulesToSchema(val.CustomRules)
-		m["disabled"] = val.Disabled
-		m["dns"] = runtimeHostDnsToSchema(val.Dns)
-		m["file_integrity_rule"] = runtimeHostFileIntegrityRulesToSchema(val.FileIntegrityRules)
-		m["log_inspection_rule"] = runtimeHostLogInspectionRulesToSchema(val.LogInspectionRules)
-		m["name"] = val.Name
-		m["network"] = runtimeHostNetworkToSchema(val.Network)
-		m["notes"] = val.Notes
-		ans = append(ans, m)
+		ans = append(ans, RuntimeHostRuleToSchema(val))
 	}
 	return ans
 }
 
+func RuntimeHostRuleToSchema(val policy.RuntimeHostRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["activities"] = runtimeHostActivitiesToSchema(val.Forensic)
+	m["antimalware"] = runtimeHostAntiMalwareToSchema(val.AntiMalware)
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["custom_rule"] = runtimeHostCustomRulesToSchema(val.CustomRules)
+	m["disabled"] = val.Disabled
+	m["dns"] = runtimeHostDnsToSchema(val.Dns)
+	m["file_integrity_rule"] = runtimeHostFileIntegrityRulesToSchema(val.FileIntegrityRules)
+	m["log_inspection_rule"] = runtimeHostLogInspectionRulesToSchema(val.LogInspectionRules)
+	m["name"] = val.Name
+	m["network"] = runtimeHostNetworkToSchema(val.Network)
+	m["notes"] = val.Notes
+	return m
+}
+
 func runtimeHostActivitiesToSchema(in policy.RuntimeHostForensic) []interface{} {
 	ans := make([]interface{}, 0, 1)
 	m := make(map[string]interface{})
@@ -0,0 +1,59 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToRuntimeServerlessRules(d *schema.ResourceData) ([]policy.RuntimeServerlessRule, error) {
+	parsedRules := make([]policy.RuntimeServerlessRule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			parsedRules = append(parsedRules, SchemaToRuntimeServerlessRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToRuntimeServerlessRule(presentRule map[string]interface{}) policy.RuntimeServerlessRule {
+	parsedRule := policy.RuntimeServerlessRule{}
+
+	parsedRule.AdvancedProtectionEffect = presentRule["advanced_protection_effect"].(string)
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+	parsedRule.CustomRules = schemaToRuntimeContainerCustomRules(presentRule["custom_rule"].([]interface{}))
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Dns = schemaToRuntimeContainerDns(presentRule["dns"].([]interface{}))
+	parsedRule.Filesystem = schemaToRuntimeContainerFilesystem(presentRule["filesystem"].([]interface{}))
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Network = schemaToRuntimeContainerNetwork(presentRule["network"].([]interface{}))
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.PreviousName = presentRule["previous_name"].(string)
+	parsedRule.Processes = schemaToRuntimeContainerProcesses(presentRule["processes"].([]interface{}))
+
+	return parsedRule
+}
+
+func RuntimeServerlessRulesToSchema(in []policy.RuntimeServerlessRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ans = append(ans, RuntimeServerlessRuleToSchema(val))
+	}
+	return ans
+}
+
+func RuntimeServerlessRuleToSchema(val policy.RuntimeServerlessRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["advanced_protection_effect"] = val.AdvancedProtectionEffect
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["custom_rule"] = runtimeContainerCustomRulesToSchema(val.CustomRules)
+	m["disabled"] = val.Disabled
+	m["dns"] = runtimeContainerDnsToSchema(val.Dns)
+	m["filesystem"] = runtimeContainerFileystemToSchema(val.Filesystem)
+	m["name"] = val.Name
+	m["network"] = runtimeContainerNetworkToSchema(val.Network)
+	m["notes"] = val.Notes
+	m["previous_name"] = val.PreviousName
+	m["processes"] = runtimeContainerProcessesToSchema(val.Processes)
+	return m
+}
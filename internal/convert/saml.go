@@ -0,0 +1,39 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToSamlSettings(d *schema.ResourceData) settings.SamlSettings {
+	return settings.SamlSettings{
+		AppId:         d.Get("app_id").(string),
+		AppSecret:     auth.Secret{Plain: d.Get("app_secret").(string)},
+		Audience:      d.Get("audience").(string),
+		Cert:          d.Get("cert").(string),
+		ConsoleUrl:    d.Get("console_url").(string),
+		Enabled:       d.Get("enabled").(bool),
+		Issuer:        d.Get("issuer").(string),
+		ProviderAlias: d.Get("provider_alias").(string),
+		TenantId:      d.Get("tenant_id").(string),
+		Type:          d.Get("type").(string),
+		Url:           d.Get("url").(string),
+	}
+}
+
+// The application secret and the certificate are write-only, so they are
+// left out.
+func SamlSettingsToSchema(in settings.SamlSettings) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["app_id"] = in.AppId
+	m["audience"] = in.Audience
+	m["console_url"] = in.ConsoleUrl
+	m["enabled"] = in.Enabled
+	m["issuer"] = in.Issuer
+	m["provider_alias"] = in.ProviderAlias
+	m["tenant_id"] = in.TenantId
+	m["type"] = in.Type
+	m["url"] = in.Url
+	return m
+}
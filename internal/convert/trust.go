@@ -0,0 +1,59 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToTrustRules(d *schema.ResourceData) ([]policy.TrustRule, error) {
+	parsedRules := make([]policy.TrustRule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			parsedRules = append(parsedRules, SchemaToTrustRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToTrustRule(presentRule map[string]interface{}) policy.TrustRule {
+	return policy.TrustRule{
+		AllowedGroups: SchemaToStringSlice(presentRule["allowed_groups"].([]interface{})),
+		BlockMessage:  presentRule["block_message"].(string),
+		Collections:   PolicySchemaToCollections(presentRule["collections"].([]interface{})),
+		DeniedGroups:  SchemaToStringSlice(presentRule["denied_groups"].([]interface{})),
+		Disabled:      presentRule["disabled"].(bool),
+		Effect:        presentRule["effect"].(string),
+		Name:          presentRule["name"].(string),
+		Notes:         presentRule["notes"].(string),
+	}
+}
+
+func TrustRulesToSchema(in []policy.TrustRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ans = append(ans, TrustRuleToSchema(val))
+	}
+	return ans
+}
+
+func TrustRuleToSchema(val policy.TrustRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["allowed_groups"] = val.AllowedGroups
+	m["block_message"] = val.BlockMessage
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["denied_groups"] = val.DeniedGroups
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	return m
+}
+
+func SchemaToTrustGroup(d *schema.ResourceData) policy.TrustGroup {
+	return policy.TrustGroup{
+		Images: SchemaToStringSlice(d.Get("images").([]interface{})),
+		Layers: SchemaToStringSlice(d.Get("layers").([]interface{})),
+		Name:   d.Get("name").(string),
+	}
+}
@@ -49,3 +49,13 @@ func UserPermissionsToSchema(in []auth.UserPermission) []interface{} {
 	}
 	return ans
 }
+
+// The password is write-only, so it is left out.
+func UserToSchema(in auth.User) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["authentication_type"] = in.AuthType
+	m["permissions"] = UserPermissionsToSchema(in.Permissions)
+	m["role"] = in.Role
+	m["username"] = in.Username
+	return m
+}
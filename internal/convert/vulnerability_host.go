@@ -10,60 +10,63 @@ func SchemaToVulnerabilityHostRules(d *schema.ResourceData) ([]policy.Vulnerabil
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.VulnerabilityHostRule{}
-
-			if presentRule["alert_threshold"].([]interface{})[0] != nil {
-				presentAlertThreshold := presentRule["alert_threshold"].([]interface{})[0].(map[string]interface{})
-				parsedRule.AlertThreshold = policy.VulnerabilityHostThreshold{
-					Disabled: presentAlertThreshold["disabled"].(bool),
-					Value:    presentAlertThreshold["value"].(int),
-				}
-			} else {
-				parsedRule.AlertThreshold = policy.VulnerabilityHostThreshold{}
-			}
-
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
-
-			presentCveRules := presentRule["cve_rule"].([]interface{})
-			parsedCveRules := make([]policy.VulnerabilityHostCveRule, 0, len(presentCveRules))
-			for _, val := range presentCveRules {
-				presentCveRule := val.(map[string]interface{})
-				parsedCveRules = append(parsedCveRules, policy.VulnerabilityHostCveRule{
-					Description: presentCveRule["description"].(string),
-					Effect:      presentCveRule["effect"].(string),
-					Expiration:  schemaToVulnerabilityHostExpiration(presentCveRule["expiration"].([]interface{})),
-					Id:          presentCveRule["id"].(string),
-				})
-			}
-			parsedRule.CveRules = parsedCveRules
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-			parsedRule.GraceDays = presentRule["grace_days"].(int)
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Notes = presentRule["notes"].(string)
-			parsedRule.OnlyFixed = presentRule["only_fixed"].(bool)
-
-			presentTagRules := presentRule["tag_rule"].([]interface{})
-			parsedTagRules := make([]policy.VulnerabilityHostTagRule, 0, len(presentTagRules))
-			for _, val := range presentTagRules {
-				presentTagRule := val.(map[string]interface{})
-				parsedTagRules = append(parsedTagRules, policy.VulnerabilityHostTagRule{
-					Description: presentTagRule["description"].(string),
-					Effect:      presentTagRule["effect"].(string),
-					Expiration:  schemaToVulnerabilityHostExpiration(presentTagRule["expiration"].([]interface{})),
-					Name:        presentTagRule["name"].(string),
-				})
-			}
-			parsedRule.TagRules = parsedTagRules
-
-			parsedRules = append(parsedRules, parsedRule)
+			parsedRules = append(parsedRules, SchemaToVulnerabilityHostRule(val.(map[string]interface{})))
 		}
 	}
 	return parsedRules, nil
 }
 
+func SchemaToVulnerabilityHostRule(presentRule map[string]interface{}) policy.VulnerabilityHostRule {
+	parsedRule := policy.VulnerabilityHostRule{}
+
+	if presentRule["alert_threshold"].([]interface{})[0] != nil {
+		presentAlertThreshold := presentRule["alert_threshold"].([]interface{})[0].(map[string]interface{})
+		parsedRule.AlertThreshold = policy.VulnerabilityHostThreshold{
+			Disabled: presentAlertThreshold["disabled"].(bool),
+			Value:    presentAlertThreshold["value"].(int),
+		}
+	} else {
+		parsedRule.AlertThreshold = policy.VulnerabilityHostThreshold{}
+	}
+
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+
+	presentCveRules := presentRule["cve_rule"].([]interface{})
+	parsedCveRules := make([]policy.VulnerabilityHostCveRule, 0, len(presentCveRules))
+	for _, val := range presentCveRules {
+		presentCveRule := val.(map[string]interface{})
+		parsedCveRules = append(parsedCveRules, policy.VulnerabilityHostCveRule{
+			Description: presentCveRule["description"].(string),
+			Effect:      presentCveRule["effect"].(string),
+			Expiration:  schemaToVulnerabilityHostExpiration(presentCveRule["expiration"].([]interface{})),
+			Id:          presentCveRule["id"].(string),
+		})
+	}
+	parsedRule.CveRules = parsedCveRules
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.GraceDays = presentRule["grace_days"].(int)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.OnlyFixed = presentRule["only_fixed"].(bool)
+
+	presentTagRules := presentRule["tag_rule"].([]interface{})
+	parsedTagRules := make([]policy.VulnerabilityHostTagRule, 0, len(presentTagRules))
+	for _, val := range presentTagRules {
+		presentTagRule := val.(map[string]interface{})
+		parsedTagRules = append(parsedTagRules, policy.VulnerabilityHostTagRule{
+			Description: presentTagRule["description"].(string),
+			Effect:      presentTagRule["effect"].(string),
+			Expiration:  schemaToVulnerabilityHostExpiration(presentTagRule["expiration"].([]interface{})),
+			Name:        presentTagRule["name"].(string),
+		})
+	}
+	parsedRule.TagRules = parsedTagRules
+
+	return parsedRule
+}
+
 func schemaToVulnerabilityHostExpiration(in []interface{}) policy.VulnerabilityHostExpiration {
 	parsedExpiration := policy.VulnerabilityHostExpiration{}
 	if in[0] == nil {
@@ -78,22 +81,26 @@ func schemaToVulnerabilityHostExpiration(in []interface{}) policy.VulnerabilityH
 func VulnerabilityHostRulesToSchema(in []policy.VulnerabilityHostRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["alert_threshold"] = vulnerabilityHostAlertThresholdToSchema(val.AlertThreshold)
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["cve_rule"] = vulnerabilityHostCveRulesToSchema(val.CveRules)
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["grace_days"] = val.GraceDays
-		m["name"] = val.Name
-		m["notes"] = val.Notes
-		m["only_fixed"] = val.OnlyFixed
-		m["tag_rule"] = vulnerabilityHostTagRulesToSchema(val.TagRules)
-		ans = append(ans, m)
+		ans = append(ans, VulnerabilityHostRuleToSchema(val))
 	}
 	return ans
 }
 
+func VulnerabilityHostRuleToSchema(val policy.VulnerabilityHostRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["alert_threshold"] = vulnerabilityHostAlertThresholdToSchema(val.AlertThreshold)
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["cve_rule"] = vulnerabilityHostCveRulesToSchema(val.CveRules)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["grace_days"] = val.GraceDays
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["only_fixed"] = val.OnlyFixed
+	m["tag_rule"] = vulnerabilityHostTagRulesToSchema(val.TagRules)
+	return m
+}
+
 func vulnerabilityHostAlertThresholdToSchema(in policy.VulnerabilityHostThreshold) []interface{} {
 	ans := make([]interface{}, 0, 1)
 	m := make(map[string]interface{})
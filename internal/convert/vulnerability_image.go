@@ -10,88 +10,97 @@ func SchemaToVulnerabilityImageRules(d *schema.ResourceData) ([]policy.Vulnerabi
 	if rules, ok := d.GetOk("rule"); ok {
 		presentRules := rules.([]interface{})
 		for _, val := range presentRules {
-			presentRule := val.(map[string]interface{})
-			parsedRule := policy.VulnerabilityImageRule{}
-
-			if presentRule["alert_threshold"].([]interface{})[0] != nil {
-				presentAlertThreshold := presentRule["alert_threshold"].([]interface{})[0].(map[string]interface{})
-				parsedRule.AlertThreshold = policy.VulnerabilityImageThreshold{
-					Disabled: presentAlertThreshold["disabled"].(bool),
-					Value:    presentAlertThreshold["value"].(int),
-				}
-			} else {
-				parsedRule.AlertThreshold = policy.VulnerabilityImageThreshold{}
-			}
-
-			parsedRule.BlockMessage = presentRule["block_message"].(string)
-
-			if presentRule["block_threshold"].([]interface{})[0] != nil {
-				presentBlockThreshold := presentRule["block_threshold"].([]interface{})[0].(map[string]interface{})
-				parsedRule.BlockThreshold = policy.VulnerabilityImageThreshold{
-					Enabled: presentBlockThreshold["enabled"].(bool),
-					Value:   presentBlockThreshold["value"].(int),
-				}
-			} else {
-				parsedRule.BlockThreshold = policy.VulnerabilityImageThreshold{}
-			}
-
-			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
-
-			presentCveRules := presentRule["cve_rule"].([]interface{})
-			parsedCveRules := make([]policy.VulnerabilityImageCveRule, 0, len(presentCveRules))
-			for _, val := range presentCveRules {
-				presentCveRule := val.(map[string]interface{})
-				parsedCveRules = append(parsedCveRules, policy.VulnerabilityImageCveRule{
-					Description: presentCveRule["description"].(string),
-					Effect:      presentCveRule["effect"].(string),
-					Expiration:  schemaToVulnerabilityImageExpiration(presentCveRule["expiration"].([]interface{})),
-					Id:          presentCveRule["id"].(string),
-				})
-			}
-			parsedRule.CveRules = parsedCveRules
-
-			parsedRule.Disabled = presentRule["disabled"].(bool)
-			parsedRule.Effect = presentRule["effect"].(string)
-
-			if len(presentRule["grace_days_policy"].([]interface{})) > 0 && presentRule["grace_days_policy"].([]interface{})[0] != nil {
-				presentGraceDaysPolicy := presentRule["grace_days_policy"].([]interface{})[0].(map[string]interface{})
-				parsedRule.GraceDaysPolicy = policy.VulnerabilityImageGraceDaysPolicy{
-					Enabled:  true,
-					Low:      presentGraceDaysPolicy["low"].(int),
-					Medium:   presentGraceDaysPolicy["medium"].(int),
-					High:     presentGraceDaysPolicy["high"].(int),
-					Critical: presentGraceDaysPolicy["critical"].(int),
-				}
-				parsedRule.GraceDays = 0
-			} else {
-				parsedRule.GraceDays = presentRule["grace_days"].(int)
-			}
-
-			parsedRule.Name = presentRule["name"].(string)
-			parsedRule.Notes = presentRule["notes"].(string)
-			parsedRule.OnlyFixed = presentRule["only_fixed"].(bool)
-
-			presentTagRules := presentRule["tag_rule"].([]interface{})
-			parsedTagRules := make([]policy.VulnerabilityImageTagRule, 0, len(presentTagRules))
-			for _, val := range presentTagRules {
-				presentTagRule := val.(map[string]interface{})
-				parsedTagRules = append(parsedTagRules, policy.VulnerabilityImageTagRule{
-					Description: presentTagRule["description"].(string),
-					Effect:      presentTagRule["effect"].(string),
-					Expiration:  schemaToVulnerabilityImageExpiration(presentTagRule["expiration"].([]interface{})),
-					Name:        presentTagRule["name"].(string),
-				})
-			}
-			parsedRule.TagRules = parsedTagRules
-
-			parsedRule.Verbose = presentRule["verbose"].(bool)
-
-			parsedRules = append(parsedRules, parsedRule)
+			parsedRules = append(parsedRules, SchemaToVulnerabilityImageRule(val.(map[string]interface{})))
 		}
 	}
 	return parsedRules, nil
 }
 
+func SchemaToVulnerabilityImageRule(presentRule map[string]interface{}) policy.VulnerabilityImageRule {
+	parsedRule := policy.VulnerabilityImageRule{}
+
+	if present := presentRule["alert_threshold"].([]interface{}); len(present) > 0 && present[0] != nil {
+		presentAlertThreshold := present[0].(map[string]interface{})
+		parsedRule.AlertThreshold = policy.VulnerabilityImageThreshold{
+			Disabled: presentAlertThreshold["disabled"].(bool),
+			Value:    presentAlertThreshold["value"].(int),
+		}
+	} else {
+		parsedRule.AlertThreshold = policy.VulnerabilityImageThreshold{}
+	}
+
+	parsedRule.BlockMessage = presentRule["block_message"].(string)
+
+	if present := presentRule["block_threshold"].([]interface{}); len(present) > 0 && present[0] != nil {
+		presentBlockThreshold := present[0].(map[string]interface{})
+		parsedRule.BlockThreshold = policy.VulnerabilityImageThreshold{
+			Enabled: presentBlockThreshold["enabled"].(bool),
+			Value:   presentBlockThreshold["value"].(int),
+		}
+	} else {
+		parsedRule.BlockThreshold = policy.VulnerabilityImageThreshold{}
+	}
+
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+
+	parsedRule.CveRules = schemaToVulnerabilityImageCveRules(presentRule["cve_rule"].([]interface{}))
+
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+
+	if len(presentRule["grace_days_policy"].([]interface{})) > 0 && presentRule["grace_days_policy"].([]interface{})[0] != nil {
+		presentGraceDaysPolicy := presentRule["grace_days_policy"].([]interface{})[0].(map[string]interface{})
+		parsedRule.GraceDaysPolicy = policy.VulnerabilityImageGraceDaysPolicy{
+			Enabled:  true,
+			Low:      presentGraceDaysPolicy["low"].(int),
+			Medium:   presentGraceDaysPolicy["medium"].(int),
+			High:     presentGraceDaysPolicy["high"].(int),
+			Critical: presentGraceDaysPolicy["critical"].(int),
+		}
+		parsedRule.GraceDays = 0
+	} else {
+		parsedRule.GraceDays = presentRule["grace_days"].(int)
+	}
+
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.OnlyFixed = presentRule["only_fixed"].(bool)
+
+	parsedRule.TagRules = schemaToVulnerabilityImageTagRules(presentRule["tag_rule"].([]interface{}))
+
+	parsedRule.Verbose = presentRule["verbose"].(bool)
+
+	return parsedRule
+}
+
+func schemaToVulnerabilityImageCveRules(in []interface{}) []policy.VulnerabilityImageCveRule {
+	parsedCveRules := make([]policy.VulnerabilityImageCveRule, 0, len(in))
+	for _, val := range in {
+		presentCveRule := val.(map[string]interface{})
+		parsedCveRules = append(parsedCveRules, policy.VulnerabilityImageCveRule{
+			Description: presentCveRule["description"].(string),
+			Effect:      presentCveRule["effect"].(string),
+			Expiration:  schemaToVulnerabilityImageExpiration(presentCveRule["expiration"].([]interface{})),
+			Id:          presentCveRule["id"].(string),
+		})
+	}
+	return parsedCveRules
+}
+
+func schemaToVulnerabilityImageTagRules(in []interface{}) []policy.VulnerabilityImageTagRule {
+	parsedTagRules := make([]policy.VulnerabilityImageTagRule, 0, len(in))
+	for _, val := range in {
+		presentTagRule := val.(map[string]interface{})
+		parsedTagRules = append(parsedTagRules, policy.VulnerabilityImageTagRule{
+			Description: presentTagRule["description"].(string),
+			Effect:      presentTagRule["effect"].(string),
+			Expiration:  schemaToVulnerabilityImageExpiration(presentTagRule["expiration"].([]interface{})),
+			Name:        presentTagRule["name"].(string),
+		})
+	}
+	return parsedTagRules
+}
+
 func schemaToVulnerabilityImageExpiration(in []interface{}) policy.VulnerabilityImageExpiration {
 	parsedExpiration := policy.VulnerabilityImageExpiration{}
 	if len(in) == 0 {
@@ -106,26 +115,30 @@ func schemaToVulnerabilityImageExpiration(in []interface{}) policy.Vulnerability
 func VulnerabilityImageRulesToSchema(in []policy.VulnerabilityImageRule) []interface{} {
 	ans := make([]interface{}, 0, len(in))
 	for _, val := range in {
-		m := make(map[string]interface{})
-		m["alert_threshold"] = vulnerabilityImageAlertThresholdToSchema(val.AlertThreshold)
-		m["block_message"] = val.BlockMessage
-		m["block_threshold"] = vulnerabilityImageBlockThresholdToSchema(val.BlockThreshold)
-		m["collections"] = CollectionsToPolicySchema(val.Collections)
-		m["cve_rule"] = vulnerabilityImageCveRulesToSchema(val.CveRules)
-		m["disabled"] = val.Disabled
-		m["effect"] = val.Effect
-		m["grace_days"] = val.GraceDays
-		m["grace_days_policy"] = vulnerabilityImageGraceDaysPolicyToSchema(val.GraceDaysPolicy)
-		m["name"] = val.Name
-		m["notes"] = val.Notes
-		m["only_fixed"] = val.OnlyFixed
-		m["tag_rule"] = vulnerabilityImageTagRulesToSchema(val.TagRules)
-		m["verbose"] = val.Verbose
-		ans = append(ans, m)
+		ans = append(ans, VulnerabilityImageRuleToSchema(val))
 	}
 	return ans
 }
 
+func VulnerabilityImageRuleToSchema(val policy.VulnerabilityImageRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["alert_threshold"] = vulnerabilityImageAlertThresholdToSchema(val.AlertThreshold)
+	m["block_message"] = val.BlockMessage
+	m["block_threshold"] = vulnerabilityImageBlockThresholdToSchema(val.BlockThreshold)
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["cve_rule"] = vulnerabilityImageCveRulesToSchema(val.CveRules)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["grace_days"] = val.GraceDays
+	m["grace_days_policy"] = vulnerabilityImageGraceDaysPolicyToSchema(val.GraceDaysPolicy)
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["only_fixed"] = val.OnlyFixed
+	m["tag_rule"] = vulnerabilityImageTagRulesToSchema(val.TagRules)
+	m["verbose"] = val.Verbose
+	return m
+}
+
 func vulnerabilityImageAlertThresholdToSchema(in policy.VulnerabilityImageThreshold) []interface{} {
 	ans := make([]interface{}, 0, 1)
 	m := make(map[string]interface{})
@@ -0,0 +1,75 @@
+package convert
+
+import (
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SchemaToVulnerabilityServerlessRules(d *schema.ResourceData) ([]policy.VulnerabilityServerlessRule, error) {
+	parsedRules := make([]policy.VulnerabilityServerlessRule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			parsedRules = append(parsedRules, SchemaToVulnerabilityServerlessRule(val.(map[string]interface{})))
+		}
+	}
+	return parsedRules, nil
+}
+
+func SchemaToVulnerabilityServerlessRule(presentRule map[string]interface{}) policy.VulnerabilityServerlessRule {
+	parsedRule := policy.VulnerabilityServerlessRule{}
+
+	if present := presentRule["alert_threshold"].([]interface{}); len(present) > 0 && present[0] != nil {
+		presentAlertThreshold := present[0].(map[string]interface{})
+		parsedRule.AlertThreshold = policy.VulnerabilityImageThreshold{
+			Disabled: presentAlertThreshold["disabled"].(bool),
+			Value:    presentAlertThreshold["value"].(int),
+		}
+	}
+
+	if present := presentRule["block_threshold"].([]interface{}); len(present) > 0 && present[0] != nil {
+		presentBlockThreshold := present[0].(map[string]interface{})
+		parsedRule.BlockThreshold = policy.VulnerabilityImageThreshold{
+			Enabled: presentBlockThreshold["enabled"].(bool),
+			Value:   presentBlockThreshold["value"].(int),
+		}
+	}
+
+	parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+	parsedRule.CveRules = schemaToVulnerabilityImageCveRules(presentRule["cve_rule"].([]interface{}))
+	parsedRule.Disabled = presentRule["disabled"].(bool)
+	parsedRule.Effect = presentRule["effect"].(string)
+	parsedRule.GraceDays = presentRule["grace_days"].(int)
+	parsedRule.Name = presentRule["name"].(string)
+	parsedRule.Notes = presentRule["notes"].(string)
+	parsedRule.OnlyFixed = presentRule["only_fixed"].(bool)
+	parsedRule.TagRules = schemaToVulnerabilityImageTagRules(presentRule["tag_rule"].([]interface{}))
+	parsedRule.Verbose = presentRule["verbose"].(bool)
+
+	return parsedRule
+}
+
+func VulnerabilityServerlessRulesToSchema(in []policy.VulnerabilityServerlessRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		ans = append(ans, VulnerabilityServerlessRuleToSchema(val))
+	}
+	return ans
+}
+
+func VulnerabilityServerlessRuleToSchema(val policy.VulnerabilityServerlessRule) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["alert_threshold"] = vulnerabilityImageAlertThresholdToSchema(val.AlertThreshold)
+	m["block_threshold"] = vulnerabilityImageBlockThresholdToSchema(val.BlockThreshold)
+	m["collections"] = CollectionsToPolicySchema(val.Collections)
+	m["cve_rule"] = vulnerabilityImageCveRulesToSchema(val.CveRules)
+	m["disabled"] = val.Disabled
+	m["effect"] = val.Effect
+	m["grace_days"] = val.GraceDays
+	m["name"] = val.Name
+	m["notes"] = val.Notes
+	m["only_fixed"] = val.OnlyFixed
+	m["tag_rule"] = vulnerabilityImageTagRulesToSchema(val.TagRules)
+	m["verbose"] = val.Verbose
+	return m
+}
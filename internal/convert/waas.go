@@ -0,0 +1,434 @@
+package convert
+
+import (
+	"reflect"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Blocks of an application that map to a WAAS protection.
+var waasProtections = []string{
+	"attack_tools",
+	"code_injection",
+	"command_injection",
+	"local_file_inclusion",
+	"malformed_request",
+	"shellshock",
+	"sql_injection",
+	"xss",
+}
+
+func SchemaToWaasRules(d *schema.ResourceData) ([]waas.Rule, error) {
+	parsedRules := make([]waas.Rule, 0)
+	if rules, ok := d.GetOk("rule"); ok {
+		presentRules := rules.([]interface{})
+		for _, val := range presentRules {
+			presentRule := val.(map[string]interface{})
+			parsedRule := waas.Rule{}
+
+			parsedRule.AllowMalformedHttpHeaderNames = presentRule["allow_malformed_http_header_names"].(bool)
+			parsedRule.Applications = schemaToWaasApplications(presentRule["application"].([]interface{}))
+			parsedRule.Collections = PolicySchemaToCollections(presentRule["collections"].([]interface{}))
+			parsedRule.Disabled = presentRule["disabled"].(bool)
+			parsedRule.Name = presentRule["name"].(string)
+			parsedRule.Notes = presentRule["notes"].(string)
+			parsedRule.ReadTimeoutSeconds = presentRule["read_timeout_seconds"].(int)
+			parsedRule.SkipApiLearning = presentRule["skip_api_learning"].(bool)
+
+			parsedRules = append(parsedRules, parsedRule)
+		}
+	}
+	return parsedRules, nil
+}
+
+func schemaToWaasApplications(in []interface{}) []waas.Application {
+	parsedApplications := make([]waas.Application, 0, len(in))
+	for _, val := range in {
+		presentApplication := val.(map[string]interface{})
+		protections := make(map[string]waas.Protection, len(waasProtections))
+		for _, key := range waasProtections {
+			protections[key] = schemaToWaasProtection(presentApplication[key].([]interface{}))
+		}
+		parsedApplications = append(parsedApplications, waas.Application{
+			ApiSpec:             schemaToWaasApiSpec(presentApplication["api_protection"].([]interface{})),
+			AppId:               presentApplication["app_id"].(string),
+			AttackTools:         protections["attack_tools"],
+			BotProtection:       schemaToWaasBotProtection(presentApplication["bot_protection"].([]interface{})),
+			ClickjackingEnabled: presentApplication["clickjacking_enabled"].(bool),
+			CodeInjection:       protections["code_injection"],
+			CommandInjection:    protections["command_injection"],
+			CsrfEnabled:         presentApplication["csrf_enabled"].(bool),
+			CustomRules:         schemaToWaasCustomRules(presentApplication["custom_rule"].([]interface{})),
+			IntelGathering:      schemaToWaasIntelGathering(presentApplication["intel_gathering"].([]interface{})),
+			LocalFileInclusion:  protections["local_file_inclusion"],
+			MalformedRequest:    protections["malformed_request"],
+			Shellshock:          protections["shellshock"],
+			SqlInjection:        protections["sql_injection"],
+			Xss:                 protections["xss"],
+		})
+	}
+	return parsedApplications
+}
+
+func schemaToWaasApiSpec(in []interface{}) waas.ApiSpec {
+	if len(in) == 0 || in[0] == nil {
+		return waas.ApiSpec{}
+	}
+	presentApiSpec := in[0].(map[string]interface{})
+
+	parsedEndpoints := make([]waas.Endpoint, 0)
+	for _, val := range presentApiSpec["endpoint"].([]interface{}) {
+		presentEndpoint := val.(map[string]interface{})
+		parsedEndpoints = append(parsedEndpoints, waas.Endpoint{
+			BasePath:     presentEndpoint["base_path"].(string),
+			ExposedPort:  presentEndpoint["exposed_port"].(int),
+			Grpc:         presentEndpoint["grpc"].(bool),
+			Host:         presentEndpoint["host"].(string),
+			Http2:        presentEndpoint["http2"].(bool),
+			InternalPort: presentEndpoint["internal_port"].(int),
+			Tls:          presentEndpoint["tls"].(bool),
+		})
+	}
+
+	parsedPaths := make([]waas.Path, 0)
+	for _, val := range presentApiSpec["path"].([]interface{}) {
+		presentPath := val.(map[string]interface{})
+		parsedMethods := make([]waas.Method, 0)
+		for _, method := range SchemaToStringSlice(presentPath["methods"].([]interface{})) {
+			parsedMethods = append(parsedMethods, waas.Method{Method: method})
+		}
+		parsedPaths = append(parsedPaths, waas.Path{
+			Methods: parsedMethods,
+			Path:    presentPath["path"].(string),
+		})
+	}
+
+	return waas.ApiSpec{
+		Description:              presentApiSpec["description"].(string),
+		Effect:                   presentApiSpec["effect"].(string),
+		Endpoints:                parsedEndpoints,
+		FallbackEffect:           presentApiSpec["fallback_effect"].(string),
+		Paths:                    parsedPaths,
+		QueryParamFallbackEffect: presentApiSpec["query_param_fallback_effect"].(string),
+		SkipLearning:             presentApiSpec["skip_learning"].(bool),
+	}
+}
+
+func schemaToWaasProtection(in []interface{}) waas.Protection {
+	if len(in) == 0 || in[0] == nil {
+		return waas.Protection{}
+	}
+	presentProtection := in[0].(map[string]interface{})
+	parsedExceptionFields := make([]waas.ExceptionField, 0)
+	for _, val := range presentProtection["exception_field"].([]interface{}) {
+		presentExceptionField := val.(map[string]interface{})
+		parsedExceptionFields = append(parsedExceptionFields, waas.ExceptionField{
+			Key:      presentExceptionField["key"].(string),
+			Location: presentExceptionField["location"].(string),
+		})
+	}
+	return waas.Protection{
+		Effect:          presentProtection["effect"].(string),
+		ExceptionFields: parsedExceptionFields,
+	}
+}
+
+func schemaToWaasIntelGathering(in []interface{}) waas.IntelGathering {
+	if len(in) == 0 || in[0] == nil {
+		return waas.IntelGathering{}
+	}
+	presentIntelGathering := in[0].(map[string]interface{})
+	return waas.IntelGathering{
+		InfoLeakageEffect:         presentIntelGathering["info_leakage_effect"].(string),
+		RemoveFingerprintsEnabled: presentIntelGathering["remove_fingerprints"].(bool),
+	}
+}
+
+func schemaToWaasBotProtection(in []interface{}) waas.BotProtection {
+	if len(in) == 0 || in[0] == nil {
+		return waas.BotProtection{}
+	}
+	presentBotProtection := in[0].(map[string]interface{})
+	parsedBotProtection := waas.BotProtection{
+		InterstitialPage:  presentBotProtection["interstitial_page"].(bool),
+		SessionValidation: presentBotProtection["session_validation"].(string),
+		UserDefinedBots:   make([]waas.UserDefinedBot, 0),
+	}
+
+	if jsInjection := presentBotProtection["js_injection"].([]interface{}); len(jsInjection) > 0 && jsInjection[0] != nil {
+		presentJsInjection := jsInjection[0].(map[string]interface{})
+		parsedBotProtection.JsInjection = waas.JsInjection{
+			Enabled:       presentJsInjection["enabled"].(bool),
+			TimeoutEffect: presentJsInjection["timeout_effect"].(string),
+		}
+	}
+
+	if knownBots := presentBotProtection["known_bots"].([]interface{}); len(knownBots) > 0 && knownBots[0] != nil {
+		presentKnownBots := knownBots[0].(map[string]interface{})
+		parsedBotProtection.KnownBots = waas.KnownBots{
+			Archiving:            presentKnownBots["archiving"].(string),
+			BusinessAnalytics:    presentKnownBots["business_analytics"].(string),
+			CareerSearch:         presentKnownBots["career_search"].(string),
+			ContentFeedClients:   presentKnownBots["content_feed_clients"].(string),
+			Educational:          presentKnownBots["educational"].(string),
+			Financial:            presentKnownBots["financial"].(string),
+			MediaSearch:          presentKnownBots["media_search"].(string),
+			News:                 presentKnownBots["news"].(string),
+			SearchEngineCrawlers: presentKnownBots["search_engine_crawlers"].(string),
+		}
+	}
+
+	if unknownBots := presentBotProtection["unknown_bots"].([]interface{}); len(unknownBots) > 0 && unknownBots[0] != nil {
+		presentUnknownBots := unknownBots[0].(map[string]interface{})
+		parsedBotProtection.UnknownBots = waas.UnknownBots{
+			ApiLibraries:         presentUnknownBots["api_libraries"].(string),
+			BotImpersonation:     presentUnknownBots["bot_impersonation"].(string),
+			BrowserImpersonation: presentUnknownBots["browser_impersonation"].(string),
+			Generic:              presentUnknownBots["generic"].(string),
+			HeadlessBrowsers:     presentUnknownBots["headless_browsers"].(string),
+			HttpLibraries:        presentUnknownBots["http_libraries"].(string),
+			WebAutomationTools:   presentUnknownBots["web_automation_tools"].(string),
+			WebScrapers:          presentUnknownBots["web_scrapers"].(string),
+		}
+		if requestAnomalies := presentUnknownBots["request_anomalies"].([]interface{}); len(requestAnomalies) > 0 && requestAnomalies[0] != nil {
+			presentRequestAnomalies := requestAnomalies[0].(map[string]interface{})
+			parsedBotProtection.UnknownBots.RequestAnomalies = waas.RequestAnomalies{
+				Effect:    presentRequestAnomalies["effect"].(string),
+				Threshold: presentRequestAnomalies["threshold"].(int),
+			}
+		}
+	}
+
+	for _, val := range presentBotProtection["user_defined_bot"].([]interface{}) {
+		presentUserDefinedBot := val.(map[string]interface{})
+		parsedBotProtection.UserDefinedBots = append(parsedBotProtection.UserDefinedBots, waas.UserDefinedBot{
+			Effect:       presentUserDefinedBot["effect"].(string),
+			HeaderName:   presentUserDefinedBot["header_name"].(string),
+			HeaderValues: SchemaToStringSlice(presentUserDefinedBot["header_values"].([]interface{})),
+			Name:         presentUserDefinedBot["name"].(string),
+			Subnets:      SchemaToStringSlice(presentUserDefinedBot["subnets"].([]interface{})),
+		})
+	}
+
+	return parsedBotProtection
+}
+
+func schemaToWaasCustomRules(in []interface{}) []waas.CustomRule {
+	parsedCustomRules := make([]waas.CustomRule, 0, len(in))
+	for _, val := range in {
+		presentCustomRule := val.(map[string]interface{})
+		parsedCustomRules = append(parsedCustomRules, waas.CustomRule{
+			Action: presentCustomRule["action"].(string),
+			Effect: presentCustomRule["effect"].(string),
+			Id:     presentCustomRule["id"].(int),
+		})
+	}
+	return parsedCustomRules
+}
+
+func WaasRulesToSchema(in []waas.Rule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		m := make(map[string]interface{})
+		m["allow_malformed_http_header_names"] = val.AllowMalformedHttpHeaderNames
+		m["application"] = waasApplicationsToSchema(val.Applications)
+		m["collections"] = CollectionsToPolicySchema(val.Collections)
+		m["disabled"] = val.Disabled
+		m["name"] = val.Name
+		m["notes"] = val.Notes
+		m["read_timeout_seconds"] = val.ReadTimeoutSeconds
+		m["skip_api_learning"] = val.SkipApiLearning
+		ans = append(ans, m)
+	}
+	return ans
+}
+
+func waasApplicationsToSchema(in []waas.Application) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		m := make(map[string]interface{})
+		m["api_protection"] = waasApiSpecToSchema(val.ApiSpec)
+		m["app_id"] = val.AppId
+		m["attack_tools"] = waasProtectionToSchema(val.AttackTools)
+		m["bot_protection"] = waasBotProtectionToSchema(val.BotProtection)
+		m["clickjacking_enabled"] = val.ClickjackingEnabled
+		m["code_injection"] = waasProtectionToSchema(val.CodeInjection)
+		m["command_injection"] = waasProtectionToSchema(val.CommandInjection)
+		m["csrf_enabled"] = val.CsrfEnabled
+		m["custom_rule"] = waasCustomRulesToSchema(val.CustomRules)
+		m["intel_gathering"] = waasIntelGatheringToSchema(val.IntelGathering)
+		m["local_file_inclusion"] = waasProtectionToSchema(val.LocalFileInclusion)
+		m["malformed_request"] = waasProtectionToSchema(val.MalformedRequest)
+		m["shellshock"] = waasProtectionToSchema(val.Shellshock)
+		m["sql_injection"] = waasProtectionToSchema(val.SqlInjection)
+		m["xss"] = waasProtectionToSchema(val.Xss)
+		ans = append(ans, m)
+	}
+	return ans
+}
+
+// Sections the Console leaves empty are not returned as blocks, so that
+// configurations that omit them do not show a difference.
+func isEmptyWaasSection(in interface{}) bool {
+	return reflect.ValueOf(in).IsZero()
+}
+
+func waasApiSpecToSchema(in waas.ApiSpec) []interface{} {
+	ans := make([]interface{}, 0, 1)
+	if isEmptyWaasSection(in) {
+		return ans
+	}
+
+	endpoints := make([]interface{}, 0, len(in.Endpoints))
+	for _, val := range in.Endpoints {
+		m := make(map[string]interface{})
+		m["base_path"] = val.BasePath
+		m["exposed_port"] = val.ExposedPort
+		m["grpc"] = val.Grpc
+		m["host"] = val.Host
+		m["http2"] = val.Http2
+		m["internal_port"] = val.InternalPort
+		m["tls"] = val.Tls
+		endpoints = append(endpoints, m)
+	}
+
+	paths := make([]interface{}, 0, len(in.Paths))
+	for _, val := range in.Paths {
+		methods := make([]string, 0, len(val.Methods))
+		for _, method := range val.Methods {
+			methods = append(methods, method.Method)
+		}
+		m := make(map[string]interface{})
+		m["methods"] = methods
+		m["path"] = val.Path
+		paths = append(paths, m)
+	}
+
+	m := make(map[string]interface{})
+	m["description"] = in.Description
+	m["effect"] = in.Effect
+	m["endpoint"] = endpoints
+	m["fallback_effect"] = in.FallbackEffect
+	m["path"] = paths
+	m["query_param_fallback_effect"] = in.QueryParamFallbackEffect
+	m["skip_learning"] = in.SkipLearning
+	ans = append(ans, m)
+	return ans
+}
+
+func waasProtectionToSchema(in waas.Protection) []interface{} {
+	ans := make([]interface{}, 0, 1)
+	if isEmptyWaasSection(in) {
+		return ans
+	}
+	exceptionFields := make([]interface{}, 0, len(in.ExceptionFields))
+	for _, val := range in.ExceptionFields {
+		m := make(map[string]interface{})
+		m["key"] = val.Key
+		m["location"] = val.Location
+		exceptionFields = append(exceptionFields, m)
+	}
+	m := make(map[string]interface{})
+	m["effect"] = in.Effect
+	m["exception_field"] = exceptionFields
+	ans = append(ans, m)
+	return ans
+}
+
+func waasIntelGatheringToSchema(in waas.IntelGathering) []interface{} {
+	ans := make([]interface{}, 0, 1)
+	if isEmptyWaasSection(in) {
+		return ans
+	}
+	m := make(map[string]interface{})
+	m["info_leakage_effect"] = in.InfoLeakageEffect
+	m["remove_fingerprints"] = in.RemoveFingerprintsEnabled
+	ans = append(ans, m)
+	return ans
+}
+
+func waasBotProtectionToSchema(in waas.BotProtection) []interface{} {
+	ans := make([]interface{}, 0, 1)
+	if isEmptyWaasSection(in) {
+		return ans
+	}
+
+	jsInjection := make([]interface{}, 0, 1)
+	if !isEmptyWaasSection(in.JsInjection) {
+		m := make(map[string]interface{})
+		m["enabled"] = in.JsInjection.Enabled
+		m["timeout_effect"] = in.JsInjection.TimeoutEffect
+		jsInjection = append(jsInjection, m)
+	}
+
+	knownBots := make([]interface{}, 0, 1)
+	if !isEmptyWaasSection(in.KnownBots) {
+		m := make(map[string]interface{})
+		m["archiving"] = in.KnownBots.Archiving
+		m["business_analytics"] = in.KnownBots.BusinessAnalytics
+		m["career_search"] = in.KnownBots.CareerSearch
+		m["content_feed_clients"] = in.KnownBots.ContentFeedClients
+		m["educational"] = in.KnownBots.Educational
+		m["financial"] = in.KnownBots.Financial
+		m["media_search"] = in.KnownBots.MediaSearch
+		m["news"] = in.KnownBots.News
+		m["search_engine_crawlers"] = in.KnownBots.SearchEngineCrawlers
+		knownBots = append(knownBots, m)
+	}
+
+	unknownBots := make([]interface{}, 0, 1)
+	if !isEmptyWaasSection(in.UnknownBots) {
+		requestAnomalies := make([]interface{}, 0, 1)
+		if !isEmptyWaasSection(in.UnknownBots.RequestAnomalies) {
+			m := make(map[string]interface{})
+			m["effect"] = in.UnknownBots.RequestAnomalies.Effect
+			m["threshold"] = in.UnknownBots.RequestAnomalies.Threshold
+			requestAnomalies = append(requestAnomalies, m)
+		}
+		m := make(map[string]interface{})
+		m["api_libraries"] = in.UnknownBots.ApiLibraries
+		m["bot_impersonation"] = in.UnknownBots.BotImpersonation
+		m["browser_impersonation"] = in.UnknownBots.BrowserImpersonation
+		m["generic"] = in.UnknownBots.Generic
+		m["headless_browsers"] = in.UnknownBots.HeadlessBrowsers
+		m["http_libraries"] = in.UnknownBots.HttpLibraries
+		m["request_anomalies"] = requestAnomalies
+		m["web_automation_tools"] = in.UnknownBots.WebAutomationTools
+		m["web_scrapers"] = in.UnknownBots.WebScrapers
+		unknownBots = append(unknownBots, m)
+	}
+
+	userDefinedBots := make([]interface{}, 0, len(in.UserDefinedBots))
+	for _, val := range in.UserDefinedBots {
+		m := make(map[string]interface{})
+		m["effect"] = val.Effect
+		m["header_name"] = val.HeaderName
+		m["header_values"] = val.HeaderValues
+		m["name"] = val.Name
+		m["subnets"] = val.Subnets
+		userDefinedBots = append(userDefinedBots, m)
+	}
+
+	m := make(map[string]interface{})
+	m["interstitial_page"] = in.InterstitialPage
+	m["js_injection"] = jsInjection
+	m["known_bots"] = knownBots
+	m["session_validation"] = in.SessionValidation
+	m["unknown_bots"] = unknownBots
+	m["user_defined_bot"] = userDefinedBots
+	ans = append(ans, m)
+	return ans
+}
+
+func waasCustomRulesToSchema(in []waas.CustomRule) []interface{} {
+	ans := make([]interface{}, 0, len(in))
+	for _, val := range in {
+		m := make(map[string]interface{})
+		m["action"] = val.Action
+		m["effect"] = val.Effect
+		m["id"] = val.Id
+		ans = append(ans, m)
+	}
+	return ans
+}
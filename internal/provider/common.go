@@ -1,17 +1,239 @@
 package provider
 
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Every resource operation is a handful of API calls, so a single default
+// covers them. The timeouts can be raised per resource for slow Consoles.
+const defaultTimeout = 10 * time.Minute
+
 const (
-	policyTypeAdmission               = "admission"
-	policyTypeComplianceCiImage       = "ciImagesCompliance"
-	policyTypeComplianceCoderepo      = "codeRepoCompliance"
-	policyTypeComplianceCiCoderepo    = "ciCodeRepoCompliance"
-	policyTypeComplianceContainer     = "containerCompliance"
-	policyTypeComplianceHost          = "hostCompliance"
-	policyTypeRuntimeContainer        = "containerRuntime"
-	policyTypeRuntimeHost             = "hostRuntime"
-	policyTypeVulnerabilityCiCoderepo = "ciCodeRepoVulnerability"
-	policyTypeVulnerabilityCiImage    = "ciImagesVulnerability"
-	policyTypeVulnerabilityCoderepo   = "codeRepoVulnerability"
-	policyTypeVulnerabilityHost       = "hostVulnerability"
-	policyTypeVulnerabilityImage      = "containerVulnerability"
+	policyTypeAdmission                 = "admission"
+	policyTypeCnns                      = "cnns"
+	policyTypeComplianceCiImage         = "ciImagesCompliance"
+	policyTypeComplianceCoderepo        = "codeRepoCompliance"
+	policyTypeComplianceCiCoderepo      = "ciCodeRepoCompliance"
+	policyTypeComplianceContainer       = "containerCompliance"
+	policyTypeComplianceHost            = "hostCompliance"
+	policyTypeComplianceServerless      = "serverlessCompliance"
+	policyTypeComplianceCiServerless    = "ciServerlessCompliance"
+	policyTypeTrust                     = "trust"
+	policyTypeRuntimeContainer          = "containerRuntime"
+	policyTypeRuntimeHost               = "hostRuntime"
+	policyTypeRuntimeServerless         = "serverlessRuntime"
+	policyTypeRuntimeAppEmbedded        = "appEmbeddedRuntime"
+	policyTypeVulnerabilityCiCoderepo   = "ciCodeRepoVulnerability"
+	policyTypeVulnerabilityCiImage      = "ciImagesVulnerability"
+	policyTypeVulnerabilityCoderepo     = "codeRepoVulnerability"
+	policyTypeVulnerabilityHost         = "hostVulnerability"
+	policyTypeVulnerabilityServerless   = "serverlessVulnerability"
+	policyTypeVulnerabilityCiServerless = "ciServerlessVulnerability"
+	policyTypeVulnerabilityImage        = "containerVulnerability"
+	policyTypeWaasContainer             = "containerAppFirewall"
+	policyTypeWaasHost                  = "hostAppFirewall"
+	policyTypeWaasAppEmbedded           = "appEmbeddedAppFirewall"
+	policyTypeWaasServerless            = "serverlessAppFirewall"
+	policyTypeWaasOutOfBand             = "outOfBandAppFirewall"
 )
+
+func defaultTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultTimeout),
+		Read:   schema.DefaultTimeout(defaultTimeout),
+		Update: schema.DefaultTimeout(defaultTimeout),
+		Delete: schema.DefaultTimeout(defaultTimeout),
+	}
+}
+
+// Remove a resource that was deleted outside of Terraform from the state, so
+// that it is planned for creation instead of failing the refresh. Reports
+// whether the resource was removed.
+func removeIfNotFound(ctx context.Context, d *schema.ResourceData, err error) bool {
+	if !api.IsNotFound(err) || d.IsNewResource() {
+		return false
+	}
+	tflog.Warn(ctx, "Resource no longer exists on the Console, removing it from the state", "id", d.Id())
+	d.SetId("")
+	return true
+}
+
+// Get the schema of the digest of a document shared by several resources, or
+// of the part of it managed by a resource, as it was last read.
+func digestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Digest of what the resource manages as last read from the Console. Updates fail instead of overwriting changes made outside of Terraform since then.",
+	}
+}
+
+// Record the digest of the document, or of the part of it managed by the
+// resource, that was just read.
+func setDigest(d *schema.ResourceData, doc interface{}) error {
+	digest, err := api.Digest(doc)
+	if err != nil {
+		return err
+	}
+	return d.Set("digest", digest)
+}
+
+// Get a context in which the update of a shared document fails with a
+// conflict error if the document, or the part of it managed by the resource,
+// changed since it was last read, such as between plan and apply.
+func withDigest(ctx context.Context, d *schema.ResourceData) context.Context {
+	return api.WithDigest(ctx, d.Get("digest").(string))
+}
+
+// Get the schema of a data source that reads the same object as a resource,
+// with every attribute computed. The ID and the given attributes, such as
+// write-only secrets, are left out.
+func dataSourceSchema(resourceSchema map[string]*schema.Schema, exclude ...string) map[string]*schema.Schema {
+	ans := make(map[string]*schema.Schema, len(resourceSchema))
+	for key, val := range resourceSchema {
+		if key == "id" || contains(exclude, key) {
+			continue
+		}
+		ans[key] = computedSchema(val)
+	}
+	return ans
+}
+
+func computedSchema(in *schema.Schema) *schema.Schema {
+	ans := &schema.Schema{
+		Type:        in.Type,
+		Computed:    true,
+		Description: in.Description,
+		Sensitive:   in.Sensitive,
+	}
+	switch elem := in.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for key, val := range elem.Schema {
+			nested[key] = computedSchema(val)
+		}
+		ans.Elem = &schema.Resource{
+			Schema: nested,
+		}
+	default:
+		ans.Elem = elem
+	}
+	return ans
+}
+
+func contains(list []string, s string) bool {
+	for _, val := range list {
+		if val == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the name_regex argument of a data source that lists objects.
+func nameRegexSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  description,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// Get the ID of a data source that lists objects, which depends on the
+// filter so that data sources with different filters are told apart.
+func listingId(d *schema.ResourceData) string {
+	if val := d.Get("name_regex").(string); val != "" {
+		return val
+	}
+	return "all"
+}
+
+// Get the schema of the given attributes of a container runtime rule, for
+// runtime policies whose rules have the same sections.
+func runtimeContainerRuleSchema(keys ...string) map[string]*schema.Schema {
+	containerRule := resourcePoliciesRuntimeContainer().Schema["rule"].Elem.(*schema.Resource).Schema
+	ans := make(map[string]*schema.Schema, len(keys))
+	for _, key := range keys {
+		ans[key] = containerRule[key]
+	}
+	return ans
+}
+
+// Get the schema of a resource that manages a single rule of a policy from the
+// rule schema of the resource that manages the whole policy. The rule is
+// identified by its name, and can be put anywhere among the other rules.
+func policyRuleSchema(policyResource *schema.Resource) map[string]*schema.Schema {
+	ans := policyResource.Schema["rule"].Elem.(*schema.Resource).Schema
+	ans["name"].Optional = false
+	ans["name"].Required = true
+	ans["name"].ForceNew = true
+	ans["position"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Where to put the rule among the other rules of the policy. Can be set to `top`, `bottom`, or `before` or `after` the rule named in `relative_to`. " +
+			"A new rule is put at the top by default. An existing rule is only moved when this or `relative_to` changes.",
+		ValidateFunc: validation.StringInSlice([]string{
+			policy.RulePositionTop,
+			policy.RulePositionBottom,
+			policy.RulePositionBefore,
+			policy.RulePositionAfter,
+		}, false),
+	}
+	ans["relative_to"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Name of the rule to put the rule before or after. Required if `position` is `before` or `after`.",
+	}
+	ans["digest"] = digestSchema()
+	return ans
+}
+
+// Get the attributes of a policy rule resource as a rule of the whole policy.
+func policyRuleFromSchema(d *schema.ResourceData, ruleSchema map[string]*schema.Schema) map[string]interface{} {
+	ans := make(map[string]interface{}, len(ruleSchema))
+	for key := range ruleSchema {
+		ans[key] = d.Get(key)
+	}
+	return ans
+}
+
+// Set the attributes of a policy rule resource from a rule of the whole policy.
+func policyRuleToSchema(d *schema.ResourceData, rule map[string]interface{}) error {
+	for key, val := range rule {
+		if err := d.Set(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get where to put a policy rule. On update, the rule stays where it is unless
+// its position changed, so that rules moved on the Console are not fought over.
+func policyRulePosition(d *schema.ResourceData) (policy.RulePosition, error) {
+	var ans policy.RulePosition
+	if !d.IsNewResource() && !d.HasChanges("position", "relative_to") {
+		return ans, nil
+	}
+	ans.Position = d.Get("position").(string)
+	ans.RelativeTo = d.Get("relative_to").(string)
+	switch ans.Position {
+	case policy.RulePositionBefore, policy.RulePositionAfter:
+		if ans.RelativeTo == "" {
+			return ans, fmt.Errorf("relative_to must be set when position is %s", ans.Position)
+		}
+	default:
+		if ans.RelativeTo != "" {
+			return ans, fmt.Errorf("relative_to can only be set when position is %s or %s", policy.RulePositionBefore, policy.RulePositionAfter)
+		}
+	}
+	return ans, nil
+}
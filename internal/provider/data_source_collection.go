@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCollection() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to retrieve a collection by name.",
+		ReadContext: dataSourceCollectionRead,
+
+		Schema: collectionDataSourceSchema(),
+	}
+	ans.Schema["id"] = &schema.Schema{
+		Description: "The ID of the collection.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	ans.Schema["name"].Computed = false
+	ans.Schema["name"].Required = true
+	return ans
+}
+
+// Get the schema of a collection with every attribute of the collection
+// resource computed.
+func collectionDataSourceSchema() map[string]*schema.Schema {
+	ans := dataSourceSchema(resourceCollection().Schema)
+	for _, val := range ans {
+		val.Description = strings.TrimSuffix(val.Description, " Defaults to all (`*`).")
+	}
+	return ans
+}
+
+func dataSourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	retrievedCollection, err := collection.GetCollection(ctx, *client, d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+
+	for key, val := range convert.CollectionToSchema(*retrievedCollection) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading collection: %s", err)
+		}
+	}
+	d.SetId(retrievedCollection.Name)
+
+	return nil
+}
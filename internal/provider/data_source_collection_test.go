@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsCollection(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsCollectionConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.test", "name", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.test", "description", "web servers"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.test", "images.0", "nginx:*"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.test", "hosts.0", "*"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.test", "system", "false"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collection.all", "system", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsCollectionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_collection" "test" {
+	name        = %q
+	description = "web servers"
+	images      = ["nginx:*"]
+}
+
+data "prismacloudcompute_collection" "test" {
+	name = prismacloudcompute_collection.test.name
+}
+
+data "prismacloudcompute_collection" "all" {
+	name = "All"
+}
+`, name)
+}
@@ -1,160 +1,72 @@
 package provider
 
-// import (
-// 	"bytes"
-// 	"encoding/base64"
-// 	"log"
+import (
+	"context"
+	"regexp"
 
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/client"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/collection"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-// )
+func dataSourceCollections() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve all collections, or the collections whose names match a regular expression.",
+		ReadContext: dataSourceCollectionsRead,
 
-// func dataSourceCollections() *schema.Resource {
-// 	return &schema.Resource{
-// 		Read: dataSourceCollectionsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the data source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"listing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching collections.",
+				Elem: &schema.Resource{
+					Schema: collectionDataSourceSchema(),
+				},
+			},
+			"name_regex": nameRegexSchema("Regular expression that the names of the collections must match."),
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching collections.",
+			},
+		},
+	}
+}
 
-// 		Schema: map[string]*schema.Schema{
+func dataSourceCollectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-// 			// Output.
-// 			"account_ids": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of account IDs.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"application_ids": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of application IDs.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"clusters": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of Kubernetes cluster names.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"code_repositories": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of code repositories.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"color": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "A hex color code for a collection.",
-// 			},
-// 			"containers": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of containers.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"description": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "A free-form text description of the collection.",
-// 			},
-// 			"functions": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of functions.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"hosts": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of hosts.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"images": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of images.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"labels": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of labels.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"name": {
-// 				Type:        schema.TypeString,
-// 				Required:    true,
-// 				Description: "Unique collection name.",
-// 			},
-// 			"namespaces": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of Kubernetes namespaces.",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 		},
-// 	}
-// }
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.Errorf("error reading collections: %s", err)
+	}
 
-// func dataSourceCollectionsRead(d *schema.ResourceData, meta interface{}) error {
-// 	var buf bytes.Buffer
-// 	client := meta.(*api.Client)
+	items, err := collection.ListCollections(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading collections: %s", err)
+	}
 
-// 	items, err := collection.List(*client)
-// 	if err != nil {
-// 		return err
-// 	}
+	list := make([]interface{}, 0, len(items))
+	for _, val := range items {
+		if nameRegex.MatchString(val.Name) {
+			list = append(list, convert.CollectionToSchema(val))
+		}
+	}
 
-// 	if buf.Len() == 0 {
-// 		d.SetId("all")
-// 	} else {
-// 		d.SetId(base64.StdEncoding.EncodeToString(buf.Bytes()))
-// 	}
-// 	d.Set("total", len(items))
+	if err := d.Set("listing", list); err != nil {
+		return diag.Errorf("error reading collections: %s", err)
+	}
+	if err := d.Set("total", len(list)); err != nil {
+		return diag.Errorf("error reading collections: %s", err)
+	}
+	d.SetId(listingId(d))
 
-// 	list := make([]interface{}, 0, len(items))
-// 	for _, i := range items {
-// 		list = append(list, map[string]interface{}{
-// 			"accountIDs":  stringSliceToSet(i.AccountIds),
-// 			"appIDs":      stringSliceToSet(i.AppIds),
-// 			"clusters":    stringSliceToSet(i.Clusters),
-// 			"codeRepos":   stringSliceToSet(i.CodeRepos),
-// 			"color":       i.Color,
-// 			"containers":  stringSliceToSet(i.Containers),
-// 			"description": i.Description,
-// 			"functions":   stringSliceToSet(i.Functions),
-// 			"hosts":       stringSliceToSet(i.Hosts),
-// 			"images":      stringSliceToSet(i.Images),
-// 			"labels":      stringSliceToSet(i.Labels),
-// 			"name":        i.Name,
-// 			"namespaces":  stringSliceToSet(i.Namespaces),
-// 		})
-// 	}
-
-// 	if err := d.Set("listing", list); err != nil {
-// 		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
-// 	}
-
-// 	return nil
-// }
+	return nil
+}
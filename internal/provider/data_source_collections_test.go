@@ -1,28 +1,52 @@
 package provider
 
-// import (
-// 	"testing"
+import (
+	"fmt"
+	"testing"
 
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// )
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
 
-// func TestAccDsCollections(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:  func() { testAccPreCheck(t) },
-// 		Providers: testAccProviders,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccDsCollectionsConfig(),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttrSet("data.prismacloudcompute_collections.test", "total"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
+func TestAccDsCollections(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
-// func testAccDsCollectionsConfig() string {
-// 	return `
-// data "prismacloudcompute_collections" "test" {}
-// `
-// }
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsCollectionsConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The built-in All collection and the one created here.
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "total", "2"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "listing.0.name", "All"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "listing.0.system", "true"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "listing.1.name", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "listing.1.images.0", "nginx:*"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.test", "id", "all"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.matching", "total", "1"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.matching", "listing.0.name", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_collections.matching", "id", "^"+name+"$"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsCollectionsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_collection" "test" {
+	name   = %q
+	images = ["nginx:*"]
+}
+
+data "prismacloudcompute_collections" "test" {
+	depends_on = [prismacloudcompute_collection.test]
+}
+
+data "prismacloudcompute_collections" "matching" {
+	name_regex = "^${prismacloudcompute_collection.test.name}$"
+}
+`, name)
+}
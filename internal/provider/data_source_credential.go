@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCredential() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to retrieve a credential by name.",
+		ReadContext: dataSourceCredentialRead,
+
+		Schema: dataSourceSchema(resourceCredentials().Schema, "api_token", "secret"),
+	}
+	ans.Schema["id"] = &schema.Schema{
+		Description: "The ID of the credential.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	ans.Schema["name"] = &schema.Schema{
+		Description: "Name of the credential.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	return ans
+}
+
+func dataSourceCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	retrievedCredential, err := auth.GetCredential(ctx, *client, d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error reading credential: %s", err)
+	}
+
+	for key, val := range convert.CredentialToSchema(*retrievedCredential) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading credential: %s", err)
+		}
+	}
+	d.SetId(retrievedCredential.Id)
+
+	return nil
+}
@@ -1,189 +1,72 @@
 package provider
 
-// import (
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/client"
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+import (
+	"context"
+	"regexp"
 
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-// )
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
-// func dataSourceCredentials() *schema.Resource {
-// 	return &schema.Resource{
-// 		Read: dataSourceCredentialsRead,
+func dataSourceCredentials() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve all credentials, or the credentials whose names match a regular expression.",
+		ReadContext: dataSourceCredentialsRead,
 
-// 		Schema: map[string]*schema.Schema{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the data source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"listing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching credentials.",
+				Elem: &schema.Resource{
+					Schema: dataSourceSchema(resourceCredentials().Schema, "api_token", "secret"),
+				},
+			},
+			"name_regex": nameRegexSchema("Regular expression that the names of the credentials must match."),
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching credentials.",
+			},
+		},
+	}
+}
 
-// 			// Output.
-// 			"id": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Computed:    true,
-// 				Description: "Unique ID for the credential.",
-// 			},
-// 			"accountguid": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Unique ID for an IBM Cloud account.",
-// 			},
-// 			"accountid": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Account identifier (e.g., username, access key, account GUID, etc.).",
-// 			},
-// 			"apitoken": {
-// 				Type:        schema.TypeMap,
-// 				Optional:    true,
-// 				Description: "Secret contains the plain and encrypted version of a value (the plain version is never stored in the DB)",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"cacert": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "CA certificate for certificate-based authentication.",
-// 			},
-// 			"created": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Created when the credential was created (or the account ID was changed for AWS).",
-// 			},
-// 			"description": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Description of the credential.",
-// 			},
-// 			"external": {
-// 				Type:        schema.TypeBool,
-// 				Optional:    true,
-// 				Description: "Indicates if the credential is external (true) or not (false).",
-// 			},
-// 			"lastmodified": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Datetime when the credential was last modified.",
-// 			},
-// 			"owner": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "User who created or modified the credential.",
-// 			},
-// 			"rolearn": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Amazon Resource Name (ARN) of the role to assume.",
-// 			},
-// 			"secret": {
-// 				Type:        schema.TypeMap,
-// 				Optional:    true,
-// 				Description: "Secret contains the plain and encrypted version of a value (the plain version is never stored in the DB)",
-// 				Elem: &schema.Schema{
-// 					Type: schema.TypeString,
-// 				},
-// 			},
-// 			"skipverify": {
-// 				Type:        schema.TypeBool,
-// 				Optional:    true,
-// 				Description: "SkipVerify if should skip certificate verification in tls communication.",
-// 			},
-// 			"tokens": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "TemporaryToken is a temporary session token for cloud provider APIs AWS - https://docs.aws.amazon.com/IAM/latest/UserGuide/id_credentials_temp.html GCP - https://cloud.google.com/iam/docs/creating-short-lived-service-account-credentials Azure - https://docs.microsoft.com/en-us/azure/active-directory/manage-apps/what-is-single-sign-on",
-// 				Elem: &schema.Resource{
-// 					Schema: map[string]*schema.Schema{
-// 						"awsaccesskeyid": {
-// 							Type:        schema.TypeString,
-// 							Optional:    true,
-// 							Description: "Temporary access key.",
-// 						},
-// 						"awssecretaccesskey": {
-// 							Type:        schema.TypeString,
-// 							Optional:    true,
-// 							Description: "Secret contains the plain and encrypted version of a value (the plain version is never stored in the DB)",
-// 						},
-// 						"duration": {
-// 							Type:        schema.TypeInt,
-// 							Optional:    true,
-// 							Description: "Duration of the token.",
-// 						},
-// 						"expirationtime": {
-// 							Type:        schema.TypeString,
-// 							Optional:    true,
-// 							Description: "Expiration time for the token.",
-// 						},
-// 						"token": {
-// 							Type:        schema.TypeSet,
-// 							Optional:    true,
-// 							Description: "Secret contains the plain and encrypted version of a value (the plain version is never stored in the DB)",
-// 							Elem: &schema.Resource{
-// 								Schema: map[string]*schema.Schema{
-// 									"encrypted": {
-// 										Type:        schema.TypeString,
-// 										Optional:    true,
-// 										Description: "Encrypted value for the secret.",
-// 									},
-// 									"plain": {
-// 										Type:        schema.TypeString,
-// 										Optional:    true,
-// 										Description: "Plain text value for the secret. Note: marshalling to JSON will convert to an encrypted value.",
-// 									},
-// 								},
-// 							},
-// 						},
-// 					},
-// 				},
-// 			},
-// 			"type": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Credential type.",
-// 			},
-// 			"url": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "URL is the server base url.",
-// 			},
-// 			"useawsrole": {
-// 				Type:        schema.TypeBool,
-// 				Optional:    true,
-// 				Description: "Indicates if authentication should be done with the instance's attached credentials (EC2 IAM Role).",
-// 			},
-// 		},
-// 	}
-// }
+func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-// func dataSourceCredentialsRead(d *schema.ResourceData, meta interface{}) error {
-// 	client := meta.(*api.Client)
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.Errorf("error reading credentials: %s", err)
+	}
 
-// 	i, err := auth.ListCredentials(*client)
+	items, err := auth.ListCredentials(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading credentials: %s", err)
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	list := make([]interface{}, 0, len(items))
+	for _, val := range items {
+		if nameRegex.MatchString(val.Id) {
+			list = append(list, convert.CredentialToSchema(val))
+		}
+	}
 
-// 	list := make([]interface{}, 0, 1)
-// 	for _, val := range i {
-// 		list = append(list, map[string]interface{}{
-// 			"_id": val.Id,
-// 			"accountGUID": val.AccountGUID,
-// 			"accountID": val.AccountID,
-// 			"apiToken": flattenSecret(val.ApiToken),
-// 			"caCert": val.CaCert,
-// 			"created": val.Created,
-// 			"description": val.Description,
-// 			"external": val.External,
-// 			"lastModified": val.LastModified,
-// 			"owner": val.Owner,
-// 			"roleArn": val.RoleArn,
-// 			"secret": flattenSecret(val.Secret),
-// 			"skipVerify": val.SkipVerify,
-// 			"tokens": flattenTokens(val.Tokens),
-// 			"type": val.Type,
-// 			"url": val.Url,
-// 			"useAWSRole": val.UseAWSRole,
-// 		})
-// 	}
+	if err := d.Set("listing", list); err != nil {
+		return diag.Errorf("error reading credentials: %s", err)
+	}
+	if err := d.Set("total", len(list)); err != nil {
+		return diag.Errorf("error reading credentials: %s", err)
+	}
+	d.SetId(listingId(d))
 
-// 	return nil
-// }
+	return nil
+}
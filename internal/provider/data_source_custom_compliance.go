@@ -1,16 +1,17 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceCustomCompliance() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to retrieve ID of a custom compliance.",
-		Read:        dataSourceCustomComplianceRead,
+		ReadContext: dataSourceCustomComplianceRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -47,33 +48,33 @@ func dataSourceCustomCompliance() *schema.Resource {
 	}
 }
 
-func dataSourceCustomComplianceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCustomComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	if name := d.Get("name").(string); name != "" {
-		retrievedCustomCompliance, err := policy.GetCustomComplianceByName(*client, name)
+		retrievedCustomCompliance, err := policy.GetCustomComplianceByName(ctx, *client, name)
 		if err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		if err := d.Set("name", retrievedCustomCompliance.Name); err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		if err := d.Set("title", retrievedCustomCompliance.Title); err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		if err := d.Set("severity", retrievedCustomCompliance.Severity); err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		if err := d.Set("script", retrievedCustomCompliance.Script); err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		if err := d.Set("prisma_id", retrievedCustomCompliance.Id); err != nil {
-			return fmt.Errorf("error reading custom Compliance: %s", err)
+			return diag.Errorf("error reading custom Compliance: %s", err)
 		}
 		d.SetId(retrievedCustomCompliance.Name)
 
 		return nil
 	}
 
-	return fmt.Errorf("missing name parameter")
+	return diag.Errorf("missing name parameter")
 }
@@ -1,17 +1,18 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/rule"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceCustomRule() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this data source to retrieve ID of a custom rule.",
-		Read:        dataSourceCustomRuleRead,
+		ReadContext: dataSourceCustomRuleRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -53,36 +54,36 @@ func dataSourceCustomRule() *schema.Resource {
 	}
 }
 
-func dataSourceCustomRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCustomRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	if name := d.Get("name").(string); name != "" {
-		retrievedCustomRule, err := rule.GetCustomRuleByName(*client, name)
+		retrievedCustomRule, err := rule.GetCustomRuleByName(ctx, *client, name)
 		if err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("description", retrievedCustomRule.Description); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("prisma_id", retrievedCustomRule.Id); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("message", retrievedCustomRule.Message); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("name", retrievedCustomRule.Name); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("script", retrievedCustomRule.Script); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		if err := d.Set("type", retrievedCustomRule.Type); err != nil {
-			return fmt.Errorf("error reading custom rule: %s", err)
+			return diag.Errorf("error reading custom rule: %s", err)
 		}
 		d.SetId(retrievedCustomRule.Name)
 
 		return nil
 	}
 
-	return fmt.Errorf("missing name parameter")
+	return diag.Errorf("missing name parameter")
 }
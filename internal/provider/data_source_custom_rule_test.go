@@ -18,7 +18,8 @@ func TestAccDsCustomRule(t *testing.T) {
 			{
 				Config: testAccDsCustomRule(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("data.prismacloudcompute_custom_rule.test", "name"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_custom_rule.test", "name", name),
+					resource.TestCheckResourceAttrPair("data.prismacloudcompute_custom_rule.test", "prisma_id", "prismacloudcompute_custom_rule.test", "prisma_id"),
 				),
 			},
 		},
@@ -27,8 +28,15 @@ func TestAccDsCustomRule(t *testing.T) {
 
 func testAccDsCustomRule(name string) string {
 	return fmt.Sprintf(`
+	resource "prismacloudcompute_custom_rule" "test" {
+		name    = %q
+		type    = "processes"
+		message = "unexpected %%proc.name was spawned"
+		script  = "proc.interactive"
+	}
+
 	data "prismacloudcompute_custom_rule" "test" {
-		name = %q
+		name = prismacloudcompute_custom_rule.test.name
 	}
 	`, name)
 }
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/defender"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDefenderDaemonSet() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to generate the manifest that deploys Defenders to a Kubernetes or OpenShift cluster as a DaemonSet. " +
+			"The manifest holds the credentials Defenders connect to the Console with.",
+		ReadContext: dataSourceDefenderDaemonSetRead,
+
+		Schema: defenderDaemonSetSchema(),
+	}
+	ans.Schema["manifests"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The documents of the manifest, one Kubernetes object each, to be decoded with `yamldecode` for `kubernetes_manifest`.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	ans.Schema["yaml"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The manifest, with all Kubernetes objects in a single YAML document stream.",
+	}
+	return ans
+}
+
+// Get the schema of the options of the Defender DaemonSet, which are shared
+// by the DaemonSet manifest and the Helm chart.
+func defenderDaemonSetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The namespace Defenders are deployed to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"cluster": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the cluster shown in the Console. The Console detects it for some cloud providers if not set.",
+		},
+		"collect_pod_labels": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Collect the labels of the pods and namespaces of deployed images.",
+		},
+		"console_address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Address that Defenders connect to the Console with, such as `console.example.com`.",
+		},
+		"cri": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Nodes use a CRI container runtime, such as containerd or CRI-O, instead of Docker.",
+		},
+		"istio": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Monitor Istio.",
+		},
+		"monitor_service_accounts": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Monitor the service accounts of the cluster.",
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "twistlock",
+			Description: "Namespace to deploy Defenders to. Defaults to `twistlock`.",
+		},
+		"node_selector": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Node selector that restricts the nodes Defenders run on, in the form `key: value`.",
+		},
+		"orchestration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "kubernetes",
+			ValidateFunc: validation.StringInSlice([]string{"kubernetes", "openshift"}, false),
+			Description:  "Orchestrator of the cluster. Can be set to `kubernetes` or `openshift`. Defaults to `kubernetes`.",
+		},
+		"privileged": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Run Defenders as privileged containers.",
+		},
+		"proxy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Proxy that Defenders connect to the Console through.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ca": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "PEM encoded CA certificate to verify the proxy.",
+					},
+					"http_proxy": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Address of the proxy, such as `http://proxy.example.com:3128`.",
+					},
+					"no_proxy": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Comma-separated addresses that are reached without the proxy.",
+					},
+					"password": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Password to authenticate with the proxy.",
+					},
+					"user": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "User to authenticate with the proxy.",
+					},
+				},
+			},
+		},
+		"selinux": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Deploy Defenders with SELinux policies, for nodes with SELinux enabled.",
+		},
+	}
+}
+
+func dataSourceDefenderDaemonSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	options := convert.SchemaToDaemonSetOptions(d)
+
+	manifest, err := defender.GetDaemonSet(ctx, *client, options)
+	if err != nil {
+		return diag.Errorf("error reading Defender DaemonSet: %s", err)
+	}
+
+	if err := d.Set("yaml", manifest); err != nil {
+		return diag.Errorf("error reading Defender DaemonSet: %s", err)
+	}
+	if err := d.Set("manifests", splitYamlDocuments(manifest)); err != nil {
+		return diag.Errorf("error reading Defender DaemonSet: %s", err)
+	}
+	d.SetId(options.Namespace)
+
+	return nil
+}
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// Split a YAML document stream into its documents, leaving out empty ones.
+func splitYamlDocuments(stream string) []string {
+	ans := make([]string, 0)
+	for _, doc := range yamlDocumentSeparator.Split(stream, -1) {
+		if strings.TrimSpace(doc) != "" {
+			ans = append(ans, strings.Trim(doc, "\n")+"\n")
+		}
+	}
+	return ans
+}
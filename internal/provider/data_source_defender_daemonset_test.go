@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsDefenderDaemonSet(t *testing.T) {
+	cluster := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsDefenderDaemonSetConfig(cluster),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_daemonset.test", "id", "defender"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_daemonset.test", "manifests.#", "3"),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "manifests.0", regexp.MustCompile(`(?m)^kind: Namespace\n`)),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "manifests.2", regexp.MustCompile(`(?m)^kind: DaemonSet\n`)),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "yaml", regexp.MustCompile(`namespace: defender\n`)),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "yaml", regexp.MustCompile(fmt.Sprintf(`value: "%s"\n`, cluster))),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "yaml", regexp.MustCompile(`node-role: "worker"\n`)),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "yaml", regexp.MustCompile(`containerd\.sock\n`)),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.test", "yaml", regexp.MustCompile(`value: "http://proxy\.example\.com:3128"\n`)),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_daemonset.default", "id", "twistlock"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_daemonset.default", "manifests.#", "3"),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_daemonset.default", "yaml", regexp.MustCompile(`docker\.sock\n`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsDefenderDaemonSetConfig(cluster string) string {
+	return fmt.Sprintf(`
+data "prismacloudcompute_defender_daemonset" "test" {
+	console_address    = "console.example.com"
+	namespace          = "defender"
+	cluster            = %q
+	collect_pod_labels = true
+	cri                = true
+	node_selector      = "node-role: worker"
+
+	proxy {
+		http_proxy = "http://proxy.example.com:3128"
+		user       = "defender"
+		password   = "Hunter2"
+	}
+}
+
+data "prismacloudcompute_defender_daemonset" "default" {
+	console_address = "console.example.com"
+}`, cluster)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/defender"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDefenderHelmChart() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to generate the Helm chart that deploys Defenders to a Kubernetes or OpenShift cluster as a DaemonSet. " +
+			"The chart holds the credentials Defenders connect to the Console with.",
+		ReadContext: dataSourceDefenderHelmChartRead,
+
+		Schema: defenderDaemonSetSchema(),
+	}
+	ans.Schema["content_base64"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The chart as a base64 encoded gzipped tar archive, to be written to a file with `local_sensitive_file` for `helm_release`.",
+	}
+	return ans
+}
+
+func dataSourceDefenderHelmChartRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	options := convert.SchemaToDaemonSetOptions(d)
+
+	chart, err := defender.GetHelmChart(ctx, *client, options)
+	if err != nil {
+		return diag.Errorf("error reading Defender Helm chart: %s", err)
+	}
+
+	if err := d.Set("content_base64", base64.StdEncoding.EncodeToString(chart)); err != nil {
+		return diag.Errorf("error reading Defender Helm chart: %s", err)
+	}
+	d.SetId(options.Namespace)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDsDefenderHelmChart(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsDefenderHelmChartConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_helm_chart.test", "id", "defender"),
+					testAccCheckDefenderHelmChartTemplate("data.prismacloudcompute_defender_helm_chart.test", "namespace: defender\n"),
+				),
+			},
+		},
+	})
+}
+
+// Check that the templates of the chart contain the given text.
+func testAccCheckDefenderHelmChartTemplate(n string, text string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		chart, err := base64.StdEncoding.DecodeString(rs.Primary.Attributes["content_base64"])
+		if err != nil {
+			return fmt.Errorf("Error in decoding chart: %s", err)
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(chart))
+		if err != nil {
+			return fmt.Errorf("Error in decompressing chart: %s", err)
+		}
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("Error in reading chart: %s", err)
+			}
+			if !strings.Contains(hdr.Name, "/templates/") {
+				continue
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				return fmt.Errorf("Error in reading %s: %s", hdr.Name, err)
+			}
+			if strings.Contains(string(content), text) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Chart templates do not contain %q", text)
+	}
+}
+
+func testAccDsDefenderHelmChartConfig() string {
+	return `
+data "prismacloudcompute_defender_helm_chart" "test" {
+	console_address = "console.example.com"
+	namespace       = "defender"
+}`
+}
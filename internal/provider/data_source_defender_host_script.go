@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/defender"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDefenderHostScript() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve the script that installs a host Defender.",
+		ReadContext: dataSourceDefenderHostScriptRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The operating system of the script.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"os": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "linux",
+				ValidateFunc: validation.StringInSlice([]string{"linux", "windows"}, false),
+				Description:  "Operating system of the host. Can be set to `linux`, for a Bash script, or `windows`, for a PowerShell script. Defaults to `linux`.",
+			},
+			"script": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The install script.",
+			},
+		},
+	}
+}
+
+func dataSourceDefenderHostScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	os := d.Get("os").(string)
+
+	script, err := defender.GetHostScript(ctx, *client, os)
+	if err != nil {
+		return diag.Errorf("error reading host Defender install script: %s", err)
+	}
+
+	if err := d.Set("script", script); err != nil {
+		return diag.Errorf("error reading host Defender install script: %s", err)
+	}
+	d.SetId(os)
+
+	return nil
+}
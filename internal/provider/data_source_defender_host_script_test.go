@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsDefenderHostScript(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsDefenderHostScriptConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_host_script.linux", "id", "linux"),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_host_script.linux", "script", regexp.MustCompile(`^#!/bin/bash\n`)),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_defender_host_script.windows", "id", "windows"),
+					resource.TestMatchResourceAttr("data.prismacloudcompute_defender_host_script.windows", "script", regexp.MustCompile(`\$ErrorActionPreference`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsDefenderHostScriptConfig() string {
+	return `
+data "prismacloudcompute_defender_host_script" "linux" {}
+
+data "prismacloudcompute_defender_host_script" "windows" {
+	os = "windows"
+}`
+}
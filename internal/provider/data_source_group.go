@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroup() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to retrieve a group by name.",
+		ReadContext: dataSourceGroupRead,
+
+		Schema: dataSourceSchema(resourceGroups().Schema),
+	}
+	ans.Schema["id"] = &schema.Schema{
+		Description: "The ID of the group.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	ans.Schema["name"] = &schema.Schema{
+		Description: "Name of the group.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	return ans
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	retrievedGroup, err := auth.GetGroup(ctx, *client, d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error reading group: %s", err)
+	}
+
+	for key, val := range convert.GroupToSchema(*retrievedGroup) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading group: %s", err)
+		}
+	}
+	d.SetId(retrievedGroup.Name)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsGroup(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsGroupConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_group.test", "name", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_group.test", "role", "auditor"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_group.test", "ldap_group", "true"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_group.test", "permissions.0.collections.0", "All"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsGroupConfig(name string) string {
+	return testAccGroupLdapSettingsConfig + fmt.Sprintf(`
+resource "prismacloudcompute_group" "test" {
+	name       = %q
+	role       = "auditor"
+	ldap_group = true
+	permissions {
+		collections = ["All"]
+	}
+
+	depends_on = [prismacloudcompute_ldap_settings.test]
+}
+
+data "prismacloudcompute_group" "test" {
+	name = prismacloudcompute_group.test.name
+}
+`, name)
+}
@@ -1,28 +1,88 @@
 package provider
 
-// import (
-// 	"testing"
-
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// )
-
-// func TestAccDsGroups(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:  func() { testAccPreCheck(t) },
-// 		Providers: testAccProviders,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccDsGroupsConfig(),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttrSet("data.prismacloudcompute_groups.test", "total"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func testAccDsGroupsConfig() string {
-// 	return `
-// data "prismacloudcompute_groups" "test" {}
-// `
-// }
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsGroups(t *testing.T) {
+	prefix := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsGroupsConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_groups.matching", "total", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_groups.matching", "listing.*", map[string]string{"name": prefix + "-first"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_groups.matching", "listing.*", map[string]string{"name": prefix + "-second"}),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_groups.all", "total", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsGroupsConfig(prefix string) string {
+	return testAccGroupLdapSettingsConfig + fmt.Sprintf(`
+locals {
+	prefix = %q
+}
+
+resource "prismacloudcompute_group" "first" {
+	name       = "${local.prefix}-first"
+	role       = "auditor"
+	ldap_group = true
+	permissions {
+		collections = ["All"]
+	}
+
+	depends_on = [prismacloudcompute_ldap_settings.test]
+}
+
+resource "prismacloudcompute_group" "second" {
+	name       = "${local.prefix}-second"
+	role       = "auditor"
+	ldap_group = true
+	permissions {
+		collections = ["All"]
+	}
+
+	depends_on = [prismacloudcompute_ldap_settings.test]
+}
+
+resource "prismacloudcompute_group" "other" {
+	name       = "other-${local.prefix}"
+	role       = "auditor"
+	ldap_group = true
+	permissions {
+		collections = ["All"]
+	}
+
+	depends_on = [prismacloudcompute_ldap_settings.test]
+}
+
+data "prismacloudcompute_groups" "matching" {
+	name_regex = "^${local.prefix}-"
+
+	depends_on = [
+		prismacloudcompute_group.first,
+		prismacloudcompute_group.second,
+		prismacloudcompute_group.other,
+	]
+}
+
+data "prismacloudcompute_groups" "all" {
+	depends_on = [
+		prismacloudcompute_group.first,
+		prismacloudcompute_group.second,
+		prismacloudcompute_group.other,
+	]
+}
+`, prefix)
+}
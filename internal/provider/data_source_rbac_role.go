@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceRbacRole() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to retrieve a role by name.",
+		ReadContext: dataSourceRbacRoleRead,
+
+		Schema: dataSourceSchema(resourceRbacRoles().Schema),
+	}
+	ans.Schema["id"] = &schema.Schema{
+		Description: "The ID of the role.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	ans.Schema["name"] = &schema.Schema{
+		Description: "Name of the role.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	return ans
+}
+
+func dataSourceRbacRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	retrievedRole, err := auth.GetRole(ctx, *client, d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error reading role: %s", err)
+	}
+
+	for key, val := range convert.RbacRoleToSchema(*retrievedRole) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading role: %s", err)
+		}
+	}
+	d.SetId(retrievedRole.Name)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsRbacRole(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsRbacRoleConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_role.test", "name", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_role.test", "description", "read-only radar"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_role.test", "permission.0.name", "radarsContainers"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsRbacRoleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_role" "test" {
+	name        = %q
+	description = "read-only radar"
+	permission {
+		name       = "radarsContainers"
+		read_write = false
+	}
+}
+
+data "prismacloudcompute_role" "test" {
+	name = prismacloudcompute_role.test.name
+}
+`, name)
+}
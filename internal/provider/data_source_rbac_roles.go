@@ -1,69 +1,72 @@
 package provider
 
-// import (
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/client"
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+import (
+	"context"
+	"regexp"
 
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-// )
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
-// func dataSourceRbacRoles() *schema.Resource {
-// 	return &schema.Resource{
-// 		Read: dataSourceRbacRolesRead,
+func dataSourceRbacRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve all roles, or the roles whose names match a regular expression.",
+		ReadContext: dataSourceRbacRolesRead,
 
-// 		Schema: map[string]*schema.Schema{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the data source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"listing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching roles.",
+				Elem: &schema.Resource{
+					Schema: dataSourceSchema(resourceRbacRoles().Schema),
+				},
+			},
+			"name_regex": nameRegexSchema("Regular expression that the names of the roles must match."),
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching roles.",
+			},
+		},
+	}
+}
 
-// 			// Output.
-// 			"description": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Role description.",
-// 			},
-// 			"name": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Role name.",
-// 			},
-// 			"permissions": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of permissions.",
-// 				Elem: &schema.Resource{
-// 					Schema: map[string]*schema.Schema{
-// 						"name": {
-// 							Type:        schema.TypeString,
-// 							Optional:    true,
-// 							Description: "Names roles for the user.",
-// 						},
-// 						"readwrite": {
-// 							Type:        schema.TypeBool,
-// 							Optional:    true,
-// 							Description: "Indicates the type of permission.",
-// 						},
-// 					},
-// 				},
-// 			},
-// 		},
-// 	}
-// }
+func dataSourceRbacRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-// func dataSourceRbacRolesRead(d *schema.ResourceData, meta interface{}) error {
-// 	client := meta.(*api.Client)
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.Errorf("error reading roles: %s", err)
+	}
 
-// 	i, err := auth.ListRoles(*client)
+	items, err := auth.ListRoles(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading roles: %s", err)
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	list := make([]interface{}, 0, len(items))
+	for _, val := range items {
+		if nameRegex.MatchString(val.Name) {
+			list = append(list, convert.RbacRoleToSchema(val))
+		}
+	}
 
-// 	list := make([]interface{}, 0, 1)
-// 	for _, val := range i {
-// 		list = append(list, map[string]interface{}{
-// 			"description": val.Description,
-// 			"name": val.Name,
-// 			"perms": val.Permissions,
-// 		})
-// 	}
+	if err := d.Set("listing", list); err != nil {
+		return diag.Errorf("error reading roles: %s", err)
+	}
+	if err := d.Set("total", len(list)); err != nil {
+		return diag.Errorf("error reading roles: %s", err)
+	}
+	d.SetId(listingId(d))
 
-// 	return nil
-// }
+	return nil
+}
@@ -1,28 +1,82 @@
 package provider
 
-// import (
-// 	"testing"
-
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// )
-
-// func TestAccDsRbacRoles(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:  func() { testAccPreCheck(t) },
-// 		Providers: testAccProviders,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccDsRbacRolesConfig(),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttrSet("data.prismacloudcompute_rbac_roles.test", "total"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func testAccDsRbacRolesConfig() string {
-// 	return `
-// data "prismacloudcompute_rbac_roles" "test" {}
-// `
-// }
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsRbacRoles(t *testing.T) {
+	prefix := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsRbacRolesConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_roles.matching", "total", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_roles.matching", "listing.*", map[string]string{"name": prefix + "-first"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_roles.matching", "listing.*", map[string]string{"name": prefix + "-second"}),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_roles.all", "total", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsRbacRolesConfig(prefix string) string {
+	return fmt.Sprintf(`
+locals {
+	prefix = %q
+}
+
+resource "prismacloudcompute_role" "first" {
+	name        = "${local.prefix}-first"
+	description = "read-only radar"
+	permission {
+		name       = "radarsContainers"
+		read_write = false
+	}
+}
+
+resource "prismacloudcompute_role" "second" {
+	name        = "${local.prefix}-second"
+	description = "read-only radar"
+	permission {
+		name       = "radarsContainers"
+		read_write = false
+	}
+}
+
+resource "prismacloudcompute_role" "other" {
+	name        = "other-${local.prefix}"
+	description = "read-only radar"
+	permission {
+		name       = "radarsContainers"
+		read_write = false
+	}
+}
+
+data "prismacloudcompute_roles" "matching" {
+	name_regex = "^${local.prefix}-"
+
+	depends_on = [
+		prismacloudcompute_role.first,
+		prismacloudcompute_role.second,
+		prismacloudcompute_role.other,
+	]
+}
+
+data "prismacloudcompute_roles" "all" {
+	depends_on = [
+		prismacloudcompute_role.first,
+		prismacloudcompute_role.second,
+		prismacloudcompute_role.other,
+	]
+}
+`, prefix)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUser() *schema.Resource {
+	ans := &schema.Resource{
+		Description: "Use this data source to retrieve a user by username.",
+		ReadContext: dataSourceUserRead,
+
+		Schema: dataSourceSchema(resourceUsers().Schema, "password"),
+	}
+	ans.Schema["id"] = &schema.Schema{
+		Description: "The ID of the user.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	ans.Schema["username"] = &schema.Schema{
+		Description: "Username of the user.",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	return ans
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	retrievedUser, err := auth.GetUser(ctx, *client, d.Get("username").(string))
+	if err != nil {
+		return diag.Errorf("error reading user: %s", err)
+	}
+
+	for key, val := range convert.UserToSchema(*retrievedUser) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading user: %s", err)
+		}
+	}
+	d.SetId(retrievedUser.Username)
+
+	return nil
+}
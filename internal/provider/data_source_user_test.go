@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsUser(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsUserConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_user.test", "username", name),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_user.test", "authentication_type", "basic"),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_user.test", "role", "auditor"),
+					resource.TestCheckNoResourceAttr("data.prismacloudcompute_user.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsUserConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_user" "test" {
+	username            = %q
+	password            = "Hunter2!tf"
+	authentication_type = "basic"
+	role                = "auditor"
+}
+
+data "prismacloudcompute_user" "test" {
+	username = prismacloudcompute_user.test.username
+}
+`, name)
+}
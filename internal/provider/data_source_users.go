@@ -1,90 +1,72 @@
 package provider
 
-// import (
-// 	"log"
+import (
+	"context"
+	"regexp"
 
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/client"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-// )
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve all users, or the users whose usernames match a regular expression.",
+		ReadContext: dataSourceUsersRead,
 
-// func dataSourceUsers() *schema.Resource {
-// 	return &schema.Resource{
-// 		Read: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the data source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"listing": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching users.",
+				Elem: &schema.Resource{
+					Schema: dataSourceSchema(resourceUsers().Schema, "password"),
+				},
+			},
+			"name_regex": nameRegexSchema("Regular expression that the usernames of the users must match."),
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching users.",
+			},
+		},
+	}
+}
 
-// 		Schema: map[string]*schema.Schema{
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-// 			// Output.
-// 			"authtype": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "The user authentication type.",
-// 			},
-// 			"password": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Password for authentication.",
-// 			},
-// 			"permissions": {
-// 				Type:        schema.TypeList,
-// 				Optional:    true,
-// 				Description: "List of permissions.",
-// 				Elem: &schema.Resource{
-// 					Schema: map[string]*schema.Schema{
-// 						"collections": {
-// 							Type:        schema.TypeList,
-// 							Optional:    true,
-// 							Description: "Specifies the set of Defenders in-scope for working on a scan job.",
-// 							Elem: &schema.Schema{
-// 								Type: schema.TypeString,
-// 							},
-// 						},
-// 						"project": {
-// 							Type:        schema.TypeString,
-// 							Optional:    true,
-// 							Description: "Names of projects which the user can access.",
-// 						},
-// 					},
-// 				},
-// 			},
-// 			"role": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "User role.",
-// 			},
-// 			"username": {
-// 				Type:        schema.TypeString,
-// 				Optional:    true,
-// 				Description: "Username for authentication.",
-// 			},
-// 		},
-// 	}
-// }
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.Errorf("error reading users: %s", err)
+	}
 
-// func dataSourceUsersRead(d *schema.ResourceData, meta interface{}) error {
-// 	client := meta.(*api.Client)
+	items, err := auth.ListUsers(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading users: %s", err)
+	}
 
-// 	i, err := auth.ListUsers(*client)
+	list := make([]interface{}, 0, len(items))
+	for _, val := range items {
+		if nameRegex.MatchString(val.Username) {
+			list = append(list, convert.UserToSchema(val))
+		}
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	if err := d.Set("listing", list); err != nil {
+		return diag.Errorf("error reading users: %s", err)
+	}
+	if err := d.Set("total", len(list)); err != nil {
+		return diag.Errorf("error reading users: %s", err)
+	}
+	d.SetId(listingId(d))
 
-// 	list := make([]interface{}, 0, 1)
-// 	for _, val := range i {
-// 		list = append(list, map[string]interface{}{
-// 			"authType":    val.AuthType,
-// 			"password":    val.Password,
-// 			"permissions": val.Permissions,
-// 			"role":        val.Role,
-// 			"username":    val.Username,
-// 		})
-// 	}
-
-// 	if err := d.Set("listing", list); err != nil {
-// 		log.Printf("[WARN] Error setting 'listing' field for %q: %s", d.Id(), err)
-// 	}
-
-// 	return nil
-// }
+	return nil
+}
@@ -1,28 +1,76 @@
 package provider
 
-// import (
-// 	"testing"
-
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// )
-
-// func TestAccDsUsers(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:  func() { testAccPreCheck(t) },
-// 		Providers: testAccProviders,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccDsUsersConfig(),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttrSet("data.prismacloudcompute_users.test", "total"),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func testAccDsUsersConfig() string {
-// 	return `
-// data "prismacloudcompute_users" "test" {}
-// `
-// }
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDsUsers(t *testing.T) {
+	prefix := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDsUsersConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.prismacloudcompute_users.matching", "total", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_users.matching", "listing.*", map[string]string{"username": prefix + "-first"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.prismacloudcompute_users.matching", "listing.*", map[string]string{"username": prefix + "-second"}),
+					resource.TestCheckResourceAttr("data.prismacloudcompute_users.all", "total", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDsUsersConfig(prefix string) string {
+	return fmt.Sprintf(`
+locals {
+	prefix = %q
+}
+
+resource "prismacloudcompute_user" "first" {
+	username            = "${local.prefix}-first"
+	password            = "Hunter2!tf"
+	authentication_type = "basic"
+	role                = "auditor"
+}
+
+resource "prismacloudcompute_user" "second" {
+	username            = "${local.prefix}-second"
+	password            = "Hunter2!tf"
+	authentication_type = "basic"
+	role                = "auditor"
+}
+
+resource "prismacloudcompute_user" "other" {
+	username            = "other-${local.prefix}"
+	password            = "Hunter2!tf"
+	authentication_type = "basic"
+	role                = "auditor"
+}
+
+data "prismacloudcompute_users" "matching" {
+	name_regex = "^${local.prefix}-"
+
+	depends_on = [
+		prismacloudcompute_user.first,
+		prismacloudcompute_user.second,
+		prismacloudcompute_user.other,
+	]
+}
+
+data "prismacloudcompute_users" "all" {
+	depends_on = [
+		prismacloudcompute_user.first,
+		prismacloudcompute_user.second,
+		prismacloudcompute_user.other,
+	]
+}
+`, prefix)
+}
@@ -1,13 +1,16 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func Provider() *schema.Provider {
@@ -38,11 +41,80 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PASSWORD", nil),
 				Sensitive:   true,
 			},
+			"access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prisma Cloud access key ID, used in place of username and password",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_ACCESS_KEY_ID", nil),
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prisma Cloud access key secret",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_SECRET_KEY", nil),
+				Sensitive:   true,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A pre-issued Prisma Cloud Compute API token. Takes precedence over all other credentials and is not renewed when it expires",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_TOKEN", nil),
+				Sensitive:   true,
+			},
 			"skip_cert_verification": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether or not to skip certificate verification",
-				Default:     true,
+				Description: "Whether or not to skip certificate verification. Defaults to false",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a PEM file with additional CA certificates to trust when verifying the Console certificate",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CA_CERT_FILE", nil),
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded additional CA certificates to trust when verifying the Console certificate",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CA_CERT_PEM", nil),
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded client certificate, or a path to one, for mutual TLS",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CLIENT_CERT", nil),
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM-encoded client key, or a path to one, for mutual TLS",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CLIENT_KEY", nil),
+				Sensitive:   true,
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the proxy for requests to the Console. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_URL", nil),
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma-separated hosts, domains and CIDRs to reach without the proxy. Defaults to the NO_PROXY environment variable",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_NO_PROXY", nil),
+			},
+			"proxy_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username to authenticate with the proxy",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_USERNAME", nil),
+			},
+			"proxy_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Password to authenticate with the proxy",
+				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_PROXY_PASSWORD", nil),
+				Sensitive:   true,
 			},
 			"config_file": {
 				Type:        schema.TypeString,
@@ -50,59 +122,130 @@ func Provider() *schema.Provider {
 				Description: "Configuration file in JSON format. See examples/creds.json",
 				DefaultFunc: schema.EnvDefaultFunc("PRISMACLOUDCOMPUTE_CONFIG_FILE", nil),
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fmt.Sprintf("Maximum number of times to retry a request that was throttled or failed because the Console was temporarily unavailable. Set to 0 to disable retries. Defaults to %d.", api.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fmt.Sprintf("Maximum number of seconds to wait between retries. Defaults to %d.", api.DefaultRetryMaxWait),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"prismacloudcompute_alertprofile":                     resourceAlertprofile(),
-			"prismacloudcompute_collection":                       resourceCollection(),
-			"prismacloudcompute_custom_rule":                      resourceCustomRule(),
-			"prismacloudcompute_admission_policy":                 resourcePoliciesAdmission(),
-			"prismacloudcompute_ci_image_compliance_policy":       resourcePoliciesComplianceCiImage(),
-			"prismacloudcompute_container_compliance_policy":      resourcePoliciesComplianceContainer(),
-			"prismacloudcompute_host_compliance_policy":           resourcePoliciesComplianceHost(),
-			"prismacloudcompute_container_runtime_policy":         resourcePoliciesRuntimeContainer(),
-			"prismacloudcompute_host_runtime_policy":              resourcePoliciesRuntimeHost(),
-			"prismacloudcompute_ci_coderepo_vulnerability_policy": resourcePoliciesVulnerabilityCiCoderepo(),
-			"prismacloudcompute_ci_image_vulnerability_policy":    resourcePoliciesVulnerabilityCiImage(),
-			"prismacloudcompute_coderepo_vulnerability_policy":    resourcePoliciesVulnerabilityCoderepo(),
-			"prismacloudcompute_coderepo_compliance_policy":       resourcePoliciesComplianceCoderepo(),
-			"prismacloudcompute_ci_coderepo_compliance_policy":    resourcePoliciesComplianceCiCoderepo(),
-			"prismacloudcompute_host_vulnerability_policy":        resourcePoliciesVulnerabilityHost(),
-			"prismacloudcompute_image_vulnerability_policy":       resourcePoliciesVulnerabilityImage(),
-			"prismacloudcompute_registry_settings":                resourceRegistrySettings(),
-			"prismacloudcompute_registry":                         resourceRegistry(),
-			"prismacloudcompute_user":                             resourceUsers(),
-			"prismacloudcompute_group":                            resourceGroups(),
-			"prismacloudcompute_role":                             resourceRbacRoles(),
-			"prismacloudcompute_credential":                       resourceCredentials(),
-			"prismacloudcompute_custom_compliance":                resourceCustomCompliance(),
-			"prismacloudcompute_cloud_account":                    resourceCloudAccount(),
+			"prismacloudcompute_alertprofile":                            resourceAlertprofile(),
+			"prismacloudcompute_collection":                              resourceCollection(),
+			"prismacloudcompute_custom_rule":                             resourceCustomRule(),
+			"prismacloudcompute_admission_policy":                        resourcePoliciesAdmission(),
+			"prismacloudcompute_admission_policy_rule":                   resourcePoliciesAdmissionRule(),
+			"prismacloudcompute_ci_image_compliance_policy":              resourcePoliciesComplianceCiImage(),
+			"prismacloudcompute_ci_image_compliance_policy_rule":         resourcePoliciesComplianceCiImageRule(),
+			"prismacloudcompute_container_compliance_policy":             resourcePoliciesComplianceContainer(),
+			"prismacloudcompute_container_compliance_policy_rule":        resourcePoliciesComplianceContainerRule(),
+			"prismacloudcompute_host_compliance_policy":                  resourcePoliciesComplianceHost(),
+			"prismacloudcompute_host_compliance_policy_rule":             resourcePoliciesComplianceHostRule(),
+			"prismacloudcompute_serverless_compliance_policy":            resourcePoliciesComplianceServerless(),
+			"prismacloudcompute_serverless_compliance_policy_rule":       resourcePoliciesComplianceServerlessRule(),
+			"prismacloudcompute_ci_serverless_compliance_policy":         resourcePoliciesComplianceCiServerless(),
+			"prismacloudcompute_ci_serverless_compliance_policy_rule":    resourcePoliciesComplianceCiServerlessRule(),
+			"prismacloudcompute_container_runtime_policy":                resourcePoliciesRuntimeContainer(),
+			"prismacloudcompute_container_runtime_policy_rule":           resourcePoliciesRuntimeContainerRule(),
+			"prismacloudcompute_host_runtime_policy":                     resourcePoliciesRuntimeHost(),
+			"prismacloudcompute_host_runtime_policy_rule":                resourcePoliciesRuntimeHostRule(),
+			"prismacloudcompute_serverless_runtime_policy":               resourcePoliciesRuntimeServerless(),
+			"prismacloudcompute_serverless_runtime_policy_rule":          resourcePoliciesRuntimeServerlessRule(),
+			"prismacloudcompute_app_embedded_runtime_policy":             resourcePoliciesRuntimeAppEmbedded(),
+			"prismacloudcompute_app_embedded_runtime_policy_rule":        resourcePoliciesRuntimeAppEmbeddedRule(),
+			"prismacloudcompute_ci_coderepo_vulnerability_policy":        resourcePoliciesVulnerabilityCiCoderepo(),
+			"prismacloudcompute_ci_coderepo_vulnerability_policy_rule":   resourcePoliciesVulnerabilityCiCoderepoRule(),
+			"prismacloudcompute_ci_image_vulnerability_policy":           resourcePoliciesVulnerabilityCiImage(),
+			"prismacloudcompute_ci_image_vulnerability_policy_rule":      resourcePoliciesVulnerabilityCiImageRule(),
+			"prismacloudcompute_coderepo_vulnerability_policy":           resourcePoliciesVulnerabilityCoderepo(),
+			"prismacloudcompute_coderepo_vulnerability_policy_rule":      resourcePoliciesVulnerabilityCoderepoRule(),
+			"prismacloudcompute_coderepo_compliance_policy":              resourcePoliciesComplianceCoderepo(),
+			"prismacloudcompute_coderepo_compliance_policy_rule":         resourcePoliciesComplianceCoderepoRule(),
+			"prismacloudcompute_ci_coderepo_compliance_policy":           resourcePoliciesComplianceCiCoderepo(),
+			"prismacloudcompute_ci_coderepo_compliance_policy_rule":      resourcePoliciesComplianceCiCoderepoRule(),
+			"prismacloudcompute_host_vulnerability_policy":               resourcePoliciesVulnerabilityHost(),
+			"prismacloudcompute_host_vulnerability_policy_rule":          resourcePoliciesVulnerabilityHostRule(),
+			"prismacloudcompute_image_vulnerability_policy":              resourcePoliciesVulnerabilityImage(),
+			"prismacloudcompute_image_vulnerability_policy_rule":         resourcePoliciesVulnerabilityImageRule(),
+			"prismacloudcompute_serverless_vulnerability_policy":         resourcePoliciesVulnerabilityServerless(),
+			"prismacloudcompute_serverless_vulnerability_policy_rule":    resourcePoliciesVulnerabilityServerlessRule(),
+			"prismacloudcompute_ci_serverless_vulnerability_policy":      resourcePoliciesVulnerabilityCiServerless(),
+			"prismacloudcompute_ci_serverless_vulnerability_policy_rule": resourcePoliciesVulnerabilityCiServerlessRule(),
+			"prismacloudcompute_cnns_policy":                             resourcePoliciesCnns(),
+			"prismacloudcompute_cnns_entity":                             resourceCnnsEntity(),
+			"prismacloudcompute_trusted_images_policy":                   resourcePoliciesTrust(),
+			"prismacloudcompute_trusted_images_policy_rule":              resourcePoliciesTrustRule(),
+			"prismacloudcompute_trust_group":                             resourceTrustGroup(),
+			"prismacloudcompute_container_waas_policy":                   resourcePoliciesWaasContainer(),
+			"prismacloudcompute_host_waas_policy":                        resourcePoliciesWaasHost(),
+			"prismacloudcompute_app_embedded_waas_policy":                resourcePoliciesWaasAppEmbedded(),
+			"prismacloudcompute_serverless_waas_policy":                  resourcePoliciesWaasServerless(),
+			"prismacloudcompute_out_of_band_waas_policy":                 resourcePoliciesWaasOutOfBand(),
+			"prismacloudcompute_registry_settings":                       resourceRegistrySettings(),
+			"prismacloudcompute_registry":                                resourceRegistry(),
+			"prismacloudcompute_logging_settings":                        resourceLoggingSettings(),
+			"prismacloudcompute_ldap_settings":                           resourceLdapSettings(),
+			"prismacloudcompute_saml_settings":                           resourceSamlSettings(),
+			"prismacloudcompute_oauth_settings":                          resourceOauthSettings(),
+			"prismacloudcompute_oidc_settings":                           resourceOidcSettings(),
+			"prismacloudcompute_user":                                    resourceUsers(),
+			"prismacloudcompute_group":                                   resourceGroups(),
+			"prismacloudcompute_role":                                    resourceRbacRoles(),
+			"prismacloudcompute_credential":                              resourceCredentials(),
+			"prismacloudcompute_custom_compliance":                       resourceCustomCompliance(),
+			"prismacloudcompute_cloud_account":                           resourceCloudAccount(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"prismacloudcompute_custom_rule":       dataSourceCustomRule(),
-			"prismacloudcompute_custom_compliance": dataSourceCustomCompliance(),
+			"prismacloudcompute_collection":           dataSourceCollection(),
+			"prismacloudcompute_collections":          dataSourceCollections(),
+			"prismacloudcompute_custom_rule":          dataSourceCustomRule(),
+			"prismacloudcompute_custom_compliance":    dataSourceCustomCompliance(),
+			"prismacloudcompute_user":                 dataSourceUser(),
+			"prismacloudcompute_users":                dataSourceUsers(),
+			"prismacloudcompute_group":                dataSourceGroup(),
+			"prismacloudcompute_groups":               dataSourceGroups(),
+			"prismacloudcompute_role":                 dataSourceRbacRole(),
+			"prismacloudcompute_roles":                dataSourceRbacRoles(),
+			"prismacloudcompute_credential":           dataSourceCredential(),
+			"prismacloudcompute_credentials":          dataSourceCredentials(),
+			"prismacloudcompute_defender_daemonset":   dataSourceDefenderDaemonSet(),
+			"prismacloudcompute_defender_helm_chart":  dataSourceDefenderHelmChart(),
+			"prismacloudcompute_defender_host_script": dataSourceDefenderHostScript(),
 		},
 
-		ConfigureFunc: configure,
+		ConfigureContextFunc: configure,
 	}
 }
 
-func configure(d *schema.ResourceData) (interface{}, error) {
-	var config api.APIClientConfig
+// Provider arguments that supply credentials.
+var credentialKeys = []string{"username", "password", "access_key_id", "secret_key", "token"}
+
+func configure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	config := api.APIClientConfig{
+		MaxRetries:   api.DefaultMaxRetries,
+		RetryMaxWait: api.DefaultRetryMaxWait,
+	}
 	if val, ok := d.GetOk("config_file"); ok {
 		configFile, err := os.Open(val.(string))
 		if err != nil {
-			return nil, fmt.Errorf("error opening config file: %s", err)
+			return nil, diag.Errorf("error opening config file: %s", err)
 		}
 		defer configFile.Close()
 
 		fileContent, err := ioutil.ReadAll(configFile)
 		if err != nil {
-			return nil, fmt.Errorf("error reading config file: %s", err)
+			return nil, diag.Errorf("error reading config file: %s", err)
 		}
 		if err := json.Unmarshal(fileContent, &config); err != nil {
-			return nil, fmt.Errorf("error unmarshalling config file: %s", err)
+			return nil, diag.Errorf("error unmarshalling config file: %s", err)
 		}
 	}
 
@@ -112,19 +255,70 @@ func configure(d *schema.ResourceData) (interface{}, error) {
 	if val, ok := d.GetOk("project"); ok {
 		config.Project = val.(string)
 	}
+	// Credentials from arguments or the environment replace those in the
+	// config file as a whole, so that the two are never mixed.
+	for _, key := range credentialKeys {
+		if _, ok := d.GetOk(key); ok {
+			config.Username, config.Password = "", ""
+			config.AccessKeyID, config.SecretKey = "", ""
+			config.Token = ""
+			break
+		}
+	}
 	if val, ok := d.GetOk("username"); ok {
 		config.Username = val.(string)
 	}
 	if val, ok := d.GetOk("password"); ok {
 		config.Password = val.(string)
 	}
-	if val, ok := d.GetOk("skip_cert_verification"); ok {
+	if val, ok := d.GetOk("access_key_id"); ok {
+		config.AccessKeyID = val.(string)
+	}
+	if val, ok := d.GetOk("secret_key"); ok {
+		config.SecretKey = val.(string)
+	}
+	if val, ok := d.GetOk("token"); ok {
+		config.Token = val.(string)
+	}
+	// An explicit false must override the config file, so GetOk cannot be used.
+	if val, ok := d.GetOkExists("skip_cert_verification"); ok {
 		config.SkipCertVerification = val.(bool)
 	}
+	if val, ok := d.GetOk("ca_cert_file"); ok {
+		config.CACertFile = val.(string)
+	}
+	if val, ok := d.GetOk("ca_cert_pem"); ok {
+		config.CACertPEM = val.(string)
+	}
+	if val, ok := d.GetOk("client_cert"); ok {
+		config.ClientCert = val.(string)
+	}
+	if val, ok := d.GetOk("client_key"); ok {
+		config.ClientKey = val.(string)
+	}
+	if val, ok := d.GetOk("proxy_url"); ok {
+		config.ProxyURL = val.(string)
+	}
+	if val, ok := d.GetOk("no_proxy"); ok {
+		config.NoProxy = val.(string)
+	}
+	if val, ok := d.GetOk("proxy_username"); ok {
+		config.ProxyUsername = val.(string)
+	}
+	if val, ok := d.GetOk("proxy_password"); ok {
+		config.ProxyPassword = val.(string)
+	}
+	// Zero is a meaningful value for max_retries, so GetOk cannot be used.
+	if val, ok := d.GetOkExists("max_retries"); ok {
+		config.MaxRetries = val.(int)
+	}
+	if val, ok := d.GetOk("retry_max_wait"); ok {
+		config.RetryMaxWait = val.(int)
+	}
 
-	client, err := api.APIClient(config)
+	client, err := api.APIClient(ctx, config)
 	if err != nil {
-		return nil, fmt.Errorf("error creating API client: %s", err)
+		return nil, diag.Errorf("error creating API client: %s", err)
 	}
 
 	return client, nil
@@ -1,38 +1,55 @@
 package provider
 
 import (
+	"context"
+	"encoding/pem"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/consoletest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const (
 	PrismacloudcomputeJsonConfigFileEnvVar = "PRISMACLOUDCOMPUTE_CONFIG_FILE"
+	PrismacloudcomputeConsoleURLEnvVar     = "PRISMACLOUDCOMPUTE_CONSOLE_URL"
+	PrismacloudcomputeUsernameEnvVar       = "PRISMACLOUDCOMPUTE_USERNAME"
+	PrismacloudcomputePasswordEnvVar       = "PRISMACLOUDCOMPUTE_PASSWORD"
 )
 
 var (
-	testAccProviders                   map[string]*schema.Provider
-	testAccProvider                    *schema.Provider
-	sessionTimeoutOrig, sessionTimeout int
+	testAccProviders map[string]*schema.Provider
+	testAccProvider  *schema.Provider
 )
 
 func init() {
-	var err error
-
 	testAccProvider = Provider()
 	testAccProviders = map[string]*schema.Provider{
 		"prismacloudcompute": testAccProvider,
 	}
+}
 
-	client := &api.Client{}
-	if err = client.Initialize(os.Getenv(PrismacloudcomputeJsonConfigFileEnvVar)); err == nil {
-		if err != nil {
-			fmt.Printf("Error initializing client")
-		}
+// Run the acceptance tests against an in-process Console unless a live
+// Console is configured through the environment.
+func TestMain(m *testing.M) {
+	if os.Getenv(PrismacloudcomputeJsonConfigFileEnvVar) != "" || os.Getenv(PrismacloudcomputeConsoleURLEnvVar) != "" {
+		os.Exit(m.Run())
 	}
+
+	console := consoletest.NewServer()
+	os.Setenv(PrismacloudcomputeConsoleURLEnvVar, console.URL)
+	os.Setenv(PrismacloudcomputeUsernameEnvVar, console.Username)
+	os.Setenv(PrismacloudcomputePasswordEnvVar, console.Password)
+
+	code := m.Run()
+	console.Close()
+	os.Exit(code)
 }
 
 func TestProvider(t *testing.T) {
@@ -46,7 +63,220 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
-	if os.Getenv(PrismacloudcomputeJsonConfigFileEnvVar) == "" {
-		t.Fatalf("%s must be set for acceptance tests", PrismacloudcomputeJsonConfigFileEnvVar)
+	if os.Getenv(PrismacloudcomputeJsonConfigFileEnvVar) == "" && os.Getenv(PrismacloudcomputeConsoleURLEnvVar) == "" {
+		t.Fatalf("%s or %s must be set for acceptance tests", PrismacloudcomputeJsonConfigFileEnvVar, PrismacloudcomputeConsoleURLEnvVar)
+	}
+}
+
+// Check that updating the named resource fails with a conflict instead of
+// overwriting a change made by another client since the resource was read, as
+// between plan and apply. change makes the change on the Console and returns a
+// function that reverts it.
+func testAccCheckUpdateConflict(n string, r *schema.Resource, change func(context.Context, api.Client) (revert func() error, err error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		ctx := context.Background()
+		client := testAccProvider.Meta().(*api.Client)
+		revert, err := change(ctx, *client)
+		if err != nil {
+			return fmt.Errorf("Error changing %s: %s", n, err)
+		}
+
+		diags := r.UpdateContext(ctx, r.Data(rs.Primary), testAccProvider.Meta())
+		if err := revert(); err != nil {
+			return fmt.Errorf("Error reverting the change of %s: %s", n, err)
+		}
+		if !diags.HasError() {
+			return fmt.Errorf("Update of %s overwrote the change made by another client", n)
+		}
+		if summary := diags[0].Summary; !strings.Contains(summary, "changed by another client") {
+			return fmt.Errorf("Update of %s failed with %q, expected a conflict", n, summary)
+		}
+		return nil
+	}
+}
+
+func TestConfigureRetries(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	configFile := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(configFile, []byte(`{"max_retries": 7, "retry_max_wait": 9}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name         string
+		raw          map[string]interface{}
+		maxRetries   int
+		retryMaxWait int
+	}{
+		{"defaults", map[string]interface{}{}, api.DefaultMaxRetries, api.DefaultRetryMaxWait},
+		{"disabled", map[string]interface{}{"max_retries": 0}, 0, api.DefaultRetryMaxWait},
+		{"arguments", map[string]interface{}{"max_retries": 5, "retry_max_wait": 10}, 5, 10},
+		{"config file", map[string]interface{}{"config_file": configFile}, 7, 9},
+		{"argument over config file", map[string]interface{}{"config_file": configFile, "max_retries": 1}, 1, 9},
+	}
+	for _, tc := range cases {
+		tc.raw["console_url"] = console.URL
+		tc.raw["username"] = console.Username
+		tc.raw["password"] = console.Password
+
+		meta, diags := configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, tc.raw))
+		if diags.HasError() {
+			t.Fatalf("%s: error configuring provider: %v", tc.name, diags)
+		}
+		config := meta.(*api.Client).Config
+		if config.MaxRetries != tc.maxRetries {
+			t.Errorf("%s: max retries is %d, expected %d", tc.name, config.MaxRetries, tc.maxRetries)
+		}
+		if config.RetryMaxWait != tc.retryMaxWait {
+			t.Errorf("%s: retry max wait is %d, expected %d", tc.name, config.RetryMaxWait, tc.retryMaxWait)
+		}
+	}
+}
+
+func TestConfigureCredentials(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	// Credentials from the environment would otherwise apply to every case.
+	t.Setenv(PrismacloudcomputeUsernameEnvVar, "")
+	t.Setenv(PrismacloudcomputePasswordEnvVar, "")
+
+	token := console.IssueToken()
+	configFile := filepath.Join(t.TempDir(), "creds.json")
+	creds := fmt.Sprintf(`{"access_key_id": %q, "secret_key": %q}`, console.AccessKeyID, console.SecretKey)
+	if err := os.WriteFile(configFile, []byte(creds), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name        string
+		raw         map[string]interface{}
+		env         map[string]string
+		username    string
+		accessKeyID string
+		token       string
+	}{
+		{"username", map[string]interface{}{"username": console.Username, "password": console.Password}, nil, console.Username, "", ""},
+		{"access key", map[string]interface{}{"access_key_id": console.AccessKeyID, "secret_key": console.SecretKey}, nil, "", console.AccessKeyID, ""},
+		{"token", map[string]interface{}{"token": token}, nil, "", "", token},
+		{"token from environment", map[string]interface{}{}, map[string]string{"PRISMACLOUDCOMPUTE_TOKEN": token}, "", "", token},
+		{"config file", map[string]interface{}{"config_file": configFile}, nil, "", console.AccessKeyID, ""},
+		{"arguments over config file", map[string]interface{}{"config_file": configFile, "username": console.Username, "password": console.Password}, nil, console.Username, "", ""},
+		{"environment over config file", map[string]interface{}{"config_file": configFile}, map[string]string{"PRISMACLOUDCOMPUTE_TOKEN": token}, "", "", token},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			tc.raw["console_url"] = console.URL
+
+			meta, diags := configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, tc.raw))
+			if diags.HasError() {
+				t.Fatalf("error configuring provider: %v", diags)
+			}
+			client := meta.(*api.Client)
+			if client.Config.Username != tc.username {
+				t.Errorf("username is %q, expected %q", client.Config.Username, tc.username)
+			}
+			if client.Config.AccessKeyID != tc.accessKeyID {
+				t.Errorf("access key ID is %q, expected %q", client.Config.AccessKeyID, tc.accessKeyID)
+			}
+			if client.Config.Token != tc.token {
+				t.Errorf("token is %q, expected %q", client.Config.Token, tc.token)
+			}
+			if client.JWT == "" {
+				t.Errorf("JWT is empty. Authenticate did not work.")
+			}
+		})
+	}
+}
+
+func TestConfigureTLS(t *testing.T) {
+	console := consoletest.NewUnstartedServer()
+	console.StartTLS()
+	defer console.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: console.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	configFile := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(configFile, []byte(`{"skip_cert_verification": true}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		raw  map[string]interface{}
+		ok   bool
+	}{
+		{"verified by default", map[string]interface{}{}, false},
+		{"ca cert file", map[string]interface{}{"ca_cert_file": caFile}, true},
+		{"ca cert pem", map[string]interface{}{"ca_cert_pem": string(caPEM)}, true},
+		{"skip in config file", map[string]interface{}{"config_file": configFile}, true},
+		{"argument over config file", map[string]interface{}{"config_file": configFile, "skip_cert_verification": false}, false},
+	}
+	for _, tc := range cases {
+		tc.raw["console_url"] = console.URL
+		tc.raw["username"] = console.Username
+		tc.raw["password"] = console.Password
+
+		_, diags := configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, tc.raw))
+		if tc.ok && diags.HasError() {
+			t.Errorf("%s: error configuring provider: %v", tc.name, diags)
+		}
+		if !tc.ok && !diags.HasError() {
+			t.Errorf("%s: expected certificate verification to fail", tc.name)
+		}
+	}
+}
+
+func TestConfigureProxy(t *testing.T) {
+	console := consoletest.NewServer()
+	defer console.Close()
+
+	configFile := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(configFile, []byte(`{"proxy_url": "http://file.example.test:3128", "no_proxy": "internal.example.test"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests to the loopback Console never use the proxy, so configure
+	// succeeds regardless of the proxy settings.
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		proxyURL string
+		noProxy  string
+	}{
+		{"unset", map[string]interface{}{}, "", ""},
+		{"arguments", map[string]interface{}{"proxy_url": "http://arg.example.test:8080", "no_proxy": "example.com"}, "http://arg.example.test:8080", "example.com"},
+		{"config file", map[string]interface{}{"config_file": configFile}, "http://file.example.test:3128", "internal.example.test"},
+		{"argument over config file", map[string]interface{}{"config_file": configFile, "proxy_url": "http://arg.example.test:8080"}, "http://arg.example.test:8080", "internal.example.test"},
+	}
+	for _, tc := range cases {
+		tc.raw["console_url"] = console.URL
+		tc.raw["username"] = console.Username
+		tc.raw["password"] = console.Password
+
+		meta, diags := configure(context.Background(), schema.TestResourceDataRaw(t, Provider().Schema, tc.raw))
+		if diags.HasError() {
+			t.Fatalf("%s: error configuring provider: %v", tc.name, diags)
+		}
+		config := meta.(*api.Client).Config
+		if config.ProxyURL != tc.proxyURL {
+			t.Errorf("%s: proxy URL is %q, expected %q", tc.name, config.ProxyURL, tc.proxyURL)
+		}
+		if config.NoProxy != tc.noProxy {
+			t.Errorf("%s: no proxy is %q, expected %q", tc.name, config.NoProxy, tc.noProxy)
+		}
 	}
 }
@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/alertprofile"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlertprofile() *schema.Resource {
@@ -18,6 +22,8 @@ func resourceAlertprofile() *schema.Resource {
 		UpdateContext: updateAlertprofile,
 		DeleteContext: deleteAlertprofile,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -48,7 +54,7 @@ func resourceAlertprofile() *schema.Resource {
 				Optional:    true,
 				MinItems:    1,
 				MaxItems:    1,
-				Description: "Alert Profile configuration, the values depend on the alert profile type",
+				Description: "Send alerts to a webhook",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"url": {
@@ -74,6 +80,338 @@ func resourceAlertprofile() *schema.Resource {
 					},
 				},
 			},
+			"email": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts by email",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the credential to authenticate to the SMTP server with",
+						},
+						"from": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Sender address",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "SMTP server port",
+						},
+						"recipients": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Recipient addresses",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"smtp_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "SMTP server address",
+						},
+						"ssl": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Connect to the SMTP server over SSL",
+						},
+					},
+				},
+			},
+			"gcp_pubsub": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to a Google Cloud Pub/Sub topic",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the GCP credential to publish with",
+						},
+						"topic": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Pub/Sub topic",
+						},
+					},
+				},
+			},
+			"jira": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Open Jira issues for alerts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assignee": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Jira user to assign issues to",
+						},
+						"base_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Jira base URL",
+						},
+						"ca_cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom CA Cert",
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential to authenticate to Jira with",
+						},
+						"issue_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Issue type",
+						},
+						"labels": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Labels to add to issues",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"priority": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Issue priority",
+						},
+						"project_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the project to open issues in",
+						},
+					},
+				},
+			},
+			"pagerduty": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Trigger PagerDuty incidents for alerts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"routing_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Integration key of the PagerDuty service. The Console never returns it, so changes made outside of Terraform are not detected",
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"critical", "error", "warning", "info"}, false),
+							Description:  "Incident severity. Can be set to `critical`, `error`, `warning` or `info`",
+						},
+						"summary": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Incident summary",
+						},
+					},
+				},
+			},
+			"security_center": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to Google Cloud Security Command Center",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the GCP credential to send findings with",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Security Command Center source ID",
+						},
+					},
+				},
+			},
+			"security_hub": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to AWS Security Hub",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "AWS account ID",
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the AWS credential to send findings with",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "AWS region",
+						},
+					},
+				},
+			},
+			"service_now": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Open ServiceNow security incidents for alerts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ServiceNow instance URL",
+						},
+						"assignee": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ServiceNow user to assign incidents to",
+						},
+						"ca_cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom CA Cert",
+						},
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the credential to authenticate to ServiceNow with",
+						},
+					},
+				},
+			},
+			"slack": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to Slack",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channels": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Channels to post alerts to",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"users": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Users to send alerts to",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"webhook_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Slack incoming webhook URL",
+						},
+					},
+				},
+			},
+			"splunk": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to a Splunk HTTP Event Collector",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "HTTP Event Collector token. The Console never returns it, so changes made outside of Terraform are not detected",
+						},
+						"custom_json": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom JSON payload",
+						},
+						"source_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Splunk source type",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "HTTP Event Collector URL",
+						},
+					},
+				},
+			},
+			"sqs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to an AWS SQS queue",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the AWS credential to send messages with",
+						},
+						"queue_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Queue URL",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "AWS region",
+						},
+					},
+				},
+			},
+			"xsoar": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send alerts to Cortex XSOAR",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "XSOAR API key. The Console never returns it, so changes made outside of Terraform are not detected",
+						},
+						"ca_cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom CA Cert",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "XSOAR URL",
+						},
+					},
+				},
+			},
 			"policy": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -798,7 +1136,10 @@ func createAlertprofile(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.Errorf("failed to create Alert Profile '%+v': %s", parsedAlertprofile, err)
 	}
-	if err := alertprofile.CreateAlertprofile(*client, parsedAlertprofile); err != nil {
+	if err := validateAlertProfileCredentials(ctx, *client, d); err != nil {
+		return diag.Errorf("error creating alertprofile '%s': %s", parsedAlertprofile.Name, err)
+	}
+	if err := alertprofile.CreateAlertprofile(ctx, *client, parsedAlertprofile); err != nil {
 		return diag.Errorf("error creating alertprofile '%+v': %s", parsedAlertprofile, err)
 	}
 
@@ -812,25 +1153,21 @@ func readAlertprofile(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	var diags diag.Diagnostics
 
-	retrievedAlertProfile, err := alertprofile.GetAlertprofile(*client, d.Id())
+	retrievedAlertProfile, err := alertprofile.GetAlertprofile(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading alertprofile: %s", err)
 	}
 
 	d.Set("name", retrievedAlertProfile.Name)
 	d.Set("enable_immediate_vulnerabilities_alerts", retrievedAlertProfile.VulnerabilityImmediateAlertsEnabled)
 
-	config := make(map[string]interface{})
-
-	if retrievedAlertProfile.Webhook.Enabled {
-		config["url"] = retrievedAlertProfile.Webhook.Url
-		config["credential_id"] = retrievedAlertProfile.Webhook.CredentialId
-		config["custom_ca"] = retrievedAlertProfile.Webhook.CaCert
-		config["custom_json"] = retrievedAlertProfile.Webhook.Json
-	}
-
-	if err = d.Set("webhook", []interface{}{config}); err != nil {
-		log.Printf("[WARN] Error setting 'webhook' for %s: %s", d.Id(), err)
+	for key, val := range convert.AlertProfileChannelsToSchema(*retrievedAlertProfile) {
+		if err := d.Set(key, alertProfileChannelSecretsFromState(d, key, val)); err != nil {
+			return diag.Errorf("error reading alertprofile: %s", err)
+		}
 	}
 
 	alertTriggerPolicies := convert.AlertProfilePoliciesToSchema(&retrievedAlertProfile.Policy)
@@ -849,8 +1186,11 @@ func updateAlertprofile(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.Errorf("failed to update Alert Profile '%+v': %s", parsedAlertprofile, err)
 	}
+	if err := validateAlertProfileCredentials(ctx, *client, d); err != nil {
+		return diag.Errorf("error updating alertprofile '%s': %s", d.Id(), err)
+	}
 
-	if err := alertprofile.UpdateAlertprofile(*client, parsedAlertprofile); err != nil {
+	if err := alertprofile.UpdateAlertprofile(ctx, *client, parsedAlertprofile); err != nil {
 		return diag.Errorf("error updating alertprofile '%s': %s", d.Id(), err)
 	}
 
@@ -862,7 +1202,7 @@ func deleteAlertprofile(ctx context.Context, d *schema.ResourceData, meta interf
 
 	var diags diag.Diagnostics
 
-	if err := alertprofile.DeleteAlertprofile(*client, d.Id()); err != nil {
+	if err := alertprofile.DeleteAlertprofile(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting alertprofile '%s': %s", d.Id(), err)
 	}
 
@@ -871,6 +1211,74 @@ func deleteAlertprofile(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+// Secret attributes of alert channels, keyed by channel block.
+var alertProfileChannelSecrets = map[string]string{
+	"pagerduty": "routing_key",
+	"splunk":    "auth_token",
+	"xsoar":     "api_key",
+}
+
+// The Console only returns the encrypted form of channel secrets, so keep the
+// configured values to avoid a perpetual diff.
+func alertProfileChannelSecretsFromState(d *schema.ResourceData, key string, channel []interface{}) []interface{} {
+	secret, ok := alertProfileChannelSecrets[key]
+	if !ok || len(channel) == 0 {
+		return channel
+	}
+	m := channel[0].(map[string]interface{})
+	if m[secret] == "" {
+		m[secret] = d.Get(key + ".0." + secret)
+	}
+	return channel
+}
+
+// Credential types that alert channels can authenticate with. Channels that
+// are not listed, or list no types, take credentials of any type.
+var alertProfileCredentialTypes = []struct {
+	channel string
+	types   []string
+}{
+	{channel: "email"},
+	{channel: "gcp_pubsub", types: []string{"gcp"}},
+	{channel: "jira"},
+	{channel: "security_center", types: []string{"gcp"}},
+	{channel: "security_hub", types: []string{"aws"}},
+	{channel: "service_now"},
+	{channel: "sqs", types: []string{"aws"}},
+	{channel: "webhook"},
+}
+
+// Check that the credentials referenced by the alert channels exist in the
+// credentials store and are of a type the channel can use, so that a typo
+// fails the apply instead of silently breaking alerts.
+func validateAlertProfileCredentials(ctx context.Context, client api.Client, d *schema.ResourceData) error {
+	var credentials map[string]auth.Credential
+	for _, val := range alertProfileCredentialTypes {
+		id := d.Get(val.channel + ".0.credential_id").(string)
+		if id == "" {
+			continue
+		}
+		if credentials == nil {
+			items, err := auth.ListCredentials(ctx, client)
+			if err != nil {
+				return err
+			}
+			credentials = make(map[string]auth.Credential, len(items))
+			for _, item := range items {
+				credentials[item.Id] = item
+			}
+		}
+		credential, ok := credentials[id]
+		if !ok {
+			return fmt.Errorf("%s credential '%s' not found", val.channel, id)
+		}
+		if len(val.types) > 0 && !stringInSlice(val.types, credential.Type) {
+			return fmt.Errorf("%s credential '%s' is of type '%s', expected %s", val.channel, id, credential.Type, strings.Join(val.types, " or "))
+		}
+	}
+	return nil
+}
+
 func stringInSlice(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -18,6 +18,8 @@ func resourceCloudAccount() *schema.Resource {
 		UpdateContext: updateCloudAccount,
 		DeleteContext: deleteCloudAccount,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -334,7 +336,7 @@ func createCloudAccount(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.Errorf("failed to create cloud account credential '%+v': %s", parsedCredential, err)
 	}
-	if err := auth.UpdateCredential(*client, parsedCredential); err != nil {
+	if err := auth.UpdateCredential(ctx, *client, parsedCredential); err != nil {
 		return diag.Errorf("error creating cloud account credential '%+v': %s", parsedCredential, err)
 	}
 
@@ -344,7 +346,7 @@ func createCloudAccount(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("failed to create cloud scan rule '%+v': %s", parsedCloudScanRule, err)
 	}
 	scanRules = append(scanRules, parsedCloudScanRule)
-	if err := account.UpdateCloudScanRule(*client, scanRules); err != nil {
+	if err := account.UpdateCloudScanRule(ctx, *client, scanRules); err != nil {
 		return diag.Errorf("error creating cloud account '%+v': %s", parsedCloudScanRule, err)
 	}
 
@@ -358,8 +360,11 @@ func readCloudAccount(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	var diags diag.Diagnostics
 
-	retrievedCloudScanRule, err := account.GetCloudScanRule(*client, d.Id())
+	retrievedCloudScanRule, err := account.GetCloudScanRule(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading cloud account: %s", err)
 	}
 
@@ -394,7 +399,7 @@ func updateCloudAccount(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return diag.Errorf("failed to parse cloud account credential '%+v': %s", parsedCloudAccountCredential, err)
 	}
-	if err := auth.UpdateCredential(*client, parsedCloudAccountCredential); err != nil {
+	if err := auth.UpdateCredential(ctx, *client, parsedCloudAccountCredential); err != nil {
 		return diag.Errorf("error updating cloud account credential '%s': %s", d.Id(), err)
 	}
 
@@ -405,7 +410,7 @@ func updateCloudAccount(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	scanRules = append(scanRules, parsedCloudScanRule)
 
-	if err := account.UpdateCloudScanRule(*client, scanRules); err != nil {
+	if err := account.UpdateCloudScanRule(ctx, *client, scanRules); err != nil {
 		return diag.Errorf("error updating cloud scan rule '%s': %s", d.Id(), err)
 	}
 
@@ -417,11 +422,11 @@ func deleteCloudAccount(ctx context.Context, d *schema.ResourceData, meta interf
 
 	var diags diag.Diagnostics
 
-	if err := account.DeleteCloudScanRule(*client, d.Id()); err != nil {
+	if err := account.DeleteCloudScanRule(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting credential: %s", err)
 	}
 
-	if err := auth.DeleteCredential(*client, d.Id()); err != nil {
+	if err := auth.DeleteCredential(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting credential: %s", err)
 	}
 
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCnnsEntity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createCnnsEntity,
+		ReadContext:   readCnnsEntity,
+		UpdateContext: updateCnnsEntity,
+		DeleteContext: deleteCnnsEntity,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the network entity.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"collections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Collections of the containers or hosts in the entity. Only used by 'container' and 'host' entities.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A free-form text description of the network entity.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS name of the entity. Only used by 'dns' entities.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A unique network entity name, referenced by the rules of the CNNS policy.",
+			},
+			"subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Subnet of the entity in CIDR notation. Only used by 'subnet' entities.",
+				ValidateFunc: validation.IsCIDR,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of the network entity. Can be set to 'container', 'host', 'subnet', or 'dns'.",
+				ValidateFunc: validation.StringInSlice([]string{
+					policy.CnnsEntityTypeContainer,
+					policy.CnnsEntityTypeHost,
+					policy.CnnsEntityTypeSubnet,
+					policy.CnnsEntityTypeDns,
+				}, false),
+			},
+		},
+	}
+}
+
+func createCnnsEntity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedEntity := convert.SchemaToCnnsEntity(d)
+	if err := policy.CreateCnnsEntity(ctx, *client, parsedEntity); err != nil {
+		return diag.Errorf("error creating network entity '%s': %s", parsedEntity.Name, err)
+	}
+
+	d.SetId(parsedEntity.Name)
+
+	return readCnnsEntity(ctx, d, meta)
+}
+
+func readCnnsEntity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedEntity, err := policy.GetCnnsEntity(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading network entity: %s", err)
+	}
+
+	if err := d.Set("collections", convert.CollectionsToPolicySchema(retrievedEntity.Collections)); err != nil {
+		return diag.Errorf("error reading network entity: %s", err)
+	}
+	d.Set("description", retrievedEntity.Description)
+	d.Set("domain", retrievedEntity.Domain)
+	d.Set("name", retrievedEntity.Name)
+	d.Set("subnet", retrievedEntity.Subnet)
+	d.Set("type", retrievedEntity.Type)
+
+	return diags
+}
+
+func updateCnnsEntity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedEntity := convert.SchemaToCnnsEntity(d)
+
+	if err := policy.UpdateCnnsEntity(ctx, *client, parsedEntity); err != nil {
+		return diag.Errorf("error updating network entity: %s", err)
+	}
+
+	return readCnnsEntity(ctx, d, meta)
+}
+
+func deleteCnnsEntity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteCnnsEntity(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting network entity '%s': %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCnnsEntityConfig(t *testing.T) {
+	var o policy.CnnsEntity
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCnnsEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCnnsEntityConfig(name, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCnnsEntityExists("prismacloudcompute_cnns_entity.test", &o),
+					testAccCheckCnnsEntityAttributes(&o, name, "10.0.0.0/16"),
+				),
+			},
+			{
+				Config: testAccCnnsEntityConfig(name, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCnnsEntityExists("prismacloudcompute_cnns_entity.test", &o),
+					testAccCheckCnnsEntityAttributes(&o, name, "10.1.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_cnns_entity.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCnnsEntityDisappears(t *testing.T) {
+	var o policy.CnnsEntity
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCnnsEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCnnsEntityConfig(name, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCnnsEntityExists("prismacloudcompute_cnns_entity.test", &o),
+					testAccCheckCnnsEntityDisappears(name),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCnnsEntityExists(n string, o *policy.CnnsEntity) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object label Name is not set")
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetCnnsEntity(context.Background(), *client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = *lo
+
+		return nil
+	}
+}
+
+func testAccCheckCnnsEntityAttributes(o *policy.CnnsEntity, name, subnet string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Type != policy.CnnsEntityTypeSubnet {
+			return fmt.Errorf("Type is %s, expected %s", o.Type, policy.CnnsEntityTypeSubnet)
+		}
+
+		if o.Subnet != subnet {
+			return fmt.Errorf("Subnet is %s, expected %s", o.Subnet, subnet)
+		}
+
+		return nil
+	}
+}
+
+// Delete the network entity outside of Terraform.
+func testAccCheckCnnsEntityDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		return policy.DeleteCnnsEntity(context.Background(), *client, name)
+	}
+}
+
+func testAccCnnsEntityDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_cnns_entity" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			name := rs.Primary.ID
+			if _, err := policy.GetCnnsEntity(context.Background(), *client, name); err == nil {
+				return fmt.Errorf("Object %q still exists", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCnnsEntityConfig(name, subnet string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_cnns_entity" "test" {
+	name        = %q
+	type        = "subnet"
+	subnet      = %q
+	description = "Office network"
+}`, name, subnet)
+}
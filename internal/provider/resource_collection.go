@@ -17,6 +17,8 @@ func resourceCollection() *schema.Resource {
 		UpdateContext: updateCollection,
 		DeleteContext: deleteCollection,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -30,7 +32,7 @@ func resourceCollection() *schema.Resource {
 			"account_ids": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted cloud account IDs.",
+				Description: "Targeted cloud account IDs. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -38,7 +40,7 @@ func resourceCollection() *schema.Resource {
 			"application_ids": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted application IDs (for app-embedded). Values must end in a wildcard (*).",
+				Description: "Targeted application IDs (for app-embedded). Values must end in a wildcard (*). Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -46,7 +48,7 @@ func resourceCollection() *schema.Resource {
 			"clusters": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted cluster names.",
+				Description: "Targeted cluster names. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -54,7 +56,7 @@ func resourceCollection() *schema.Resource {
 			"code_repositories": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted code repositories.",
+				Description: "Targeted code repositories. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -68,7 +70,7 @@ func resourceCollection() *schema.Resource {
 			"containers": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted containers.",
+				Description: "Targeted containers. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -81,7 +83,7 @@ func resourceCollection() *schema.Resource {
 			"functions": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted functions.",
+				Description: "Targeted functions. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -89,7 +91,7 @@ func resourceCollection() *schema.Resource {
 			"hosts": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted hosts.",
+				Description: "Targeted hosts. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -97,7 +99,7 @@ func resourceCollection() *schema.Resource {
 			"images": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted images.",
+				Description: "Targeted images. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -105,7 +107,7 @@ func resourceCollection() *schema.Resource {
 			"labels": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted labels.",
+				Description: "Targeted labels. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -113,16 +115,27 @@ func resourceCollection() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "A unique collection name.",
 			},
 			"namespaces": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Targeted cluster namespaces.",
+				Description: "Targeted cluster namespaces. Defaults to all (`*`).",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"prisma": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the collection is managed by Prisma Cloud.",
+			},
+			"system": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the collection is built into the Console.",
+			},
 		},
 	}
 }
@@ -130,7 +143,7 @@ func resourceCollection() *schema.Resource {
 func createCollection(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 	parsedCollection := convert.SchemaToCollection(d)
-	if err := collection.CreateCollection(*client, parsedCollection); err != nil {
+	if err := collection.CreateCollection(ctx, *client, parsedCollection); err != nil {
 		return diag.Errorf("error creating collection '%+v': %s", parsedCollection, err)
 	}
 
@@ -144,54 +157,81 @@ func readCollection(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	var diags diag.Diagnostics
 
-	retrievedCollection, err := collection.GetCollection(*client, d.Id())
+	retrievedCollection, err := collection.GetCollection(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading collection: %s", err)
 	}
 
-	if err := d.Set("account_ids", retrievedCollection.AccountIds); err != nil {
+	if err := d.Set("account_ids", collectionFilterToSchema(d, "account_ids", retrievedCollection.AccountIds)); err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+	if err := d.Set("application_ids", collectionFilterToSchema(d, "application_ids", retrievedCollection.AppIds)); err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+	if err := d.Set("clusters", collectionFilterToSchema(d, "clusters", retrievedCollection.Clusters)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("application_ids", retrievedCollection.AppIds); err != nil {
+	if err := d.Set("code_repositories", collectionFilterToSchema(d, "code_repositories", retrievedCollection.CodeRepos)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("clusters", retrievedCollection.Clusters); err != nil {
+	if err := d.Set("color", retrievedCollection.Color); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("code_repositories", retrievedCollection.CodeRepos); err != nil {
+	if err := d.Set("containers", collectionFilterToSchema(d, "containers", retrievedCollection.Containers)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	d.Set("color", retrievedCollection.Color)
-	if err := d.Set("containers", retrievedCollection.Containers); err != nil {
+	if err := d.Set("description", retrievedCollection.Description); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	d.Set("description", retrievedCollection.Description)
-	if err := d.Set("functions", retrievedCollection.Functions); err != nil {
+	if err := d.Set("functions", collectionFilterToSchema(d, "functions", retrievedCollection.Functions)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("hosts", retrievedCollection.Hosts); err != nil {
+	if err := d.Set("hosts", collectionFilterToSchema(d, "hosts", retrievedCollection.Hosts)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("images", retrievedCollection.Images); err != nil {
+	if err := d.Set("images", collectionFilterToSchema(d, "images", retrievedCollection.Images)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	if err := d.Set("labels", retrievedCollection.Labels); err != nil {
+	if err := d.Set("labels", collectionFilterToSchema(d, "labels", retrievedCollection.Labels)); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
-	d.Set("name", retrievedCollection.Name)
-	if err := d.Set("namespaces", retrievedCollection.Namespaces); err != nil {
+	if err := d.Set("name", retrievedCollection.Name); err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+	if err := d.Set("namespaces", collectionFilterToSchema(d, "namespaces", retrievedCollection.Namespaces)); err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+	if err := d.Set("prisma", retrievedCollection.Prisma); err != nil {
+		return diag.Errorf("error reading collection: %s", err)
+	}
+	if err := d.Set("system", retrievedCollection.System); err != nil {
 		return diag.Errorf("error reading collection: %s", err)
 	}
 
 	return diags
 }
 
+// Get the value of a collection filter for the state. Targeting all
+// resources (`*`) is the default, so it is left out of the state unless it
+// is already there because it was configured.
+func collectionFilterToSchema(d *schema.ResourceData, key string, in []string) []string {
+	if len(in) == 1 && in[0] == "*" {
+		if current := d.Get(key).([]interface{}); len(current) != 1 || current[0] != "*" {
+			return nil
+		}
+	}
+	return in
+}
+
 func updateCollection(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	parsedCollection := convert.SchemaToCollection(d)
 
-	if err := collection.UpdateCollection(*client, parsedCollection); err != nil {
+	if err := collection.UpdateCollection(ctx, *client, parsedCollection); err != nil {
 		return diag.Errorf("error updating collection: %s", err)
 	}
 
@@ -203,7 +243,7 @@ func deleteCollection(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	var diags diag.Diagnostics
 
-	if err := collection.DeleteCollection(*client, d.Id()); err != nil {
+	if err := collection.DeleteCollection(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error updating collection '%s': %s", d.Id(), err)
 	}
 
@@ -1,8 +1,9 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
@@ -14,7 +15,6 @@ import (
 )
 
 func TestAccCollectionConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccCollectionConfig")
 	var o collection.Collection
 	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
@@ -24,51 +24,40 @@ func TestAccCollectionConfig(t *testing.T) {
 		CheckDestroy: testAccCollectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCollectionConfig(name),
+				Config: testAccCollectionConfig(name, "first description", "#000000"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
+					testAccCheckCollectionAttributes(&o, name, "first description", "#000000"),
 				),
 			},
 			{
-				Config: testAccCollectionConfig(name),
+				Config: testAccCollectionConfig(name, "second description", "#FFFFFF"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
+					testAccCheckCollectionAttributes(&o, name, "second description", "#FFFFFF"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccCollectionNetwork(t *testing.T) {
-	var o collection.Collection
-	name := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCollectionDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccCollectionConfig(name),
+				// Filters removed from the configuration target all
+				// resources again.
+				Config: testAccCollectionUnfilteredConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
+					testAccCheckCollectionImages(&o, []string{"*"}),
+					resource.TestCheckResourceAttr("prismacloudcompute_collection.test", "images.#", "0"),
+					resource.TestCheckResourceAttr("prismacloudcompute_collection.test", "hosts.#", "0"),
 				),
 			},
 			{
-				Config: testAccCollectionConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
-				),
+				ResourceName:      "prismacloudcompute_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func TestAccCollectionAuditEvent(t *testing.T) {
+func TestAccCollectionDisappears(t *testing.T) {
 	var o collection.Collection
 	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
@@ -78,18 +67,12 @@ func TestAccCollectionAuditEvent(t *testing.T) {
 		CheckDestroy: testAccCollectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCollectionConfig(name),
+				Config: testAccCollectionConfig(name, "first description", "#000000"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
-				),
-			},
-			{
-				Config: testAccCollectionConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCollectionExists("prismacloudcompute_collection.test", &o),
-					testAccCheckCollectionAttributes(&o, name, "description", "#000000"),
+					testAccCheckCollectionDisappears(name),
 				),
+				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
@@ -97,8 +80,6 @@ func TestAccCollectionAuditEvent(t *testing.T) {
 
 func testAccCheckCollectionExists(n string, o *collection.Collection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.Name)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
@@ -110,11 +91,11 @@ func testAccCheckCollectionExists(n string, o *collection.Collection) resource.T
 
 		client := testAccProvider.Meta().(*api.Client)
 		name := rs.Primary.ID
-		lo, err := collection.Get(*client, name)
+		lo, err := collection.GetCollection(context.Background(), *client, name)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
-		o = lo
+		*o = *lo
 
 		return nil
 	}
@@ -123,9 +104,7 @@ func testAccCheckCollectionExists(n string, o *collection.Collection) resource.T
 func testAccCheckCollectionAttributes(o *collection.Collection, name string, description string, color string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if o.Name != name {
-			return fmt.Errorf("\n\nName is %s, expected %s", o.Name, name)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Name)
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
 		}
 
 		if o.Description != description {
@@ -140,35 +119,65 @@ func testAccCheckCollectionAttributes(o *collection.Collection, name string, des
 	}
 }
 
+func testAccCheckCollectionImages(o *collection.Collection, images []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !reflect.DeepEqual(o.Images, images) {
+			return fmt.Errorf("Images are %v, expected %v", o.Images, images)
+		}
+
+		return nil
+	}
+}
+
+// Delete the collection outside of Terraform.
+func testAccCheckCollectionDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		return collection.DeleteCollection(context.Background(), *client, name)
+	}
+}
+
 func testAccCollectionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
 	for _, rs := range s.RootModule().Resources {
-
 		if rs.Type != "prismacloudcompute_collection" {
 			continue
 		}
 
 		if rs.Primary.ID != "" {
 			name := rs.Primary.ID
-			if err := collection.Delete(*client, name); err == nil {
+			if _, err := collection.GetCollection(context.Background(), *client, name); err == nil {
 				return fmt.Errorf("Object %q still exists", name)
 			}
 		}
-		return nil
 	}
 
 	return nil
 }
 
-func testAccCollectionConfig(name string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_collections" "test" {
-    name = %q
-}`, name))
+func testAccCollectionConfig(name, description, color string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_collection" "test" {
+	name              = %q
+	description       = %q
+	color             = %q
+	account_ids       = ["*"]
+	application_ids   = ["*"]
+	clusters          = ["*"]
+	code_repositories = ["*"]
+	containers        = ["*"]
+	functions         = ["*"]
+	hosts             = ["*"]
+	images            = ["nginx:*"]
+	labels            = ["*"]
+	namespaces        = ["*"]
+}`, name, description, color)
+}
 
-	return buf.String()
+func testAccCollectionUnfilteredConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_collection" "test" {
+	name = %q
+}`, name)
 }
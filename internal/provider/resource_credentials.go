@@ -17,6 +17,8 @@ func resourceCredentials() *schema.Resource {
 		UpdateContext: updateCredentials,
 		DeleteContext: deleteCredentials,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -139,7 +141,7 @@ func createCredentials(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error converting schema to credential: %s", err)
 	}
 
-	if err := auth.UpdateCredential(*client, parsedCredential); err != nil {
+	if err := auth.UpdateCredential(ctx, *client, parsedCredential); err != nil {
 		return diag.Errorf("error creating credential '%v': %s", parsedCredential.Id, err)
 	}
 	d.SetId(parsedCredential.Id)
@@ -151,14 +153,17 @@ func readCredentials(ctx context.Context, d *schema.ResourceData, meta interface
 
 	var diags diag.Diagnostics
 
-	retrievedCredential, err := auth.GetCredential(*client, d.Id())
+	retrievedCredential, err := auth.GetCredential(ctx, *client, d.Id())
 
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error getting credential '%s' from Console: %s", d.Id(), err)
 	}
 
 	d.Set("account_id", retrievedCredential.AccountID)
-	if err := d.Set("api_token", convert.CredentialSecretToSchema(retrievedCredential.ApiToken)); err != nil {
+	if err := d.Set("api_token", credentialSecretToSchema(d, "api_token", retrievedCredential.ApiToken)); err != nil {
 		return diag.Errorf("error converting credential secret to schema: %s", err)
 	}
 	d.Set("ca_cert", retrievedCredential.CaCert)
@@ -167,18 +172,31 @@ func readCredentials(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("ibm_account_guid", retrievedCredential.AccountGUID)
 	d.Set("name", retrievedCredential.Id)
 	d.Set("role_arn", retrievedCredential.RoleArn)
-	if err := d.Set("secret", convert.CredentialSecretToSchema(retrievedCredential.Secret)); err != nil {
+	if err := d.Set("secret", credentialSecretToSchema(d, "secret", retrievedCredential.Secret)); err != nil {
 		return diag.Errorf("error converting credential secret to schema: %s", err)
 	}
 	d.Set("skip_cert_verification", retrievedCredential.SkipVerify)
 	d.Set("type", retrievedCredential.Type)
 	d.Set("url", retrievedCredential.Url)
 	d.Set("use_aws_role", retrievedCredential.UseAWSRole)
-	d.Set("use_sts_regional_endpoint", retrievedCredential.UseAWSRole)
+	d.Set("use_sts_regional_endpoint", retrievedCredential.UseSTSRegionalEndpoint)
 
 	return diags
 }
 
+// The Console only returns the encrypted form of a secret, so keep the
+// configured plain text value to avoid a perpetual diff.
+func credentialSecretToSchema(d *schema.ResourceData, key string, in auth.Secret) []interface{} {
+	if in.Encrypted == "" && in.Plain == "" {
+		return nil
+	}
+	ans := convert.CredentialSecretToSchema(in)
+	if in.Plain == "" {
+		ans[0].(map[string]interface{})["plain"] = d.Get(key + ".0.plain")
+	}
+	return ans
+}
+
 func updateCredentials(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -187,7 +205,7 @@ func updateCredentials(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error parsing schema to credential: %s", err)
 	}
 
-	if err := auth.UpdateCredential(*client, parsedCredential); err != nil {
+	if err := auth.UpdateCredential(ctx, *client, parsedCredential); err != nil {
 		return diag.Errorf("error updating credential: %s", err)
 	}
 
@@ -199,7 +217,7 @@ func deleteCredentials(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	var diags diag.Diagnostics
 
-	if err := auth.DeleteCredential(*client, d.Id()); err != nil {
+	if err := auth.DeleteCredential(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting credential: %s", err)
 	}
 
@@ -17,6 +17,8 @@ func resourceCustomCompliance() *schema.Resource {
 		UpdateContext: updateCustomCompliance,
 		DeleteContext: deleteCustomCompliance,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -59,7 +61,7 @@ func resourceCustomCompliance() *schema.Resource {
 func createCustomCompliance(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 	parsedCustomCompliance := convert.SchemaToCustomCompliance(d)
-	err := policy.CreateCustomCompliance(*client, parsedCustomCompliance)
+	err := policy.CreateCustomCompliance(ctx, *client, parsedCustomCompliance)
 
 	if err != nil {
 		return diag.Errorf("error creating custom Compliance '%+v': %s", parsedCustomCompliance, err)
@@ -71,8 +73,11 @@ func createCustomCompliance(ctx context.Context, d *schema.ResourceData, meta in
 
 func readCustomCompliance(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
-	retrievedCustomCompliance, err := policy.GetCustomComplianceByName(*client, d.Id())
+	retrievedCustomCompliance, err := policy.GetCustomComplianceByName(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return nil
+		}
 		return diag.Errorf("error reading custom Compliance: %s", err)
 	}
 
@@ -99,7 +104,7 @@ func updateCustomCompliance(ctx context.Context, d *schema.ResourceData, meta in
 	client := meta.(*api.Client)
 	parsedCustomCompliance := convert.SchemaToCustomCompliance(d)
 
-	if err := policy.UpdateCustomCompliance(*client, parsedCustomCompliance); err != nil {
+	if err := policy.UpdateCustomCompliance(ctx, *client, parsedCustomCompliance); err != nil {
 		return diag.Errorf("error updating custom Compliance: %s", err)
 	}
 
@@ -111,7 +116,7 @@ func deleteCustomCompliance(ctx context.Context, d *schema.ResourceData, meta in
 
 	var diags diag.Diagnostics
 
-	if err := policy.DeleteCustomCompliance(*client, d.Id()); err != nil {
+	if err := policy.DeleteCustomCompliance(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting custom Compliance '%s': %s", d.Id(), err)
 	}
 
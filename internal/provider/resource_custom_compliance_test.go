@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +14,6 @@ import (
 )
 
 func TestAccCustomComplianceConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccCustomComplianceConfig")
 	var o policy.CustomCompliance
 	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
@@ -24,71 +23,17 @@ func TestAccCustomComplianceConfig(t *testing.T) {
 		CheckDestroy: testAccCustomComplianceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCustomComplianceConfig(name),
+				Config: testAccCustomComplianceConfig(name, "high"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
+					testAccCheckCustomComplianceExists("prismacloudcompute_custom_compliance.test", &o),
+					testAccCheckCustomComplianceAttributes(&o, name, "high"),
 				),
 			},
 			{
-				Config: testAccCustomComplianceConfig(name),
+				Config: testAccCustomComplianceConfig(name, "critical"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccCustomComplianceNetwork(t *testing.T) {
-	var o policy.CustomCompliance
-	name := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCustomComplianceDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccCustomComplianceConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
-				),
-			},
-			{
-				Config: testAccCustomComplianceConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccCustomComplianceAuditEvent(t *testing.T) {
-	var o policy.CustomCompliance
-	name := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCustomComplianceDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccCustomComplianceConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
-				),
-			},
-			{
-				Config: testAccCustomComplianceConfig(name),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCustomComplianceExists("prismacloudcompute_custom_Compliance.test", &o),
-					testAccCheckCustomComplianceAttributes(&o, name, "description", "#000000"),
+					testAccCheckCustomComplianceExists("prismacloudcompute_custom_compliance.test", &o),
+					testAccCheckCustomComplianceAttributes(&o, name, "critical"),
 				),
 			},
 		},
@@ -97,8 +42,6 @@ func TestAccCustomComplianceAuditEvent(t *testing.T) {
 
 func testAccCheckCustomComplianceExists(n string, o *policy.CustomCompliance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.Name)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
@@ -110,22 +53,28 @@ func testAccCheckCustomComplianceExists(n string, o *policy.CustomCompliance) re
 
 		client := testAccProvider.Meta().(*api.Client)
 		name := rs.Primary.ID
-		lo, err := policy.Get(*client, name)
+		lo, err := policy.GetCustomComplianceByName(context.Background(), *client, name)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
-		o = lo
+		*o = *lo
 
 		return nil
 	}
 }
 
-func testAccCheckCustomComplianceAttributes(o *policy.CustomCompliance, name string, description string, color string) resource.TestCheckFunc {
+func testAccCheckCustomComplianceAttributes(o *policy.CustomCompliance, name string, severity string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if o.Name != name {
-			return fmt.Errorf("\n\nName is %s, expected %s", o.Name, name)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Name)
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Severity != severity {
+			return fmt.Errorf("Severity is %s, expected %s", o.Severity, severity)
+		}
+
+		if o.Id == 0 {
+			return fmt.Errorf("ID was not assigned")
 		}
 
 		return nil
@@ -136,31 +85,27 @@ func testAccCustomComplianceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
 	for _, rs := range s.RootModule().Resources {
-
-		if rs.Type != "prismacloudcompute_custom_Compliance" {
+		if rs.Type != "prismacloudcompute_custom_compliance" {
 			continue
 		}
 
 		if rs.Primary.ID != "" {
 			name := rs.Primary.ID
-			if err := policy.Delete(*client, name); err == nil {
+			if _, err := policy.GetCustomComplianceByName(context.Background(), *client, name); err == nil {
 				return fmt.Errorf("Object %q still exists", name)
 			}
 		}
-		return nil
 	}
 
 	return nil
 }
 
-func testAccCustomComplianceConfig(name string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_custom_Compliances" "test" {
-    name = %q
-}`, name))
-
-	return buf.String()
+func testAccCustomComplianceConfig(name, severity string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_custom_compliance" "test" {
+	name     = %q
+	title    = "Check that /etc/passwd is not world writable"
+	severity = %q
+	script   = "if [ $(stat -c %%a /etc/passwd) -gt 644 ]; then exit 1; fi"
+}`, name, severity)
 }
@@ -20,6 +20,8 @@ func resourceCustomRule() *schema.Resource {
 		UpdateContext: updateCustomRule,
 		DeleteContext: deleteCustomRule,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 
@@ -112,7 +114,7 @@ func CustomRuleParseId(id string) (string, string, error) {
 func createCustomRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 	parsedCustomRule := convert.SchemaToCustomRule(d)
-	id, err := rule.CreateCustomRule(*client, parsedCustomRule)
+	id, err := rule.CreateCustomRule(ctx, *client, parsedCustomRule)
 
 	if err != nil {
 		return diag.Errorf("error creating custom rule '%+v': %s", parsedCustomRule, err)
@@ -129,9 +131,12 @@ func readCustomRule(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	var diags diag.Diagnostics
 
-	retrievedCustomRule, err := rule.GetCustomRuleByName(*client, d.Id())
+	retrievedCustomRule, err := rule.GetCustomRuleByName(ctx, *client, d.Id())
 
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading custom rule: %s", err)
 	}
 
@@ -161,7 +166,7 @@ func updateCustomRule(ctx context.Context, d *schema.ResourceData, meta interfac
 	client := meta.(*api.Client)
 	parsedCustomRule := convert.SchemaToCustomRule(d)
 
-	if err := rule.UpdateCustomRule(*client, parsedCustomRule); err != nil {
+	if err := rule.UpdateCustomRule(ctx, *client, parsedCustomRule); err != nil {
 		return diag.Errorf("error updating custom rule: %s", err)
 	}
 
@@ -173,7 +178,7 @@ func deleteCustomRule(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	var diags diag.Diagnostics
 
-	if err := rule.DeleteCustomRule(*client, d.Get("prisma_id").(int)); err != nil {
+	if err := rule.DeleteCustomRule(ctx, *client, d.Get("prisma_id").(int)); err != nil {
 		return diag.Errorf("error updating custom rule '%s': %s", d.Id(), err)
 	}
 
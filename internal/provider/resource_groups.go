@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +19,8 @@ func resourceGroups() *schema.Resource {
 		UpdateContext: updateGroup,
 		DeleteContext: deleteGroup,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -103,7 +107,11 @@ func createGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("error creating group '%+v': %s", parsedGroup, err)
 	}
 
-	if err := auth.CreateGroup(*client, parsedGroup); err != nil {
+	if err := validateGroupIdentityProvider(ctx, *client, parsedGroup); err != nil {
+		return diag.Errorf("error creating group '%+v': %s", parsedGroup, err)
+	}
+
+	if err := auth.CreateGroup(ctx, *client, parsedGroup); err != nil {
 		return diag.Errorf("error creating group '%+v': %s", parsedGroup, err)
 	}
 
@@ -116,8 +124,11 @@ func readGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 
 	var diags diag.Diagnostics
 
-	retrievedGroup, err := auth.GetGroup(*client, d.Id())
+	retrievedGroup, err := auth.GetGroup(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading group: %s", err)
 	}
 
@@ -144,7 +155,11 @@ func updateGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("error updating group: %s", err)
 	}
 
-	if err := auth.UpdateGroup(*client, parsedGroup); err != nil {
+	if err := validateGroupIdentityProvider(ctx, *client, parsedGroup); err != nil {
+		return diag.Errorf("error updating group: %s", err)
+	}
+
+	if err := auth.UpdateGroup(ctx, *client, parsedGroup); err != nil {
 		return diag.Errorf("error updating group: %s", err)
 	}
 
@@ -156,10 +171,64 @@ func deleteGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	var diags diag.Diagnostics
 
-	if err := auth.DeleteGroup(*client, d.Id()); err != nil {
+	if err := auth.DeleteGroup(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting group '%s': %s", d.Id(), err)
 	}
 
 	d.SetId("")
 	return diags
 }
+
+// Identity providers that groups can be mapped from, with the resource that
+// configures each of them.
+var groupIdentityProviders = []struct {
+	attribute string
+	name      string
+	resource  string
+	isGroup   func(auth.Group) bool
+	enabled   func(context.Context, api.Client) (bool, error)
+}{
+	{"ldap_group", "LDAP", "prismacloudcompute_ldap_settings",
+		func(g auth.Group) bool { return g.LdapGroup },
+		func(ctx context.Context, c api.Client) (bool, error) {
+			s, err := settings.GetLdapSettings(ctx, c)
+			return s.Enabled, err
+		}},
+	{"oauth_group", "OAuth", "prismacloudcompute_oauth_settings",
+		func(g auth.Group) bool { return g.OauthGroup },
+		func(ctx context.Context, c api.Client) (bool, error) {
+			s, err := settings.GetOauthSettings(ctx, c)
+			return s.Enabled, err
+		}},
+	{"oidc_group", "OpenID Connect", "prismacloudcompute_oidc_settings",
+		func(g auth.Group) bool { return g.OidcGroup },
+		func(ctx context.Context, c api.Client) (bool, error) {
+			s, err := settings.GetOidcSettings(ctx, c)
+			return s.Enabled, err
+		}},
+	{"saml_group", "SAML", "prismacloudcompute_saml_settings",
+		func(g auth.Group) bool { return g.SamlGroup },
+		func(ctx context.Context, c api.Client) (bool, error) {
+			s, err := settings.GetSamlSettings(ctx, c)
+			return s.Enabled, err
+		}},
+}
+
+// Check that the identity provider a group is mapped from is enabled, so that
+// a group applied before the identity provider settings fails instead of
+// silently mapping no users.
+func validateGroupIdentityProvider(ctx context.Context, c api.Client, group auth.Group) error {
+	for _, idp := range groupIdentityProviders {
+		if !idp.isGroup(group) {
+			continue
+		}
+		enabled, err := idp.enabled(ctx, c)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return fmt.Errorf("%s is set, but %s is not enabled on the Console. Configure it first, for example with a %s resource that the group depends on", idp.attribute, idp.name, idp.resource)
+		}
+	}
+	return nil
+}
@@ -1,166 +1,146 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestGroupsConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccGroupsConfig")
-	var o group.Group
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccGroupConfig(t *testing.T) {
+	var o auth.Group
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccGroupsDestroy,
+		CheckDestroy: testAccGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupsConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccGroupsConfig(id),
+				Config: testAccGroupConfig(name, "auditor"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
+					testAccCheckGroupExists("prismacloudcompute_group.test", &o),
+					testAccCheckGroupAttributes(&o, name, "auditor"),
 				),
 			},
-		},
-	})
-}
-
-func TestGroupsNetwork(t *testing.T) {
-	var o group.Group
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccGroupsDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupsConfig(id),
+				Config: testAccGroupConfig(name, "devSecOps"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
+					testAccCheckGroupExists("prismacloudcompute_group.test", &o),
+					testAccCheckGroupAttributes(&o, name, "devSecOps"),
 				),
 			},
 			{
-				Config: testAccGroupsConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
-				),
+				ResourceName:      "prismacloudcompute_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func TestGroupsAuditEvent(t *testing.T) {
-	var o group.Group
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccGroupIdentityProviderValidation(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccGroupsDestroy,
+		CheckDestroy: testAccGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupsConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccGroupsConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckGroupsExists("prismacloudcompute_groups.test", &o),
-					testAccCheckGroupsAttributes(&o, id, true),
-				),
+				Config: fmt.Sprintf(`
+resource "prismacloudcompute_group" "test" {
+	name       = %q
+	role       = "auditor"
+	oidc_group = true
+}`, name),
+				ExpectError: regexp.MustCompile(`oidc_group is set, but OpenID Connect is not enabled on the Console`),
 			},
 		},
 	})
 }
 
-func testGroupsExists(n string, o *group.Group) resource.TestCheckFunc {
+func testAccCheckGroupExists(n string, o *auth.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.GroupId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
 		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+			return fmt.Errorf("Object label Name is not set")
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := groups.Get(*client)
+		name := rs.Primary.ID
+		lo, err := auth.GetGroup(context.Background(), *client, name)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
-		*o = lo
+		*o = *lo
 
 		return nil
 	}
 }
 
-// func testGroupsAttributes(o *group.Group, id string, learningDisabled bool) resource.TestCheckFunc {
-// 	return func(s *terraform.State) error {
-// 		if o.GroupId != id {
-// 			return fmt.Errorf("\n\nGroupId is %s, expected %s", o.GroupId, id)
-// 		} else {
-// 			fmt.Printf("\n\nName is %s", o.GroupId)
-// 		}
-
-// 		if o.LearningDisabled != learningDisabled {
-// 			return fmt.Errorf("LearningDisabled is %t, expected %t", o.LearningDisabled, learningDisabled)
-// 		}
-
-// 		return nil
-// 	}
-// }
-
-// func testGroupsDestroy(s *terraform.State) error {
-// 	/*	client := testAccProvider.Meta().(*api.Client)
-
-// 		for _, rs := range s.RootModule().Resources {
-
-// 			if rs.Type != "prismacloudcompute_groups" {
-// 				continue
-// 			}
-
-// 			if rs.Primary.ID != "" {
-// 				name := rs.Primary.ID
-// 				if err := group.Delete(client, name); err == nil {
-// 					return fmt.Errorf("Object %q still exists", name)
-// 				}
-// 			}
-// 			return nil
-// 		}
-// 	*/
-// 	return nil
-// }
-
-// func testGroupsConfig(id string) string {
-// 	var buf bytes.Buffer
-// 	buf.Grow(500)
-
-// 	buf.WriteString(fmt.Sprintf(`
-// resource "prismacloudcompute_groups" "test" {
-//     name = %q
-// }`, id))
-
-// 	return buf.String()
-// }
+func testAccCheckGroupAttributes(o *auth.Group, name string, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Role != role {
+			return fmt.Errorf("Role is %s, expected %s", o.Role, role)
+		}
+
+		if !o.LdapGroup {
+			return fmt.Errorf("Group is not an LDAP group")
+		}
+
+		if len(o.Permissions) != 1 || len(o.Permissions[0].Collections) != 1 || o.Permissions[0].Collections[0] != "All" {
+			return fmt.Errorf("Permissions are %+v, expected the All collection", o.Permissions)
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_group" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			name := rs.Primary.ID
+			if _, err := auth.GetGroup(context.Background(), *client, name); err == nil {
+				return fmt.Errorf("Object %q still exists", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccGroupConfig(name, role string) string {
+	return testAccGroupLdapSettingsConfig + fmt.Sprintf(`
+resource "prismacloudcompute_group" "test" {
+	name       = %q
+	role       = %q
+	ldap_group = true
+	permissions {
+		collections = ["All"]
+	}
+
+	depends_on = [prismacloudcompute_ldap_settings.test]
+}`, name, role)
+}
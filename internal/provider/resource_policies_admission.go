@@ -17,11 +17,14 @@ func resourcePoliciesAdmission() *schema.Resource {
 		UpdateContext: updatePolicyAdmission,
 		DeleteContext: deletePolicyAdmission,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -79,7 +82,7 @@ func createPolicyAdmission(ctx context.Context, d *schema.ResourceData, meta int
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateAdmission(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateAdmission(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeAdmission, err)
 	}
 
@@ -92,11 +95,15 @@ func readPolicyAdmission(ctx context.Context, d *schema.ResourceData, meta inter
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetAdmission(*client)
+	retrievedPolicy, err := policy.GetAdmission(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeAdmission, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeAdmission, err)
+	}
+
 	if err := d.Set("rule", convert.AdmissionRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeAdmission, err)
 	}
@@ -105,6 +112,7 @@ func readPolicyAdmission(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 func updatePolicyAdmission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToAdmissionRules(d)
 	if err != nil {
@@ -116,7 +124,7 @@ func updatePolicyAdmission(ctx context.Context, d *schema.ResourceData, meta int
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateAdmission(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateAdmission(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeAdmission, err)
 	}
 
@@ -124,7 +132,15 @@ func updatePolicyAdmission(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func deletePolicyAdmission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetAdmission(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeAdmission, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesAdmissionRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the admission policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_admission_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyAdmissionRule,
+		ReadContext:   readPolicyAdmissionRule,
+		UpdateContext: updatePolicyAdmissionRule,
+		DeleteContext: deletePolicyAdmissionRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesAdmission()),
+	}
+}
+
+func createPolicyAdmissionRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyAdmissionRule(ctx, d, meta, policy.CreateAdmissionRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeAdmission, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyAdmissionRule(ctx, d, meta)
+}
+
+func readPolicyAdmissionRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetAdmissionRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeAdmission, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeAdmission, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.AdmissionRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeAdmission, err)
+	}
+	return diags
+}
+
+func updatePolicyAdmissionRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyAdmissionRule(ctx, d, meta, policy.PutAdmissionRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeAdmission, err)
+	}
+
+	return readPolicyAdmissionRule(ctx, d, meta)
+}
+
+func deletePolicyAdmissionRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteAdmissionRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeAdmission, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyAdmissionRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.AdmissionRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToAdmissionRule(policyRuleFromSchema(d, resourcePoliciesAdmissionRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyAdmissionConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyAdmissionConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccAdmissionPolicyConfig(t *testing.T) {
+	var o policy.AdmissionPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyAdmissionDestroy,
+		CheckDestroy: testAccAdmissionPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyAdmissionConfig(id),
+				Config: testAccAdmissionPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
+					testAccCheckAdmissionPolicyExists("prismacloudcompute_admission_policy.test", &o),
+					testAccCheckAdmissionPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyAdmissionConfig(id),
+				Config: testAccAdmissionPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyAdmissionNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyAdmissionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyAdmissionConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
+					testAccCheckAdmissionPolicyExists("prismacloudcompute_admission_policy.test", &o),
+					testAccCheckAdmissionPolicyAttributes(&o, name+"-second"),
 				),
 			},
 			{
-				Config: testAccPolicyAdmissionConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
-				),
+				ResourceName:      "prismacloudcompute_admission_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func TestAccPolicyAdmissionAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyAdmissionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyAdmissionConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccPolicyAdmissionConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyAdmissionExists("prismacloudcompute_policies_admission.test", &o),
-					testAccCheckPolicyAdmissionAttributes(&o, id, true),
-				),
-			},
-		},
-	})
-}
-
-func testAccCheckPolicyAdmissionExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckAdmissionPolicyExists(n string, o *policy.AdmissionPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeAdmission {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeAdmission)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.ComplianceHostEndpoint)
+		lo, err := policy.GetAdmission(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,57 @@ func testAccCheckPolicyAdmissionExists(n string, o *policy.Policy) resource.Test
 	}
 }
 
-func testAccCheckPolicyAdmissionAttributes(o *policy.Policy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckAdmissionPolicyAttributes(o *policy.AdmissionPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.PolicyType != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.PolicyType, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyAdmissionDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
+func testAccAdmissionPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-		for _, rs := range s.RootModule().Resources {
-
-			if rs.Type != "prismacloudcompute_policyadmission" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_admission_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetAdmission(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultAdmission()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyAdmissionConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyAdmission" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccAdmissionPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_admission_policy" "test" {
+	rule {
+		name        = %q
+		description = "Do not allow privileged pods"
+		effect      = "alert"
+		script      = "match[{\"msg\": msg}] { input.request.operation == \"CREATE\" ; msg := \"privileged\" }"
+	}
+}`, name)
 }
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePoliciesCnns() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyCnns,
+		ReadContext:   readPolicyCnns,
+		UpdateContext: updatePolicyCnns,
+		DeleteContext: deletePolicyCnns,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"container_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not to enable CNNS for containers.",
+			},
+			"container_enforcement_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not to block container traffic that matches a rule with the 'prevent' effect. Traffic is only alerted on otherwise.",
+			},
+			"container_rule": cnnsRuleSchema("Rules for the traffic between containers and network entities."),
+			"host_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not to enable CNNS for hosts.",
+			},
+			"host_enforcement_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not to block host traffic that matches a rule with the 'prevent' effect. Traffic is only alerted on otherwise.",
+			},
+			"host_rule": cnnsRuleSchema("Rules for the traffic between hosts and network entities."),
+		},
+	}
+}
+
+func cnnsRuleSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the network entity the traffic goes to.",
+				},
+				"disabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether or not to disable the rule.",
+				},
+				"effect": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The effect of traffic that matches the rule. Can be set to 'allow', 'alert', or 'prevent'.",
+					ValidateFunc: validation.StringInSlice([]string{"allow", "alert", "prevent"}, false),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Unique name of the rule.",
+				},
+				"port": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Destination port ranges the rule applies to. The rule applies to all ports if none are set.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"end": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								Description:  "Last port of the range.",
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"start": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								Description:  "First port of the range.",
+								ValidateFunc: validation.IsPortNumber,
+							},
+						},
+					},
+				},
+				"source": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the network entity the traffic comes from.",
+				},
+			},
+		},
+	}
+}
+
+func createPolicyCnns(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedPolicy := convert.SchemaToCnnsPolicy(d)
+
+	if err := policy.UpdateCnns(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeCnns, err)
+	}
+
+	d.SetId(policyTypeCnns)
+
+	return readPolicyCnns(ctx, d, meta)
+}
+
+func readPolicyCnns(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetCnns(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeCnns, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeCnns, err)
+	}
+
+	d.Set("container_enabled", retrievedPolicy.ContainerEnabled)
+	d.Set("container_enforcement_enabled", retrievedPolicy.ContainerEnforcementEnabled)
+	if err := d.Set("container_rule", convert.CnnsRulesToSchema(retrievedPolicy.ContainerRules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeCnns, err)
+	}
+	d.Set("host_enabled", retrievedPolicy.HostEnabled)
+	d.Set("host_enforcement_enabled", retrievedPolicy.HostEnforcementEnabled)
+	if err := d.Set("host_rule", convert.CnnsRulesToSchema(retrievedPolicy.HostRules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeCnns, err)
+	}
+	return diags
+}
+
+func updatePolicyCnns(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedPolicy := convert.SchemaToCnnsPolicy(d)
+
+	if err := policy.UpdateCnns(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeCnns, err)
+	}
+
+	return readPolicyCnns(ctx, d, meta)
+}
+
+func deletePolicyCnns(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetCnns(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeCnns, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
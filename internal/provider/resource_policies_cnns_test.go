@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCnnsPolicyConfig(t *testing.T) {
+	var o policy.CnnsPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCnnsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCnnsPolicyConfig(name, "alert"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCnnsPolicyExists("prismacloudcompute_cnns_policy.test", &o),
+					testAccCheckCnnsPolicyAttributes(&o, name, "alert"),
+				),
+			},
+			{
+				Config: testAccCnnsPolicyConfig(name, "prevent"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCnnsPolicyExists("prismacloudcompute_cnns_policy.test", &o),
+					testAccCheckCnnsPolicyAttributes(&o, name, "prevent"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_cnns_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCnnsPolicyExists(n string, o *policy.CnnsPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeCnns {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeCnns)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetCnns(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckCnnsPolicyAttributes(o *policy.CnnsPolicy, name, effect string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.ContainerEnabled || o.HostEnabled {
+			return fmt.Errorf("Policy is enabled for containers %t and hosts %t, expected only containers", o.ContainerEnabled, o.HostEnabled)
+		}
+
+		if len(o.ContainerRules) != 2 || len(o.HostRules) != 1 {
+			return fmt.Errorf("Policy has %d container and %d host rules, expected 2 and 1", len(o.ContainerRules), len(o.HostRules))
+		}
+
+		rule := o.ContainerRules[0]
+		if rule.Source != name+"-frontend" || rule.Destination != name+"-office" || rule.Effect != effect {
+			return fmt.Errorf("Rule is %+v, expected from %s-frontend to %s-office with effect %s", rule, name, name, effect)
+		}
+		if len(rule.Ports) != 1 || rule.Ports[0].Start != 443 {
+			return fmt.Errorf("Rule ports are %+v, expected 443", rule.Ports)
+		}
+
+		ids := map[int]bool{}
+		for _, r := range append(o.ContainerRules, o.HostRules...) {
+			if r.Id == 0 || ids[r.Id] {
+				return fmt.Errorf("Rule %s has ID %d, expected a unique ID", r.Name, r.Id)
+			}
+			ids[r.Id] = true
+		}
+
+		return nil
+	}
+}
+
+func testAccCnnsPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_cnns_policy" {
+			continue
+		}
+
+		lo, err := policy.GetCnns(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.ContainerEnabled || lo.HostEnabled || len(lo.ContainerRules) != 0 || len(lo.HostRules) != 0 {
+			return fmt.Errorf("Policy was not reset: %+v", lo)
+		}
+	}
+
+	return nil
+}
+
+func testAccCnnsPolicyConfig(name, effect string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_cnns_entity" "frontend" {
+	name        = "%[1]s-frontend"
+	type        = "container"
+	collections = ["All"]
+}
+
+resource "prismacloudcompute_cnns_entity" "office" {
+	name   = "%[1]s-office"
+	type   = "subnet"
+	subnet = "10.0.0.0/16"
+}
+
+resource "prismacloudcompute_cnns_entity" "registry" {
+	name   = "%[1]s-registry"
+	type   = "dns"
+	domain = "registry.example.com"
+}
+
+resource "prismacloudcompute_cnns_policy" "test" {
+	container_enabled = true
+
+	container_rule {
+		name        = "frontend to office"
+		source      = prismacloudcompute_cnns_entity.frontend.name
+		destination = prismacloudcompute_cnns_entity.office.name
+		effect      = %[2]q
+		port {
+			start = 443
+			end   = 443
+		}
+	}
+	container_rule {
+		name        = "frontend to registry"
+		source      = prismacloudcompute_cnns_entity.frontend.name
+		destination = prismacloudcompute_cnns_entity.registry.name
+		effect      = "allow"
+	}
+	host_rule {
+		name        = "office to registry"
+		source      = prismacloudcompute_cnns_entity.office.name
+		destination = prismacloudcompute_cnns_entity.registry.name
+		effect      = "alert"
+	}
+}`, name, effect)
+}
@@ -17,11 +17,14 @@ func resourcePoliciesComplianceCiCoderepo() *schema.Resource {
 		UpdateContext: updatePolicyComplianceCiCoderepo,
 		DeleteContext: deletePolicyComplianceCiCoderepo,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -163,7 +166,7 @@ func createPolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceDat
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCiCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCiCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceCiCoderepo, err)
 	}
 
@@ -176,11 +179,15 @@ func readPolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetComplianceCiCoderepo(*client)
+	retrievedPolicy, err := policy.GetComplianceCiCoderepo(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiCoderepo, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
 	if err := d.Set("rule", convert.ComplianceCoderepoCiRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiCoderepo, err)
 	}
@@ -189,6 +196,7 @@ func readPolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceData,
 }
 
 func updatePolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceCiCoderepoRules(d)
 	if err != nil {
@@ -200,7 +208,7 @@ func updatePolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceDat
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCiCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCiCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceCiCoderepo, err)
 	}
 
@@ -208,7 +216,15 @@ func updatePolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceDat
 }
 
 func deletePolicyComplianceCiCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceCiCoderepo(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
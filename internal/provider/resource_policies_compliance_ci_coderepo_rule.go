@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceCiCoderepoRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the CI coderepo compliance policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_ci_coderepo_compliance_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyComplianceCiCoderepoRule,
+		ReadContext:   readPolicyComplianceCiCoderepoRule,
+		UpdateContext: updatePolicyComplianceCiCoderepoRule,
+		DeleteContext: deletePolicyComplianceCiCoderepoRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesComplianceCiCoderepo()),
+	}
+}
+
+func createPolicyComplianceCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyComplianceCiCoderepoRule(ctx, d, meta, policy.CreateComplianceCiCoderepoRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyComplianceCiCoderepoRule(ctx, d, meta)
+}
+
+func readPolicyComplianceCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetComplianceCiCoderepoRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.ComplianceCoderepoCiRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyComplianceCiCoderepoRule(ctx, d, meta, policy.PutComplianceCiCoderepoRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	return readPolicyComplianceCiCoderepoRule(ctx, d, meta)
+}
+
+func deletePolicyComplianceCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteComplianceCiCoderepoRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeComplianceCiCoderepo, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyComplianceCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.ComplianceCoderepoRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToComplianceCiCoderepoRule(policyRuleFromSchema(d, resourcePoliciesComplianceCiCoderepoRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyComplianceCiCoderepoConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyComplianceCiCiCoderepoConfig")
+func TestAccComplianceCiCoderepoPolicyConfig(t *testing.T) {
 	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCiCoderepoDestroy,
+		CheckDestroy: testAccComplianceCiCoderepoPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
+				Config: testAccComplianceCiCoderepoPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
+					testAccCheckComplianceCiCoderepoPolicyExists("prismacloudcompute_ci_coderepo_compliance_policy.test", &o),
+					testAccCheckComplianceCiCoderepoPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
+				Config: testAccComplianceCiCoderepoPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
+					testAccCheckComplianceCiCoderepoPolicyExists("prismacloudcompute_ci_coderepo_compliance_policy.test", &o),
+					testAccCheckComplianceCiCoderepoPolicyAttributes(&o, name+"-second"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccPolicyComplianceCiCoderepoNetwork(t *testing.T) {
-	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCiCoderepoDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyComplianceCiCoderepoAuditEvent(t *testing.T) {
-	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCiCoderepoDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyComplianceCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCiCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCiCoderepoAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_ci_coderepo_compliance_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyComplianceCiCoderepoExists(n string, o *policy.ComplianceCoderepoPolicy) resource.TestCheckFunc {
+func testAccCheckComplianceCiCoderepoPolicyExists(n string, o *policy.ComplianceCoderepoPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeComplianceCiCoderepo {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeComplianceCiCoderepo)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.GetComplianceCoderepo(*client)
+		lo, err := policy.GetComplianceCiCoderepo(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,70 @@ func testAccCheckPolicyComplianceCiCoderepoExists(n string, o *policy.Compliance
 	}
 }
 
-func testAccCheckPolicyComplianceCiCoderepoAttributes(o *policy.ComplianceCoderepoPolicy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckComplianceCiCoderepoPolicyAttributes(o *policy.ComplianceCoderepoPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.Id != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.Id, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Id)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.Type != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.Type, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyComplianceCiCoderepoDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccComplianceCiCoderepoPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policycompliancecoderepo" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ci_coderepo_compliance_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetComplianceCiCoderepo(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultComplianceCiCoderepo()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyComplianceCiCoderepoConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyComplianceCiCoderepo" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccComplianceCiCoderepoPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ci_coderepo_compliance_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		license {
+			critical = ["AGPL-3.0"]
+			high     = []
+			medium   = []
+			low      = []
+			alert_threshold {
+				enabled = true
+				value   = 3
+			}
+			block_threshold {
+				enabled = false
+				value   = 0
+			}
+		}
+	}
+}`, name)
 }
@@ -17,11 +17,14 @@ func resourcePoliciesComplianceCiImage() *schema.Resource {
 		UpdateContext: updatePolicyComplianceCiImage,
 		DeleteContext: deletePolicyComplianceCiImage,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -104,7 +107,7 @@ func createPolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCiImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCiImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceCiImage, err)
 	}
 
@@ -117,11 +120,15 @@ func readPolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData, me
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetComplianceCiImage(*client)
+	retrievedPolicy, err := policy.GetComplianceCiImage(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiImage, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiImage, err)
+	}
+
 	if err := d.Set("rule", convert.ComplianceCiRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCiImage, err)
 	}
@@ -129,6 +136,7 @@ func readPolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData, me
 }
 
 func updatePolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceCiRules(d)
 	if err != nil {
@@ -140,7 +148,7 @@ func updatePolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCiImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCiImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceCiImage, err)
 	}
 
@@ -148,7 +156,15 @@ func updatePolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData,
 }
 
 func deletePolicyComplianceCiImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceCiImage(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceCiImage, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComplianceCiImagePolicyRuleConfig(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccComplianceCiImagePolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceCiImagePolicyRuleConfig(name, false, "bottom"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_ci_image_compliance_policy_rule.test", "id", name),
+					testAccCheckComplianceCiImagePolicyRule(name, false, false),
+				),
+			},
+			{
+				Config: testAccComplianceCiImagePolicyRuleConfig(name, true, "top"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_ci_image_compliance_policy_rule.test", "disabled", "true"),
+					testAccCheckComplianceCiImagePolicyRule(name, true, true),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_ci_image_compliance_policy_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position", "relative_to"},
+			},
+		},
+	})
+}
+
+func testAccCheckComplianceCiImagePolicyRule(name string, disabled, top bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetComplianceCiImage(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+
+		i := 0
+		if !top {
+			i = len(lo.Rules) - 1
+		}
+		if len(lo.Rules) == 0 || lo.Rules[i].Name != name {
+			return fmt.Errorf("Rule %s is not at index %d of %d rules", name, i, len(lo.Rules))
+		}
+		if lo.Rules[i].Disabled != disabled {
+			return fmt.Errorf("Rule disabled is %t, expected %t", lo.Rules[i].Disabled, disabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccComplianceCiImagePolicyRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ci_image_compliance_policy_rule" {
+			continue
+		}
+
+		_, err := policy.GetComplianceCiImageRule(context.Background(), *client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Rule %s still exists", rs.Primary.ID)
+		}
+		if !api.IsNotFound(err) {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func testAccComplianceCiImagePolicyRuleConfig(name string, disabled bool, position string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ci_image_compliance_policy_rule" "test" {
+	name        = %q
+	disabled    = %t
+	position    = %q
+	collections = ["All"]
+	effect      = "alert"
+	compliance_check {
+		block = false
+		id    = 41
+	}
+}`, name, disabled, position)
+}
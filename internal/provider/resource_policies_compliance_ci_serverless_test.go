@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComplianceCiServerlessPolicyConfig(t *testing.T) {
+	var o policy.CompliancePolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccComplianceCiServerlessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceCiServerlessPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceCiServerlessPolicyExists("prismacloudcompute_ci_serverless_compliance_policy.test", &o),
+					testAccCheckComplianceCiServerlessPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccComplianceCiServerlessPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceCiServerlessPolicyExists("prismacloudcompute_ci_serverless_compliance_policy.test", &o),
+					testAccCheckComplianceCiServerlessPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_ci_serverless_compliance_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComplianceCiServerlessPolicyExists(n string, o *policy.CompliancePolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeComplianceCiServerless {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeComplianceCiServerless)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetComplianceCiServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckComplianceCiServerlessPolicyAttributes(o *policy.CompliancePolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccComplianceCiServerlessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ci_serverless_compliance_policy" {
+			continue
+		}
+
+		lo, err := policy.GetComplianceCiServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultComplianceCiServerless()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccComplianceCiServerlessPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ci_serverless_compliance_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		compliance_check {
+			block = false
+			id    = 434
+		}
+	}
+}`, name)
+}
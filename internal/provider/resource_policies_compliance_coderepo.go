@@ -17,11 +17,14 @@ func resourcePoliciesComplianceCoderepo() *schema.Resource {
 		UpdateContext: updatePolicyComplianceCoderepo,
 		DeleteContext: deletePolicyComplianceCoderepo,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -143,7 +146,7 @@ func createPolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceCoderepo, err)
 	}
 
@@ -156,11 +159,15 @@ func readPolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData, m
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetComplianceCoderepo(*client)
+	retrievedPolicy, err := policy.GetComplianceCoderepo(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCoderepo, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCoderepo, err)
+	}
+
 	if err := d.Set("rule", convert.ComplianceCoderepoRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceCoderepo, err)
 	}
@@ -169,6 +176,7 @@ func readPolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData, m
 }
 
 func updatePolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceCoderepoRules(d)
 	if err != nil {
@@ -180,7 +188,7 @@ func updatePolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceCoderepo, err)
 	}
 
@@ -188,7 +196,15 @@ func updatePolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData,
 }
 
 func deletePolicyComplianceCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceCoderepo(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceCoderepoRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the coderepo compliance policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_coderepo_compliance_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyComplianceCoderepoRule,
+		ReadContext:   readPolicyComplianceCoderepoRule,
+		UpdateContext: updatePolicyComplianceCoderepoRule,
+		DeleteContext: deletePolicyComplianceCoderepoRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesComplianceCoderepo()),
+	}
+}
+
+func createPolicyComplianceCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyComplianceCoderepoRule(ctx, d, meta, policy.CreateComplianceCoderepoRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyComplianceCoderepoRule(ctx, d, meta)
+}
+
+func readPolicyComplianceCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetComplianceCoderepoRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.ComplianceCoderepoRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyComplianceCoderepoRule(ctx, d, meta, policy.PutComplianceCoderepoRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	return readPolicyComplianceCoderepoRule(ctx, d, meta)
+}
+
+func deletePolicyComplianceCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteComplianceCoderepoRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeComplianceCoderepo, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyComplianceCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.ComplianceCoderepoRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToComplianceCoderepoRule(policyRuleFromSchema(d, resourcePoliciesComplianceCoderepoRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyComplianceCoderepoConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyComplianceCoderepoConfig")
+func TestAccComplianceCoderepoPolicyConfig(t *testing.T) {
 	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCoderepoDestroy,
+		CheckDestroy: testAccComplianceCoderepoPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
+				Config: testAccComplianceCoderepoPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
+					testAccCheckComplianceCoderepoPolicyExists("prismacloudcompute_coderepo_compliance_policy.test", &o),
+					testAccCheckComplianceCoderepoPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
+				Config: testAccComplianceCoderepoPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyComplianceCoderepoNetwork(t *testing.T) {
-	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCoderepoDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
+					testAccCheckComplianceCoderepoPolicyExists("prismacloudcompute_coderepo_compliance_policy.test", &o),
+					testAccCheckComplianceCoderepoPolicyAttributes(&o, name+"-second"),
 				),
 			},
 			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_coderepo_compliance_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func TestAccPolicyComplianceCoderepoAuditEvent(t *testing.T) {
-	var o policy.ComplianceCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceCoderepoDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyComplianceCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceCoderepoExists("prismacloudcompute_policies_compliance_coderepo.test", &o),
-					testAccCheckPolicyComplianceCoderepoAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func testAccCheckPolicyComplianceCoderepoExists(n string, o *policy.ComplianceCoderepoPolicy) resource.TestCheckFunc {
+func testAccCheckComplianceCoderepoPolicyExists(n string, o *policy.ComplianceCoderepoPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeComplianceCoderepo {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeComplianceCoderepo)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.GetComplianceCoderepo(*client)
+		lo, err := policy.GetComplianceCoderepo(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,66 @@ func testAccCheckPolicyComplianceCoderepoExists(n string, o *policy.ComplianceCo
 	}
 }
 
-func testAccCheckPolicyComplianceCoderepoAttributes(o *policy.ComplianceCoderepoPolicy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckComplianceCoderepoPolicyAttributes(o *policy.ComplianceCoderepoPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.Id != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.Id, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Id)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.Type != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.Type, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyComplianceCoderepoDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccComplianceCoderepoPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policycompliancecoderepo" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_coderepo_compliance_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetComplianceCoderepo(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultComplianceCoderepo()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyComplianceCoderepoConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyComplianceCoderepo" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccComplianceCoderepoPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_coderepo_compliance_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		license {
+			critical = ["AGPL-3.0"]
+			high     = []
+			medium   = []
+			low      = []
+			alert_threshold {
+				enabled = true
+				value   = 3
+			}
+		}
+	}
+}`, name)
 }
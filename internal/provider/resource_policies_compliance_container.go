@@ -17,11 +17,14 @@ func resourcePoliciesComplianceContainer() *schema.Resource {
 		UpdateContext: updatePolicyComplianceContainer,
 		DeleteContext: deletePolicyComplianceContainer,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -114,7 +117,7 @@ func createPolicyComplianceContainer(ctx context.Context, d *schema.ResourceData
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceContainer(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceContainer(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceContainer, err)
 	}
 
@@ -127,11 +130,15 @@ func readPolicyComplianceContainer(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetComplianceContainer(*client)
+	retrievedPolicy, err := policy.GetComplianceContainer(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceContainer, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceContainer, err)
+	}
+
 	if err := d.Set("rule", convert.ComplianceDeployedRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceContainer, err)
 	}
@@ -139,6 +146,7 @@ func readPolicyComplianceContainer(ctx context.Context, d *schema.ResourceData,
 }
 
 func updatePolicyComplianceContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceDeployedRules(d)
 	if err != nil {
@@ -150,7 +158,7 @@ func updatePolicyComplianceContainer(ctx context.Context, d *schema.ResourceData
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceContainer(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateComplianceContainer(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceContainer, err)
 	}
 
@@ -158,7 +166,15 @@ func updatePolicyComplianceContainer(ctx context.Context, d *schema.ResourceData
 }
 
 func deletePolicyComplianceContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceContainer(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceContainer, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceContainerRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the container compliance policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_container_compliance_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyComplianceContainerRule,
+		ReadContext:   readPolicyComplianceContainerRule,
+		UpdateContext: updatePolicyComplianceContainerRule,
+		DeleteContext: deletePolicyComplianceContainerRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesComplianceContainer()),
+	}
+}
+
+func createPolicyComplianceContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyComplianceContainerRule(ctx, d, meta, policy.CreateComplianceContainerRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyComplianceContainerRule(ctx, d, meta)
+}
+
+func readPolicyComplianceContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetComplianceContainerRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.ComplianceDeployedRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyComplianceContainerRule(ctx, d, meta, policy.PutComplianceContainerRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+
+	return readPolicyComplianceContainerRule(ctx, d, meta)
+}
+
+func deletePolicyComplianceContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteComplianceContainerRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeComplianceContainer, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyComplianceContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.ComplianceRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToComplianceDeployedRule(policyRuleFromSchema(d, resourcePoliciesComplianceContainerRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,26 +1,30 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourcePoliciesComplianceHost() *schema.Resource {
 	return &schema.Resource{
-		Create: createPolicyComplianceHost,
-		Read:   readPolicyComplianceHost,
-		Update: updatePolicyComplianceHost,
-		Delete: deletePolicyComplianceHost,
+		CreateContext: createPolicyComplianceHost,
+		ReadContext:   readPolicyComplianceHost,
+		UpdateContext: updatePolicyComplianceHost,
+		DeleteContext: deletePolicyComplianceHost,
+
+		Timeouts: defaultTimeouts(),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -101,11 +105,11 @@ func resourcePoliciesComplianceHost() *schema.Resource {
 	}
 }
 
-func createPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
+func createPolicyComplianceHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceDeployedRules(d)
 	if err != nil {
-		return fmt.Errorf("error creating %s policy: %s", policyTypeComplianceHost, err)
+		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceHost, err)
 	}
 
 	parsedPolicy := policy.CompliancePolicy{
@@ -113,32 +117,40 @@ func createPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceHost(*client, parsedPolicy); err != nil {
-		return fmt.Errorf("error creating %s policy: %s", policyTypeComplianceHost, err)
+	if err := policy.UpdateComplianceHost(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceHost, err)
 	}
 
 	d.SetId(policyTypeComplianceHost)
-	return readPolicyComplianceHost(d, meta)
+	return readPolicyComplianceHost(ctx, d, meta)
 }
 
-func readPolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
+func readPolicyComplianceHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
-	retrievedPolicy, err := policy.GetComplianceHost(*client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetComplianceHost(ctx, *client)
 	if err != nil {
-		return fmt.Errorf("error reading %s policy: %s", policyTypeComplianceHost, err)
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceHost, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceHost, err)
 	}
 
 	if err := d.Set("rule", convert.ComplianceDeployedRulesToSchema(retrievedPolicy.Rules)); err != nil {
-		return fmt.Errorf("error reading %s policy: %s", policyTypeComplianceHost, err)
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceHost, err)
 	}
-	return nil
+	return diags
 }
 
-func updatePolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
+func updatePolicyComplianceHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToComplianceDeployedRules(d)
 	if err != nil {
-		return fmt.Errorf("error updating %s policy: %s", policyTypeComplianceHost, err)
+		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceHost, err)
 	}
 
 	parsedPolicy := policy.CompliancePolicy{
@@ -146,14 +158,23 @@ func updatePolicyComplianceHost(d *schema.ResourceData, meta interface{}) error
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateComplianceHost(*client, parsedPolicy); err != nil {
-		return fmt.Errorf("error updating %s policy: %s", policyTypeComplianceHost, err)
+	if err := policy.UpdateComplianceHost(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceHost, err)
 	}
 
-	return readPolicyComplianceHost(d, meta)
+	return readPolicyComplianceHost(ctx, d, meta)
 }
 
-func deletePolicyComplianceHost(d *schema.ResourceData, meta interface{}) error {
-	// TODO: reset to default policy
-	return nil
+func deletePolicyComplianceHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceHost(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceHost, err)
+	}
+
+	d.SetId("")
+
+	return diags
 }
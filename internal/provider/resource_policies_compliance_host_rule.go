@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceHostRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the host compliance policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_host_compliance_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyComplianceHostRule,
+		ReadContext:   readPolicyComplianceHostRule,
+		UpdateContext: updatePolicyComplianceHostRule,
+		DeleteContext: deletePolicyComplianceHostRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesComplianceHost()),
+	}
+}
+
+func createPolicyComplianceHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyComplianceHostRule(ctx, d, meta, policy.CreateComplianceHostRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyComplianceHostRule(ctx, d, meta)
+}
+
+func readPolicyComplianceHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetComplianceHostRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.ComplianceDeployedRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyComplianceHostRule(ctx, d, meta, policy.PutComplianceHostRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+
+	return readPolicyComplianceHostRule(ctx, d, meta)
+}
+
+func deletePolicyComplianceHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteComplianceHostRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeComplianceHost, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyComplianceHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.ComplianceRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToComplianceDeployedRule(policyRuleFromSchema(d, resourcePoliciesComplianceHostRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
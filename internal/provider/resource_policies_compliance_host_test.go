@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyComplianceHostConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyComplianceHostConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccComplianceHostPolicyConfig(t *testing.T) {
+	var o policy.CompliancePolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceHostDestroy,
+		CheckDestroy: testAccComplianceHostPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyComplianceHostConfig(id),
+				Config: testAccComplianceHostPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
+					testAccCheckComplianceHostPolicyExists("prismacloudcompute_host_compliance_policy.test", &o),
+					testAccCheckComplianceHostPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyComplianceHostConfig(id),
+				Config: testAccComplianceHostPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyComplianceHostNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceHostDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyComplianceHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyComplianceHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyComplianceHostAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyComplianceHostDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyComplianceHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
+					testAccCheckComplianceHostPolicyExists("prismacloudcompute_host_compliance_policy.test", &o),
+					testAccCheckComplianceHostPolicyAttributes(&o, name+"-second"),
 				),
 			},
 			{
-				Config: testAccPolicyComplianceHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyComplianceHostExists("prismacloudcompute_policies_compliance_container.test", &o),
-					testAccCheckPolicyComplianceHostAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_host_compliance_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyComplianceHostExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckComplianceHostPolicyExists(n string, o *policy.CompliancePolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeComplianceHost {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeComplianceHost)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.ComplianceHostEndpoint)
+		lo, err := policy.GetComplianceHost(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,60 @@ func testAccCheckPolicyComplianceHostExists(n string, o *policy.Policy) resource
 	}
 }
 
-func testAccCheckPolicyComplianceHostAttributes(o *policy.Policy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckComplianceHostPolicyAttributes(o *policy.CompliancePolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.PolicyType != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.PolicyType, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyComplianceHostDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
+func testAccComplianceHostPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-		for _, rs := range s.RootModule().Resources {
-
-			if rs.Type != "prismacloudcompute_policycompliancehost" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_host_compliance_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetComplianceHost(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultComplianceHost()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyComplianceHostConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyComplianceHost" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccComplianceHostPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_host_compliance_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		compliance_check {
+			block = false
+			id    = 41
+		}
+	}
+}`, name)
 }
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceServerless() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyComplianceServerless,
+		ReadContext:   readPolicyComplianceServerless,
+		UpdateContext: updatePolicyComplianceServerless,
+		DeleteContext: deletePolicyComplianceServerless,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that make up the policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collections": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Collections used to scope the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"compliance_check": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Compliance checks. Omitted checks are ignored.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether or not to block if this check is failed. Setting to 'false' will only alert if the check is failed.",
+									},
+									"id": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "Compliance check number.",
+									},
+								},
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to disable the rule.",
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The effect of the rule. Can be set to 'ignore' or 'alert'.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Unique name of the rule.",
+						},
+						"notes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Free-form text field.",
+						},
+						"show_passed_checks": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to report both failed and passed compliance checks.",
+						},
+						"verbose": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to provide verbose output for blocked requests.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createPolicyComplianceServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToComplianceServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	parsedPolicy := policy.CompliancePolicy{
+		Type:  policyTypeComplianceServerless,
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateComplianceServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	d.SetId(policyTypeComplianceServerless)
+	return readPolicyComplianceServerless(ctx, d, meta)
+}
+
+func readPolicyComplianceServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetComplianceServerless(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	if err := d.Set("rule", convert.ComplianceServerlessRulesToSchema(retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeComplianceServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToComplianceServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	parsedPolicy := policy.CompliancePolicy{
+		Type:  policyTypeComplianceServerless,
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateComplianceServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	return readPolicyComplianceServerless(ctx, d, meta)
+}
+
+func deletePolicyComplianceServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetComplianceServerless(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeComplianceServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesComplianceServerlessRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the serverless compliance policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_serverless_compliance_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyComplianceServerlessRule,
+		ReadContext:   readPolicyComplianceServerlessRule,
+		UpdateContext: updatePolicyComplianceServerlessRule,
+		DeleteContext: deletePolicyComplianceServerlessRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesComplianceServerless()),
+	}
+}
+
+func createPolicyComplianceServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyComplianceServerlessRule(ctx, d, meta, policy.CreateComplianceServerlessRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyComplianceServerlessRule(ctx, d, meta)
+}
+
+func readPolicyComplianceServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetComplianceServerlessRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.ComplianceServerlessRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyComplianceServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyComplianceServerlessRule(ctx, d, meta, policy.PutComplianceServerlessRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+
+	return readPolicyComplianceServerlessRule(ctx, d, meta)
+}
+
+func deletePolicyComplianceServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteComplianceServerlessRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeComplianceServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyComplianceServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.ComplianceRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToComplianceServerlessRule(policyRuleFromSchema(d, resourcePoliciesComplianceServerlessRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccComplianceServerlessPolicyConfig(t *testing.T) {
+	var o policy.CompliancePolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccComplianceServerlessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceServerlessPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceServerlessPolicyExists("prismacloudcompute_serverless_compliance_policy.test", &o),
+					testAccCheckComplianceServerlessPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccComplianceServerlessPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComplianceServerlessPolicyExists("prismacloudcompute_serverless_compliance_policy.test", &o),
+					testAccCheckComplianceServerlessPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_serverless_compliance_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComplianceServerlessPolicyExists(n string, o *policy.CompliancePolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeComplianceServerless {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeComplianceServerless)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetComplianceServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckComplianceServerlessPolicyAttributes(o *policy.CompliancePolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccComplianceServerlessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_serverless_compliance_policy" {
+			continue
+		}
+
+		lo, err := policy.GetComplianceServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultComplianceServerless()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccComplianceServerlessPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_serverless_compliance_policy" "test" {
+	rule {
+		name               = %q
+		effect             = "alert"
+		collections        = ["All"]
+		show_passed_checks = true
+		compliance_check {
+			block = false
+			id    = 434
+		}
+	}
+}`, name)
+}
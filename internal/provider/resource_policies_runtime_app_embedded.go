@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeAppEmbedded() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyRuntimeAppEmbedded,
+		ReadContext:   readPolicyRuntimeAppEmbedded,
+		UpdateContext: updatePolicyRuntimeAppEmbedded,
+		DeleteContext: deletePolicyRuntimeAppEmbedded,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that make up the policy.",
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: runtimeContainerRuleSchema(
+						"advanced_protection_effect",
+						"cloud_metadata_enforcement_effect",
+						"collections",
+						"custom_rule",
+						"disabled",
+						"dns",
+						"filesystem",
+						"name",
+						"network",
+						"notes",
+						"previous_name",
+						"processes",
+					),
+				},
+			},
+		},
+	}
+}
+
+func createPolicyRuntimeAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToRuntimeAppEmbeddedRules(d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	parsedPolicy := policy.RuntimeAppEmbeddedPolicy{
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateRuntimeAppEmbedded(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	d.SetId(policyTypeRuntimeAppEmbedded)
+
+	return readPolicyRuntimeAppEmbedded(ctx, d, meta)
+}
+
+func readPolicyRuntimeAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetRuntimeAppEmbedded(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	if err := d.Set("rule", convert.RuntimeAppEmbeddedRulesToSchema(retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToRuntimeAppEmbeddedRules(d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	parsedPolicy := policy.RuntimeAppEmbeddedPolicy{
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateRuntimeAppEmbedded(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	return readPolicyRuntimeAppEmbedded(ctx, d, meta)
+}
+
+func deletePolicyRuntimeAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetRuntimeAppEmbedded(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
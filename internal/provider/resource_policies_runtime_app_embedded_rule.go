@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeAppEmbeddedRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the App-Embedded runtime policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_app_embedded_runtime_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyRuntimeAppEmbeddedRule,
+		ReadContext:   readPolicyRuntimeAppEmbeddedRule,
+		UpdateContext: updatePolicyRuntimeAppEmbeddedRule,
+		DeleteContext: deletePolicyRuntimeAppEmbeddedRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesRuntimeAppEmbedded()),
+	}
+}
+
+func createPolicyRuntimeAppEmbeddedRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyRuntimeAppEmbeddedRule(ctx, d, meta, policy.CreateRuntimeAppEmbeddedRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyRuntimeAppEmbeddedRule(ctx, d, meta)
+}
+
+func readPolicyRuntimeAppEmbeddedRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetRuntimeAppEmbeddedRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.RuntimeAppEmbeddedRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeAppEmbeddedRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyRuntimeAppEmbeddedRule(ctx, d, meta, policy.PutRuntimeAppEmbeddedRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	return readPolicyRuntimeAppEmbeddedRule(ctx, d, meta)
+}
+
+func deletePolicyRuntimeAppEmbeddedRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteRuntimeAppEmbeddedRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeRuntimeAppEmbedded, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyRuntimeAppEmbeddedRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.RuntimeAppEmbeddedRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToRuntimeAppEmbeddedRule(policyRuleFromSchema(d, resourcePoliciesRuntimeAppEmbeddedRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRuntimeAppEmbeddedPolicyConfig(t *testing.T) {
+	var o policy.RuntimeAppEmbeddedPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRuntimeAppEmbeddedPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeAppEmbeddedPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeAppEmbeddedPolicyExists("prismacloudcompute_app_embedded_runtime_policy.test", &o),
+					testAccCheckRuntimeAppEmbeddedPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccRuntimeAppEmbeddedPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeAppEmbeddedPolicyExists("prismacloudcompute_app_embedded_runtime_policy.test", &o),
+					testAccCheckRuntimeAppEmbeddedPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_app_embedded_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeAppEmbeddedPolicyExists(n string, o *policy.RuntimeAppEmbeddedPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeRuntimeAppEmbedded {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeRuntimeAppEmbedded)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetRuntimeAppEmbedded(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeAppEmbeddedPolicyAttributes(o *policy.RuntimeAppEmbeddedPolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuntimeAppEmbeddedPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_app_embedded_runtime_policy" {
+			continue
+		}
+
+		lo, err := policy.GetRuntimeAppEmbedded(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultRuntimeAppEmbedded()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccRuntimeAppEmbeddedPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_app_embedded_runtime_policy" "test" {
+	rule {
+		name                              = %q
+		collections                       = ["All"]
+		cloud_metadata_enforcement_effect = "alert"
+		custom_rule {
+			action = "incident"
+			effect = "alert"
+			id     = 2
+		}
+		dns {
+			default_effect = "alert"
+			domain_list {
+				allowed = []
+				denied  = []
+				effect  = "disable"
+			}
+		}
+		filesystem {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+		network {
+			allowed_ips    = []
+			default_effect = "alert"
+			denied_ips     = []
+			listening_ports {
+				effect = "disable"
+			}
+			outbound_ports {
+				effect = "disable"
+			}
+		}
+		processes {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+	}
+}`, name)
+}
@@ -17,11 +17,14 @@ func resourcePoliciesRuntimeContainer() *schema.Resource {
 		UpdateContext: updatePolicyRuntimeContainer,
 		DeleteContext: deletePolicyRuntimeContainer,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -522,7 +525,7 @@ func createPolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, m
 		Rules:            parsedRules,
 	}
 
-	if err := policy.UpdateRuntimeContainer(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateRuntimeContainer(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeContainer, err)
 	}
 
@@ -536,11 +539,15 @@ func readPolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, met
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetRuntimeContainer(*client)
+	retrievedPolicy, err := policy.GetRuntimeContainer(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeContainer, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeContainer, err)
+	}
+
 	d.Set("learning_disabled", retrievedPolicy.LearningDisabled)
 	if err := d.Set("rule", convert.RuntimeContainerRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeContainer, err)
@@ -549,6 +556,7 @@ func readPolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, met
 }
 
 func updatePolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 
 	var learningDisabled bool
@@ -568,7 +576,7 @@ func updatePolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, m
 		Rules:            parsedRules,
 	}
 
-	if err := policy.UpdateRuntimeContainer(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateRuntimeContainer(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeContainer, err)
 	}
 
@@ -576,7 +584,15 @@ func updatePolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, m
 }
 
 func deletePolicyRuntimeContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetRuntimeContainer(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeRuntimeContainer, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
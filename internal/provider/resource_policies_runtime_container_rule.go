@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeContainerRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the container runtime policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_container_runtime_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyRuntimeContainerRule,
+		ReadContext:   readPolicyRuntimeContainerRule,
+		UpdateContext: updatePolicyRuntimeContainerRule,
+		DeleteContext: deletePolicyRuntimeContainerRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesRuntimeContainer()),
+	}
+}
+
+func createPolicyRuntimeContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyRuntimeContainerRule(ctx, d, meta, policy.CreateRuntimeContainerRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyRuntimeContainerRule(ctx, d, meta)
+}
+
+func readPolicyRuntimeContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetRuntimeContainerRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.RuntimeContainerRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyRuntimeContainerRule(ctx, d, meta, policy.PutRuntimeContainerRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+
+	return readPolicyRuntimeContainerRule(ctx, d, meta)
+}
+
+func deletePolicyRuntimeContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteRuntimeContainerRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeRuntimeContainer, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyRuntimeContainerRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.RuntimeContainerRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToRuntimeContainerRule(policyRuleFromSchema(d, resourcePoliciesRuntimeContainerRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
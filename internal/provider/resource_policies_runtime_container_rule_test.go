@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRuntimeContainerPolicyRuleConfig(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRuntimeContainerPolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeContainerPolicyRuleConfig(name, "first", `
+	position    = "after"
+	relative_to = prismacloudcompute_container_runtime_policy_rule.first.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_container_runtime_policy_rule.first", "id", name+"-first"),
+					testAccCheckRuntimeContainerPolicyRuleOrder(name+"-first", name+"-second"),
+				),
+			},
+			{
+				Config: testAccRuntimeContainerPolicyRuleConfig(name, "second", `
+	position = "top"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_container_runtime_policy_rule.second", "notes", "second"),
+					testAccCheckRuntimeContainerPolicyRuleOrder(name+"-second", name+"-first"),
+					testAccCheckUpdateConflict("prismacloudcompute_container_runtime_policy_rule.second", resourcePoliciesRuntimeContainerRule(), testAccChangeRuntimeContainerPolicyRule(name+"-second")),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_container_runtime_policy_rule.second",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position", "relative_to"},
+			},
+		},
+	})
+}
+
+// Check that the named rules are next to each other in the given order.
+func testAccCheckRuntimeContainerPolicyRuleOrder(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetRuntimeContainer(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+
+		start := -1
+		for i, rule := range lo.Rules {
+			if rule.Name == names[0] {
+				start = i
+			}
+		}
+		if start < 0 || start+len(names) > len(lo.Rules) {
+			return fmt.Errorf("Rule %s not found", names[0])
+		}
+		for i, name := range names {
+			if lo.Rules[start+i].Name != name {
+				return fmt.Errorf("Rule %d is %s, expected %s", start+i, lo.Rules[start+i].Name, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// Change the notes of the named rule as another client would.
+func testAccChangeRuntimeContainerPolicyRule(name string) func(context.Context, api.Client) (func() error, error) {
+	return func(ctx context.Context, c api.Client) (func() error, error) {
+		rule, err := policy.GetRuntimeContainerRule(ctx, c, name)
+		if err != nil {
+			return nil, err
+		}
+		changed := *rule
+		changed.Notes = "changed by another client"
+		if err := policy.PutRuntimeContainerRule(ctx, c, changed, policy.RulePosition{}); err != nil {
+			return nil, err
+		}
+		return func() error {
+			return policy.PutRuntimeContainerRule(ctx, c, *rule, policy.RulePosition{})
+		}, nil
+	}
+}
+
+func testAccRuntimeContainerPolicyRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_container_runtime_policy_rule" {
+			continue
+		}
+
+		_, err := policy.GetRuntimeContainerRule(context.Background(), *client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Rule %s still exists", rs.Primary.ID)
+		}
+		if !api.IsNotFound(err) {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func testAccRuntimeContainerPolicyRuleConfig(name, notes, secondPosition string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_container_runtime_policy_rule" "first" {
+	name = "%[1]s-first"
+	%[3]s
+}
+
+resource "prismacloudcompute_container_runtime_policy_rule" "second" {
+	name  = "%[1]s-second"
+	notes = %[2]q
+	%[3]s
+	%[4]s
+}
+`, name, notes, testAccRuntimeContainerPolicyRuleBody, secondPosition)
+}
+
+const testAccRuntimeContainerPolicyRuleBody = `collections = ["All"]
+	dns {
+		default_effect = "alert"
+		domain_list {
+			allowed = []
+			denied  = []
+			effect  = "disable"
+		}
+	}
+	filesystem {
+		allowed_list   = []
+		default_effect = "alert"
+		denied_list {
+			effect = "disable"
+			paths  = []
+		}
+	}
+	network {
+		allowed_ips    = []
+		default_effect = "alert"
+		denied_ips     = []
+		listening_ports {
+			effect = "disable"
+		}
+		outbound_ports {
+			effect = "disable"
+		}
+	}
+	processes {
+		allowed_list   = []
+		default_effect = "alert"
+		denied_list {
+			effect = "disable"
+			paths  = []
+		}
+	}`
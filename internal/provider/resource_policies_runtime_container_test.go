@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccRuntimeContainerPolicyConfig(t *testing.T) {
+	var o policy.RuntimeContainerPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
+		CheckDestroy: testAccRuntimeContainerPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyConfig(id),
+				Config: testAccRuntimeContainerPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
+					testAccCheckRuntimeContainerPolicyExists("prismacloudcompute_container_runtime_policy.test", &o),
+					testAccCheckRuntimeContainerPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyConfig(id),
+				Config: testAccRuntimeContainerPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
+					testAccCheckRuntimeContainerPolicyExists("prismacloudcompute_container_runtime_policy.test", &o),
+					testAccCheckRuntimeContainerPolicyAttributes(&o, name+"-second"),
 				),
 			},
 			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
+				ResourceName:      "prismacloudcompute_container_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckRuntimeContainerPolicyExists(n string, o *policy.RuntimeContainerPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeRuntimeContainer {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeRuntimeContainer)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.RuntimeContainerEndpoint)
+		lo, err := policy.GetRuntimeContainer(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,90 @@ func testAccCheckPolicyExists(n string, o *policy.Policy) resource.TestCheckFunc
 	}
 }
 
-func testAccCheckPolicyAttributes(o *policy.Policy, id string, learningDisabled bool) resource.TestCheckFunc {
+func testAccCheckRuntimeContainerPolicyAttributes(o *policy.RuntimeContainerPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.LearningDisabled != learningDisabled {
-			return fmt.Errorf("LearningDisabled is %t, expected %t", o.LearningDisabled, learningDisabled)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccRuntimeContainerPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policyruntimecontainer" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_container_runtime_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetRuntimeContainer(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultRuntimeContainer()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyRuntimeContainer" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccRuntimeContainerPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_container_runtime_policy" "test" {
+	rule {
+		name        = %q
+		collections = ["All"]
+		dns {
+			default_effect = "alert"
+			domain_list {
+				allowed = []
+				denied  = []
+				effect  = "disable"
+			}
+		}
+		filesystem {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+		network {
+			allowed_ips    = []
+			default_effect = "alert"
+			denied_ips     = []
+			listening_ports {
+				effect = "disable"
+			}
+			outbound_ports {
+				effect = "disable"
+			}
+		}
+		processes {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+	}
+}`, name)
 }
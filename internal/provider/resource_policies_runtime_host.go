@@ -17,11 +17,14 @@ func resourcePoliciesRuntimeHost() *schema.Resource {
 		UpdateContext: updatePolicyRuntimeHost,
 		DeleteContext: deletePolicyRuntimeHost,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -447,7 +450,7 @@ func createPolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta i
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateRuntimeHost(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateRuntimeHost(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeHost, err)
 	}
 
@@ -460,11 +463,15 @@ func readPolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta int
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetRuntimeHost(*client)
+	retrievedPolicy, err := policy.GetRuntimeHost(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeHost, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeHost, err)
+	}
+
 	if err := d.Set("rule", convert.RuntimeHostRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeHost, err)
 	}
@@ -473,6 +480,7 @@ func readPolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func updatePolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToRuntimeHostRules(d)
 	if err != nil {
@@ -483,7 +491,7 @@ func updatePolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta i
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateRuntimeHost(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateRuntimeHost(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeHost, err)
 	}
 
@@ -491,7 +499,15 @@ func updatePolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func deletePolicyRuntimeHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetRuntimeHost(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeRuntimeHost, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
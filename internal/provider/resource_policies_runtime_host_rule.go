@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeHostRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the host runtime policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_host_runtime_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyRuntimeHostRule,
+		ReadContext:   readPolicyRuntimeHostRule,
+		UpdateContext: updatePolicyRuntimeHostRule,
+		DeleteContext: deletePolicyRuntimeHostRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesRuntimeHost()),
+	}
+}
+
+func createPolicyRuntimeHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyRuntimeHostRule(ctx, d, meta, policy.CreateRuntimeHostRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyRuntimeHostRule(ctx, d, meta)
+}
+
+func readPolicyRuntimeHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetRuntimeHostRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.RuntimeHostRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyRuntimeHostRule(ctx, d, meta, policy.PutRuntimeHostRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+
+	return readPolicyRuntimeHostRule(ctx, d, meta)
+}
+
+func deletePolicyRuntimeHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteRuntimeHostRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeRuntimeHost, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyRuntimeHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.RuntimeHostRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToRuntimeHostRule(policyRuleFromSchema(d, resourcePoliciesRuntimeHostRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
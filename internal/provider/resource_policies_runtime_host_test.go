@@ -1,165 +1,159 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestPolicyRuntimeHostConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccRuntimeHostPolicyConfig(t *testing.T) {
+	var o policy.RuntimeHostPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
+		CheckDestroy: testAccRuntimeHostPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyConfig(id),
+				Config: testAccRuntimeHostPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
+					testAccCheckRuntimeHostPolicyExists("prismacloudcompute_host_runtime_policy.test", &o),
+					testAccCheckRuntimeHostPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyConfig(id),
+				Config: testAccRuntimeHostPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
+					testAccCheckRuntimeHostPolicyExists("prismacloudcompute_host_runtime_policy.test", &o),
+					testAccCheckRuntimeHostPolicyAttributes(&o, name+"-second"),
+					testAccCheckUpdateConflict("prismacloudcompute_host_runtime_policy.test", resourcePoliciesRuntimeHost(), testAccChangeRuntimeHostPolicy),
 				),
 			},
+			{
+				ResourceName:      "prismacloudcompute_host_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func TestPolicyRuntimeHostNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+// Change the notes of the first rule of the policy as another client would.
+func testAccChangeRuntimeHostPolicy(ctx context.Context, c api.Client) (func() error, error) {
+	current, err := policy.GetRuntimeHost(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	changed := policy.RuntimeHostPolicy{Rules: append([]policy.RuntimeHostRule{}, current.Rules...)}
+	changed.Rules[0].Notes = "changed by another client"
+	if err := policy.UpdateRuntimeHost(ctx, c, changed); err != nil {
+		return nil, err
+	}
+	return func() error {
+		return policy.UpdateRuntimeHost(ctx, c, current)
+	}, nil
+}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-		},
-	})
+func testAccCheckRuntimeHostPolicyExists(n string, o *policy.RuntimeHostPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeRuntimeHost {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeRuntimeHost)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetRuntimeHost(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
 }
 
-func TestPolicyRuntimeHostAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func testAccCheckRuntimeHostPolicyAttributes(o *policy.RuntimeHostPolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-			{
-				Config: testAccPolicyConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyExists("prismacloudcompute_policies_runtime_container.test", &o),
-					testAccCheckPolicyAttributes(&o, id, true),
-				),
-			},
-		},
-	})
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuntimeHostPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_host_runtime_policy" {
+			continue
+		}
+
+		lo, err := policy.GetRuntimeHost(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultRuntimeHost()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
 }
 
-// func testPolicyRuntimeHostExists(n string, o *policy.Policy) resource.TestCheckFunc {
-// 	return func(s *terraform.State) error {
-// 		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
-// 		rs, ok := s.RootModule().Resources[n]
-// 		if !ok {
-// 			return fmt.Errorf("Resource not found: %s", n)
-// 		}
-
-// 		if rs.Primary.ID == "" {
-// 			return fmt.Errorf("Object label Id is not set")
-// 		}
-
-// 		client := testAccProvider.Meta().(*api.Client)
-// 		lo, err := policy.Get(*client, policy.RuntimeHostEndpoint)
-// 		if err != nil {
-// 			return fmt.Errorf("Error in get: %s", err)
-// 		}
-// 		*o = lo
-
-// 		return nil
-// 	}
-// }
-
-// func testPolicyRuntimeHostAttributes(o *policy.Policy, id string, learningDisabled bool) resource.TestCheckFunc {
-// 	return func(s *terraform.State) error {
-// 		if o.PolicyId != id {
-// 			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-// 		} else {
-// 			fmt.Printf("\n\nName is %s", o.PolicyId)
-// 		}
-
-// 		if o.LearningDisabled != learningDisabled {
-// 			return fmt.Errorf("LearningDisabled is %t, expected %t", o.LearningDisabled, learningDisabled)
-// 		}
-
-// 		return nil
-// 	}
-// }
-
-// func testPolicyRuntimeHostDestroy(s *terraform.State) error {
-// 	/*	client := testAccProvider.Meta().(*api.Client)
-
-// 		for _, rs := range s.RootModule().Resources {
-
-// 			if rs.Type != "prismacloudcompute_policyruntimehost" {
-// 				continue
-// 			}
-
-// 			if rs.Primary.ID != "" {
-// 				name := rs.Primary.ID
-// 				if err := policy.Delete(client, name); err == nil {
-// 					return fmt.Errorf("Object %q still exists", name)
-// 				}
-// 			}
-// 			return nil
-// 		}
-// 	*/
-// 	return nil
-// }
-
-// func testPolicyRuntimeHostConfig(id string) string {
-// 	var buf bytes.Buffer
-// 	buf.Grow(500)
-
-// 	buf.WriteString(fmt.Sprintf(`
-// resource "prismacloudcompute_policyRuntimeHost" "test" {
-//     name = %q
-// }`, id))
-
-// 	return buf.String()
-// }
+func testAccRuntimeHostPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_host_runtime_policy" "test" {
+	rule {
+		name        = %q
+		collections = ["All"]
+		activities {
+			disabled = false
+		}
+		antimalware {
+			allowed_processes = []
+			crypto_miners     = "alert"
+			denied_processes {
+				effect = "alert"
+				paths  = []
+			}
+		}
+		dns {
+			allowed     = []
+			denied      = []
+			deny_effect = "disable"
+		}
+		network {
+			allowed_outbound_ips = []
+			denied_outbound_ips  = []
+			deny_effect          = "alert"
+		}
+	}
+}`, name)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeServerless() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyRuntimeServerless,
+		ReadContext:   readPolicyRuntimeServerless,
+		UpdateContext: updatePolicyRuntimeServerless,
+		DeleteContext: deletePolicyRuntimeServerless,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that make up the policy.",
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: runtimeContainerRuleSchema(
+						"advanced_protection_effect",
+						"collections",
+						"custom_rule",
+						"disabled",
+						"dns",
+						"filesystem",
+						"name",
+						"network",
+						"notes",
+						"previous_name",
+						"processes",
+					),
+				},
+			},
+		},
+	}
+}
+
+func createPolicyRuntimeServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToRuntimeServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	parsedPolicy := policy.RuntimeServerlessPolicy{
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateRuntimeServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	d.SetId(policyTypeRuntimeServerless)
+
+	return readPolicyRuntimeServerless(ctx, d, meta)
+}
+
+func readPolicyRuntimeServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetRuntimeServerless(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	if err := d.Set("rule", convert.RuntimeServerlessRulesToSchema(retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToRuntimeServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	parsedPolicy := policy.RuntimeServerlessPolicy{
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateRuntimeServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	return readPolicyRuntimeServerless(ctx, d, meta)
+}
+
+func deletePolicyRuntimeServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetRuntimeServerless(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeRuntimeServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
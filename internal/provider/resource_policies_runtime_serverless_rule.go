@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesRuntimeServerlessRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the serverless runtime policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_serverless_runtime_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyRuntimeServerlessRule,
+		ReadContext:   readPolicyRuntimeServerlessRule,
+		UpdateContext: updatePolicyRuntimeServerlessRule,
+		DeleteContext: deletePolicyRuntimeServerlessRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesRuntimeServerless()),
+	}
+}
+
+func createPolicyRuntimeServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyRuntimeServerlessRule(ctx, d, meta, policy.CreateRuntimeServerlessRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyRuntimeServerlessRule(ctx, d, meta)
+}
+
+func readPolicyRuntimeServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetRuntimeServerlessRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.RuntimeServerlessRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyRuntimeServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyRuntimeServerlessRule(ctx, d, meta, policy.PutRuntimeServerlessRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+
+	return readPolicyRuntimeServerlessRule(ctx, d, meta)
+}
+
+func deletePolicyRuntimeServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteRuntimeServerlessRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeRuntimeServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyRuntimeServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.RuntimeServerlessRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToRuntimeServerlessRule(policyRuleFromSchema(d, resourcePoliciesRuntimeServerlessRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRuntimeServerlessPolicyConfig(t *testing.T) {
+	var o policy.RuntimeServerlessPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRuntimeServerlessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeServerlessPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeServerlessPolicyExists("prismacloudcompute_serverless_runtime_policy.test", &o),
+					testAccCheckRuntimeServerlessPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccRuntimeServerlessPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuntimeServerlessPolicyExists("prismacloudcompute_serverless_runtime_policy.test", &o),
+					testAccCheckRuntimeServerlessPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_serverless_runtime_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeServerlessPolicyExists(n string, o *policy.RuntimeServerlessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeRuntimeServerless {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeRuntimeServerless)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetRuntimeServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeServerlessPolicyAttributes(o *policy.RuntimeServerlessPolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuntimeServerlessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_serverless_runtime_policy" {
+			continue
+		}
+
+		lo, err := policy.GetRuntimeServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultRuntimeServerless()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccRuntimeServerlessPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_serverless_runtime_policy" "test" {
+	rule {
+		name        = %q
+		collections = ["All"]
+		dns {
+			default_effect = "alert"
+			domain_list {
+				allowed = []
+				denied  = []
+				effect  = "disable"
+			}
+		}
+		filesystem {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+		network {
+			allowed_ips    = []
+			default_effect = "alert"
+			denied_ips     = []
+			listening_ports {
+				effect = "disable"
+			}
+			outbound_ports {
+				effect = "disable"
+			}
+		}
+		processes {
+			allowed_list   = []
+			default_effect = "alert"
+			denied_list {
+				effect = "disable"
+				paths  = []
+			}
+		}
+	}
+}`, name)
+}
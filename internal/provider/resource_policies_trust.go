@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePoliciesTrust() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyTrust,
+		ReadContext:   readPolicyTrust,
+		UpdateContext: updatePolicyTrust,
+		DeleteContext: deletePolicyTrust,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether or not to enforce the policy.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that make up the policy.",
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of the trust groups of images allowed by the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"block_message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Message to display when a container is blocked.",
+						},
+						"collections": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Collections used to scope the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"denied_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Names of the trust groups of images denied by the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to disable the rule.",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The effect of running a container from an image that is not allowed. Can be set to 'alert' or 'block'.",
+							ValidateFunc: validation.StringInSlice([]string{"alert", "block"}, false),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique name of the rule.",
+						},
+						"notes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Notes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createPolicyTrust(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToTrustRules(d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeTrust, err)
+	}
+
+	parsedPolicy := policy.TrustPolicy{
+		Enabled: d.Get("enabled").(bool),
+		Rules:   parsedRules,
+	}
+
+	if err := policy.UpdateTrust(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeTrust, err)
+	}
+
+	d.SetId(policyTypeTrust)
+
+	return readPolicyTrust(ctx, d, meta)
+}
+
+func readPolicyTrust(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetTrust(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeTrust, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeTrust, err)
+	}
+
+	d.Set("enabled", retrievedPolicy.Enabled)
+	if err := d.Set("rule", convert.TrustRulesToSchema(retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeTrust, err)
+	}
+	return diags
+}
+
+func updatePolicyTrust(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToTrustRules(d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeTrust, err)
+	}
+
+	parsedPolicy := policy.TrustPolicy{
+		Enabled: d.Get("enabled").(bool),
+		Rules:   parsedRules,
+	}
+
+	if err := policy.UpdateTrust(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeTrust, err)
+	}
+
+	return readPolicyTrust(ctx, d, meta)
+}
+
+func deletePolicyTrust(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetTrust(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeTrust, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
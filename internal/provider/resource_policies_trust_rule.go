@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesTrustRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the trusted images policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_trusted_images_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyTrustRule,
+		ReadContext:   readPolicyTrustRule,
+		UpdateContext: updatePolicyTrustRule,
+		DeleteContext: deletePolicyTrustRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesTrust()),
+	}
+}
+
+func createPolicyTrustRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyTrustRule(ctx, d, meta, policy.CreateTrustRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeTrust, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyTrustRule(ctx, d, meta)
+}
+
+func readPolicyTrustRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetTrustRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeTrust, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeTrust, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.TrustRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeTrust, err)
+	}
+	return diags
+}
+
+func updatePolicyTrustRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyTrustRule(ctx, d, meta, policy.PutTrustRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeTrust, err)
+	}
+
+	return readPolicyTrustRule(ctx, d, meta)
+}
+
+func deletePolicyTrustRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteTrustRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeTrust, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyTrustRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.TrustRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToTrustRule(policyRuleFromSchema(d, resourcePoliciesTrustRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
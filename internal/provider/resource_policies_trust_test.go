@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTrustPolicyConfig(t *testing.T) {
+	var o policy.TrustPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTrustPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustPolicyConfig(name, "alert"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustPolicyExists("prismacloudcompute_trusted_images_policy.test", &o),
+					testAccCheckTrustPolicyAttributes(&o, name, "alert"),
+				),
+			},
+			{
+				Config: testAccTrustPolicyConfig(name, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustPolicyExists("prismacloudcompute_trusted_images_policy.test", &o),
+					testAccCheckTrustPolicyAttributes(&o, name, "block"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_trusted_images_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustPolicyExists(n string, o *policy.TrustPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeTrust {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeTrust)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetTrust(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckTrustPolicyAttributes(o *policy.TrustPolicy, name, effect string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("Policy is not enabled")
+		}
+
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		rule := o.Rules[0]
+		if rule.Effect != effect {
+			return fmt.Errorf("Rule effect is %s, expected %s", rule.Effect, effect)
+		}
+		if len(rule.AllowedGroups) != 1 || rule.AllowedGroups[0] != name {
+			return fmt.Errorf("Rule allows groups %v, expected [%s]", rule.AllowedGroups, name)
+		}
+		if len(rule.Collections) != 1 || rule.Collections[0].Name != "All" {
+			return fmt.Errorf("Rule collections are %v, expected [All]", rule.Collections)
+		}
+
+		return nil
+	}
+}
+
+func testAccTrustPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_trusted_images_policy" {
+			continue
+		}
+
+		lo, err := policy.GetTrust(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.Enabled || len(lo.Rules) != 0 {
+			return fmt.Errorf("Policy was not reset: %+v", lo)
+		}
+	}
+
+	return nil
+}
+
+func testAccTrustPolicyConfig(name, effect string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_trust_group" "test" {
+	name   = %q
+	images = ["registry.example.com/*"]
+}
+
+resource "prismacloudcompute_trusted_images_policy" "test" {
+	enabled = true
+
+	rule {
+		name           = "Trusted registry"
+		collections    = ["All"]
+		effect         = %q
+		allowed_groups = [prismacloudcompute_trust_group.test.name]
+		block_message  = "Only images from the trusted registry can run"
+	}
+}`, name, effect)
+}
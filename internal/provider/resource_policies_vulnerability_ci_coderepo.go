@@ -17,11 +17,14 @@ func resourcePoliciesVulnerabilityCiCoderepo() *schema.Resource {
 		UpdateContext: updatePolicyVulnerabilityCiCoderepo,
 		DeleteContext: deletePolicyVulnerabilityCiCoderepo,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -255,7 +258,7 @@ func createPolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.Resource
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCiCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCiCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
 	}
 
@@ -268,11 +271,15 @@ func readPolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.ResourceDa
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetVulnerabilityCiCoderepo(*client)
+	retrievedPolicy, err := policy.GetVulnerabilityCiCoderepo(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
 	if err := d.Set("rule", convert.VulnerabilityCiCoderepoRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
 	}
@@ -281,6 +288,7 @@ func readPolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.ResourceDa
 }
 
 func updatePolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToVulnerabilityCiCoderepoRules(d)
 	if err != nil {
@@ -292,7 +300,7 @@ func updatePolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.Resource
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCiCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCiCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
 	}
 
@@ -300,7 +308,15 @@ func updatePolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.Resource
 }
 
 func deletePolicyVulnerabilityCiCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityCiCoderepo(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
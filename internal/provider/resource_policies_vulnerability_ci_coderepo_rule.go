@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityCiCoderepoRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the CI coderepo vulnerability policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_ci_coderepo_vulnerability_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyVulnerabilityCiCoderepoRule,
+		ReadContext:   readPolicyVulnerabilityCiCoderepoRule,
+		UpdateContext: updatePolicyVulnerabilityCiCoderepoRule,
+		DeleteContext: deletePolicyVulnerabilityCiCoderepoRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesVulnerabilityCiCoderepo()),
+	}
+}
+
+func createPolicyVulnerabilityCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyVulnerabilityCiCoderepoRule(ctx, d, meta, policy.CreateVulnerabilityCiCoderepoRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyVulnerabilityCiCoderepoRule(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetVulnerabilityCiCoderepoRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.VulnerabilityCiCoderepoRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+	return diags
+}
+
+func updatePolicyVulnerabilityCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyVulnerabilityCiCoderepoRule(ctx, d, meta, policy.PutVulnerabilityCiCoderepoRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	return readPolicyVulnerabilityCiCoderepoRule(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteVulnerabilityCiCoderepoRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeVulnerabilityCiCoderepo, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyVulnerabilityCiCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.VulnerabilityCoderepoRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToVulnerabilityCiCoderepoRule(policyRuleFromSchema(d, resourcePoliciesVulnerabilityCiCoderepoRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
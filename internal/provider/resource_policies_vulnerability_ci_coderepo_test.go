@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyVulnerabilityCiCoderepoConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyVulnerabilityCiCoderepoConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccVulnerabilityCiCoderepoPolicyConfig(t *testing.T) {
+	var o policy.VulnerabilityCoderepoPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiCoderepoDestroy,
+		CheckDestroy: testAccVulnerabilityCiCoderepoPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
+				Config: testAccVulnerabilityCiCoderepoPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCiCoderepoPolicyExists("prismacloudcompute_ci_coderepo_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCiCoderepoPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
+				Config: testAccVulnerabilityCiCoderepoPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCiCoderepoPolicyExists("prismacloudcompute_ci_coderepo_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCiCoderepoPolicyAttributes(&o, name+"-second"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCiCoderepoNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiCoderepoDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCiCoderepoAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiCoderepoDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCiCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCiCoderepoAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_ci_coderepo_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyVulnerabilityCiCoderepoExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCiCoderepoPolicyExists(n string, o *policy.VulnerabilityCoderepoPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeVulnerabilityCiCoderepo {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityCiCoderepo)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.VulnerabilityCiCodereposEndpoint)
+		lo, err := policy.GetVulnerabilityCiCoderepo(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,70 @@ func testAccCheckPolicyVulnerabilityCiCoderepoExists(n string, o *policy.Policy)
 	}
 }
 
-func testAccCheckPolicyVulnerabilityCiCoderepoAttributes(o *policy.Policy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCiCoderepoPolicyAttributes(o *policy.VulnerabilityCoderepoPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.PolicyType != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.PolicyType, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyVulnerabilityCiCoderepoDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccVulnerabilityCiCoderepoPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policyvulnerabilitycicodrepo" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ci_coderepo_vulnerability_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetVulnerabilityCiCoderepo(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityCiCoderepo()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyVulnerabilityCiCoderepoConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyVulnerabilityCiCoderepo" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccVulnerabilityCiCoderepoPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ci_coderepo_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+		grace_days_policy {
+			critical = 0
+			high     = 0
+			medium   = 0
+			low      = 0
+		}
+		block_threshold {
+			enabled = false
+			value   = 0
+		}
+	}
+}`, name)
 }
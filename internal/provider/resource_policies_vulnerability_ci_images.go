@@ -17,11 +17,14 @@ func resourcePoliciesVulnerabilityCiImage() *schema.Resource {
 		UpdateContext: updatePolicyVulnerabilityCiImage,
 		DeleteContext: deletePolicyVulnerabilityCiImage,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -255,7 +258,7 @@ func createPolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceDat
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCiImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCiImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityCiImage, err)
 	}
 
@@ -268,11 +271,15 @@ func readPolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetVulnerabilityCiImage(*client)
+	retrievedPolicy, err := policy.GetVulnerabilityCiImage(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiImage, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
 	if err := d.Set("rule", convert.VulnerabilityImageRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiImage, err)
 	}
@@ -281,6 +288,7 @@ func readPolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceData,
 }
 
 func updatePolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToVulnerabilityImageRules(d)
 	if err != nil {
@@ -292,7 +300,7 @@ func updatePolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceDat
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCiImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCiImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityCiImage, err)
 	}
 
@@ -300,7 +308,15 @@ func updatePolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceDat
 }
 
 func deletePolicyVulnerabilityCiImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityCiImage(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityCiImageRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the CI image vulnerability policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_ci_image_vulnerability_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyVulnerabilityCiImageRule,
+		ReadContext:   readPolicyVulnerabilityCiImageRule,
+		UpdateContext: updatePolicyVulnerabilityCiImageRule,
+		DeleteContext: deletePolicyVulnerabilityCiImageRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesVulnerabilityCiImage()),
+	}
+}
+
+func createPolicyVulnerabilityCiImageRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyVulnerabilityCiImageRule(ctx, d, meta, policy.CreateVulnerabilityCiImageRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyVulnerabilityCiImageRule(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityCiImageRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetVulnerabilityCiImageRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.VulnerabilityImageRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+	return diags
+}
+
+func updatePolicyVulnerabilityCiImageRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyVulnerabilityCiImageRule(ctx, d, meta, policy.PutVulnerabilityCiImageRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	return readPolicyVulnerabilityCiImageRule(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityCiImageRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteVulnerabilityCiImageRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeVulnerabilityCiImage, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyVulnerabilityCiImageRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.VulnerabilityImageRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToVulnerabilityImageRule(policyRuleFromSchema(d, resourcePoliciesVulnerabilityCiImageRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
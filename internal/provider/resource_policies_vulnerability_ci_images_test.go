@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyVulnerabilityCiImagesConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyVulnerabilityCiImagesConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccVulnerabilityCiImagePolicyConfig(t *testing.T) {
+	var o policy.VulnerabilityImagePolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiImagesDestroy,
+		CheckDestroy: testAccVulnerabilityCiImagePolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
+				Config: testAccVulnerabilityCiImagePolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCiImagePolicyExists("prismacloudcompute_ci_image_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCiImagePolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
+				Config: testAccVulnerabilityCiImagePolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCiImagePolicyExists("prismacloudcompute_ci_image_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCiImagePolicyAttributes(&o, name+"-second"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCiImagesNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiImagesDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCiImagesAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCiImagesDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCiImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCiImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityCiImagesAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_ci_image_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyVulnerabilityCiImagesExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCiImagePolicyExists(n string, o *policy.VulnerabilityImagePolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeVulnerabilityCiImage {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityCiImage)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.VulnerabilityCiImagesEndpoint)
+		lo, err := policy.GetVulnerabilityCiImage(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,70 @@ func testAccCheckPolicyVulnerabilityCiImagesExists(n string, o *policy.Policy) r
 	}
 }
 
-func testAccCheckPolicyVulnerabilityCiImagesAttributes(o *policy.Policy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCiImagePolicyAttributes(o *policy.VulnerabilityImagePolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.PolicyType != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.PolicyType, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyVulnerabilityCiImagesDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccVulnerabilityCiImagePolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policyvulnerabilityciimages" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ci_image_vulnerability_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetVulnerabilityCiImage(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityCiImage()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyVulnerabilityCiImagesConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyVulnerabilityCiImages" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccVulnerabilityCiImagePolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ci_image_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+		grace_days_policy {
+			critical = 0
+			high     = 0
+			medium   = 0
+			low      = 0
+		}
+		block_threshold {
+			enabled = false
+			value   = 0
+		}
+	}
+}`, name)
 }
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityCiServerless() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyVulnerabilityCiServerless,
+		ReadContext:   readPolicyVulnerabilityCiServerless,
+		UpdateContext: updatePolicyVulnerabilityCiServerless,
+		DeleteContext: deletePolicyVulnerabilityCiServerless,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that make up the policy.",
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alert_threshold": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Threshold for generating alerts.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether or not to disable vulnerability alerts.",
+									},
+									"value": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "Minimum vulnerability severity to generate an alert. Can be set to 0=off, 1=low, 4=medium, 7=high, and 9=critical.",
+									},
+								},
+							},
+						},
+						"block_threshold": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Threshold for blocking.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether or not to block when vulnerabilities are found.",
+									},
+									"value": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "Minimum vulnerability severity to block. Can be set to 0=off, 1=low, 4=medium, 7=high, and 9=critical.",
+									},
+								},
+							},
+						},
+						"collections": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Collections used to scope the rule.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cve_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "List of rules for handling specific CVEs.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Free-form text field.",
+									},
+									"effect": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Action to take if the CVE is found. Can be set to 'ignore', 'alert', or 'block'.",
+									},
+									"expiration": {
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Optional:    true,
+										Description: "CVE rule expiration.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"date": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Expiration date.",
+												},
+												"enabled": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: "Whether or not to enable the CVE rule expiration.",
+												},
+											},
+										},
+									},
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "CVE ID.",
+									},
+								},
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to disable the rule.",
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The effect of the rule. Can be set to 'ignore', 'alert', 'block', or 'alert, block'.",
+						},
+						"grace_days": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Number of days to suppress the rule's block effect. Measured from date the vulnerability was fixed. If there's no fix, measured from the date the vulnerability was published.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Unique name of the rule.",
+						},
+						"notes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Free-form text field.",
+						},
+						"only_fixed": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to apply the rule only when vendor fixes are available.",
+						},
+						"tag_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "List of rules for handling specific tags.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Free-form text field.",
+									},
+									"effect": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Action to take if a tagged CVE is found. Can be set to 'ignore', 'alert', or 'block'.",
+									},
+									"expiration": {
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Optional:    true,
+										Description: "Tag rule expiration.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"date": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Expiration date.",
+												},
+												"enabled": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: "Whether or not to enable the tag rule expiration.",
+												},
+											},
+										},
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Tag name.",
+									},
+								},
+							},
+						},
+						"verbose": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether or not to display a detailed message when blocked.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createPolicyVulnerabilityCiServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToVulnerabilityServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	parsedPolicy := policy.VulnerabilityServerlessPolicy{
+		Type:  policyTypeVulnerabilityCiServerless,
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateVulnerabilityCiServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	d.SetId(policyTypeVulnerabilityCiServerless)
+	return readPolicyVulnerabilityCiServerless(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityCiServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := policy.GetVulnerabilityCiServerless(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	if err := d.Set("rule", convert.VulnerabilityServerlessRulesToSchema(retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	return diags
+}
+
+func updatePolicyVulnerabilityCiServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := convert.SchemaToVulnerabilityServerlessRules(d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	parsedPolicy := policy.VulnerabilityServerlessPolicy{
+		Type:  policyTypeVulnerabilityCiServerless,
+		Rules: parsedRules,
+	}
+
+	if err := policy.UpdateVulnerabilityCiServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	return readPolicyVulnerabilityCiServerless(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityCiServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityCiServerless(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
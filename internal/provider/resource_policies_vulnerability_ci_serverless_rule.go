@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityCiServerlessRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the CI serverless vulnerability policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_ci_serverless_vulnerability_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyVulnerabilityCiServerlessRule,
+		ReadContext:   readPolicyVulnerabilityCiServerlessRule,
+		UpdateContext: updatePolicyVulnerabilityCiServerlessRule,
+		DeleteContext: deletePolicyVulnerabilityCiServerlessRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesVulnerabilityCiServerless()),
+	}
+}
+
+func createPolicyVulnerabilityCiServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyVulnerabilityCiServerlessRule(ctx, d, meta, policy.CreateVulnerabilityCiServerlessRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyVulnerabilityCiServerlessRule(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityCiServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetVulnerabilityCiServerlessRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.VulnerabilityServerlessRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyVulnerabilityCiServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyVulnerabilityCiServerlessRule(ctx, d, meta, policy.PutVulnerabilityCiServerlessRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	return readPolicyVulnerabilityCiServerlessRule(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityCiServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteVulnerabilityCiServerlessRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeVulnerabilityCiServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyVulnerabilityCiServerlessRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.VulnerabilityServerlessRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToVulnerabilityServerlessRule(policyRuleFromSchema(d, resourcePoliciesVulnerabilityCiServerlessRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
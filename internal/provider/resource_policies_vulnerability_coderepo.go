@@ -17,11 +17,14 @@ func resourcePoliciesVulnerabilityCoderepo() *schema.Resource {
 		UpdateContext: updatePolicyVulnerabilityCoderepo,
 		DeleteContext: deletePolicyVulnerabilityCoderepo,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -204,7 +207,7 @@ func createPolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceDa
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityCoderepo, err)
 	}
 
@@ -217,11 +220,15 @@ func readPolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceData
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetVulnerabilityCoderepo(*client)
+	retrievedPolicy, err := policy.GetVulnerabilityCoderepo(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCoderepo, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
 	if err := d.Set("rule", convert.VulnerabilityCoderepoRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityCoderepo, err)
 	}
@@ -230,6 +237,7 @@ func readPolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceData
 }
 
 func updatePolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToVulnerabilityCoderepoRules(d)
 	if err != nil {
@@ -241,7 +249,7 @@ func updatePolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceDa
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityCoderepo(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityCoderepo(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityCoderepo, err)
 	}
 
@@ -249,7 +257,15 @@ func updatePolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceDa
 }
 
 func deletePolicyVulnerabilityCoderepo(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityCoderepo(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityCoderepoRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the coderepo vulnerability policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_coderepo_vulnerability_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyVulnerabilityCoderepoRule,
+		ReadContext:   readPolicyVulnerabilityCoderepoRule,
+		UpdateContext: updatePolicyVulnerabilityCoderepoRule,
+		DeleteContext: deletePolicyVulnerabilityCoderepoRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesVulnerabilityCoderepo()),
+	}
+}
+
+func createPolicyVulnerabilityCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyVulnerabilityCoderepoRule(ctx, d, meta, policy.CreateVulnerabilityCoderepoRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyVulnerabilityCoderepoRule(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetVulnerabilityCoderepoRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.VulnerabilityCoderepoRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+	return diags
+}
+
+func updatePolicyVulnerabilityCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyVulnerabilityCoderepoRule(ctx, d, meta, policy.PutVulnerabilityCoderepoRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	return readPolicyVulnerabilityCoderepoRule(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteVulnerabilityCoderepoRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeVulnerabilityCoderepo, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyVulnerabilityCoderepoRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.VulnerabilityCoderepoRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToVulnerabilityCoderepoRule(policyRuleFromSchema(d, resourcePoliciesVulnerabilityCoderepoRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyVulnerabilityCoderepoConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyVulnerabilityCoderepoConfig")
+func TestAccVulnerabilityCoderepoPolicyConfig(t *testing.T) {
 	var o policy.VulnerabilityCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCoderepoDestroy,
+		CheckDestroy: testAccVulnerabilityCoderepoPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
+				Config: testAccVulnerabilityCoderepoPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCoderepoPolicyExists("prismacloudcompute_coderepo_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCoderepoPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
+				Config: testAccVulnerabilityCoderepoPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityCoderepoPolicyExists("prismacloudcompute_coderepo_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityCoderepoPolicyAttributes(&o, name+"-second"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCoderepoNetwork(t *testing.T) {
-	var o policy.VulnerabilityCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCoderepoDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityCoderepoAuditEvent(t *testing.T) {
-	var o policy.VulnerabilityCoderepoPolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityCoderepoDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityCoderepoConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityCoderepoExists("prismacloudcompute_policies_vulnerability_coderepo.test", &o),
-					testAccCheckPolicyVulnerabilityCoderepoAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_coderepo_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyVulnerabilityCoderepoExists(n string, o *policy.VulnerabilityCoderepoPolicy) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCoderepoPolicyExists(n string, o *policy.VulnerabilityCoderepoPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeVulnerabilityCoderepo {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityCoderepo)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.GetVulnerabilityCoderepo(*client)
+		lo, err := policy.GetVulnerabilityCoderepo(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,60 @@ func testAccCheckPolicyVulnerabilityCoderepoExists(n string, o *policy.Vulnerabi
 	}
 }
 
-func testAccCheckPolicyVulnerabilityCoderepoAttributes(o *policy.VulnerabilityCoderepoPolicy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckVulnerabilityCoderepoPolicyAttributes(o *policy.VulnerabilityCoderepoPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.Id != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.Id, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Id)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.Type != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.Type, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyVulnerabilityCoderepoDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
+func testAccVulnerabilityCoderepoPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-		for _, rs := range s.RootModule().Resources {
-
-			if rs.Type != "prismacloudcompute_policyvulnerabilitycoderepo" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_coderepo_vulnerability_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetVulnerabilityCoderepo(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityCoderepo()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyVulnerabilityCoderepoConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyVulnerabilityCoderepo" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccVulnerabilityCoderepoPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_coderepo_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+	}
+}`, name)
 }
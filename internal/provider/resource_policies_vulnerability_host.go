@@ -17,11 +17,14 @@ func resourcePoliciesVulnerabilityHost() *schema.Resource {
 		UpdateContext: updatePolicyVulnerabilityHost,
 		DeleteContext: deletePolicyVulnerabilityHost,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -204,7 +207,7 @@ func createPolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityHost(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityHost(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityHost, err)
 	}
 
@@ -217,11 +220,15 @@ func readPolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData, me
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetVulnerabilityHost(*client)
+	retrievedPolicy, err := policy.GetVulnerabilityHost(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityHost, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityHost, err)
+	}
+
 	if err := d.Set("rule", convert.VulnerabilityHostRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityHost, err)
 	}
@@ -230,6 +237,7 @@ func readPolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData, me
 }
 
 func updatePolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToVulnerabilityHostRules(d)
 	if err != nil {
@@ -241,7 +249,7 @@ func updatePolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityHost(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityHost(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityHost, err)
 	}
 
@@ -249,7 +257,15 @@ func updatePolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData,
 }
 
 func deletePolicyVulnerabilityHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityHost(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
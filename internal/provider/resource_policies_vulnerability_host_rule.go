@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesVulnerabilityHostRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a single rule of the host vulnerability policy, leaving the other rules as they are. " +
+			"Creating it fails if the policy already has a rule with the same name, which has to be imported to be managed by Terraform. " +
+			"Do not use it together with `prismacloudcompute_host_vulnerability_policy`, which manages all rules of the policy.",
+
+		CreateContext: createPolicyVulnerabilityHostRule,
+		ReadContext:   readPolicyVulnerabilityHostRule,
+		UpdateContext: updatePolicyVulnerabilityHostRule,
+		DeleteContext: deletePolicyVulnerabilityHostRule,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policyRuleSchema(resourcePoliciesVulnerabilityHost()),
+	}
+}
+
+func createPolicyVulnerabilityHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := putPolicyVulnerabilityHostRule(ctx, d, meta, policy.CreateVulnerabilityHostRule); err != nil {
+		return diag.Errorf("error creating %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readPolicyVulnerabilityHostRule(ctx, d, meta)
+}
+
+func readPolicyVulnerabilityHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedRule, err := policy.GetVulnerabilityHostRule(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	if err := setDigest(d, *retrievedRule); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	if err := policyRuleToSchema(d, convert.VulnerabilityHostRuleToSchema(*retrievedRule)); err != nil {
+		return diag.Errorf("error reading %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+	return diags
+}
+
+func updatePolicyVulnerabilityHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	if err := putPolicyVulnerabilityHostRule(ctx, d, meta, policy.PutVulnerabilityHostRule); err != nil {
+		return diag.Errorf("error updating %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	return readPolicyVulnerabilityHostRule(ctx, d, meta)
+}
+
+func deletePolicyVulnerabilityHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteVulnerabilityHostRule(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting %s policy rule: %s", policyTypeVulnerabilityHost, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Create or update the rule with put, which is given the rule and its
+// position parsed from the resource.
+func putPolicyVulnerabilityHostRule(ctx context.Context, d *schema.ResourceData, meta interface{}, put func(context.Context, api.Client, policy.VulnerabilityHostRule, policy.RulePosition) error) error {
+	client := meta.(*api.Client)
+
+	position, err := policyRulePosition(d)
+	if err != nil {
+		return err
+	}
+	parsedRule := convert.SchemaToVulnerabilityHostRule(policyRuleFromSchema(d, resourcePoliciesVulnerabilityHostRule().Schema))
+
+	return put(ctx, *client, parsedRule, position)
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyVulnerabilityHostConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyVulnerabilityHostConfig")
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+func TestAccVulnerabilityHostPolicyConfig(t *testing.T) {
+	var o policy.VulnerabilityHostPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityHostDestroy,
+		CheckDestroy: testAccVulnerabilityHostPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
+				Config: testAccVulnerabilityHostPolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityHostPolicyExists("prismacloudcompute_host_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityHostPolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
+				Config: testAccVulnerabilityHostPolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityHostNetwork(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityHostDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityHostAuditEvent(t *testing.T) {
-	var o policy.Policy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityHostDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityHostPolicyExists("prismacloudcompute_host_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityHostPolicyAttributes(&o, name+"-second"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityHostConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityHostExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityHostAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_host_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyVulnerabilityHostExists(n string, o *policy.Policy) resource.TestCheckFunc {
+func testAccCheckVulnerabilityHostPolicyExists(n string, o *policy.VulnerabilityHostPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeVulnerabilityHost {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityHost)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.Get(*client, policy.VulnerabilityHostEndpoint)
+		lo, err := policy.GetVulnerabilityHost(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,60 @@ func testAccCheckPolicyVulnerabilityHostExists(n string, o *policy.Policy) resou
 	}
 }
 
-func testAccCheckPolicyVulnerabilityHostAttributes(o *policy.Policy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckVulnerabilityHostPolicyAttributes(o *policy.VulnerabilityHostPolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.PolicyId != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.PolicyId, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.PolicyId)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.PolicyType != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.PolicyType, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyVulnerabilityHostDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
+func testAccVulnerabilityHostPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-		for _, rs := range s.RootModule().Resources {
-
-			if rs.Type != "prismacloudcompute_policyvulnerabilityhost" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_host_vulnerability_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetVulnerabilityHost(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityHost()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyVulnerabilityHostConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyVulnerabilityHost" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccVulnerabilityHostPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_host_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+	}
+}`, name)
 }
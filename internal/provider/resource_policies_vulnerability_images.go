@@ -17,11 +17,14 @@ func resourcePoliciesVulnerabilityImage() *schema.Resource {
 		UpdateContext: updatePolicyVulnerabilityImage,
 		DeleteContext: deletePolicyVulnerabilityImage,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the policy.",
 				Type:        schema.TypeString,
@@ -255,7 +258,7 @@ func createPolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error creating %s policy: %s", policyTypeVulnerabilityImage, err)
 	}
 
@@ -268,11 +271,15 @@ func readPolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData, m
 
 	var diags diag.Diagnostics
 
-	retrievedPolicy, err := policy.GetVulnerabilityImage(*client)
+	retrievedPolicy, err := policy.GetVulnerabilityImage(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityImage, err)
 	}
 
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityImage, err)
+	}
+
 	if err := d.Set("rule", convert.VulnerabilityImageRulesToSchema(retrievedPolicy.Rules)); err != nil {
 		return diag.Errorf("error reading %s policy: %s", policyTypeVulnerabilityImage, err)
 	}
@@ -281,6 +288,7 @@ func readPolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData, m
 }
 
 func updatePolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRules, err := convert.SchemaToVulnerabilityImageRules(d)
 	if err != nil {
@@ -292,7 +300,7 @@ func updatePolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData,
 		Rules: parsedRules,
 	}
 
-	if err := policy.UpdateVulnerabilityImage(*client, parsedPolicy); err != nil {
+	if err := policy.UpdateVulnerabilityImage(ctx, *client, parsedPolicy); err != nil {
 		return diag.Errorf("error updating %s policy: %s", policyTypeVulnerabilityImage, err)
 	}
 
@@ -300,7 +308,15 @@ func updatePolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData,
 }
 
 func deletePolicyVulnerabilityImage(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// TODO: reset to default policy
+	client := meta.(*api.Client)
+
 	var diags diag.Diagnostics
+
+	if err := policy.ResetVulnerabilityImage(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeVulnerabilityImage, err)
+	}
+
+	d.SetId("")
+
 	return diags
 }
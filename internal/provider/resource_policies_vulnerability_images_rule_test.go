@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVulnerabilityImagePolicyRuleConfig(t *testing.T) {
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVulnerabilityImagePolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVulnerabilityImagePolicyRuleConfig(name, "alert", "bottom"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_image_vulnerability_policy_rule.test", "id", name),
+					testAccCheckVulnerabilityImagePolicyRule(name, "alert", false),
+				),
+			},
+			{
+				Config: testAccVulnerabilityImagePolicyRuleConfig(name, "block", "top"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("prismacloudcompute_image_vulnerability_policy_rule.test", "effect", "block"),
+					testAccCheckVulnerabilityImagePolicyRule(name, "block", true),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_image_vulnerability_policy_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"position", "relative_to"},
+			},
+		},
+	})
+}
+
+func testAccCheckVulnerabilityImagePolicyRule(name, effect string, top bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetVulnerabilityImage(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+
+		i := 0
+		if !top {
+			i = len(lo.Rules) - 1
+		}
+		if len(lo.Rules) == 0 || lo.Rules[i].Name != name {
+			return fmt.Errorf("Rule %s is not at index %d of %d rules", name, i, len(lo.Rules))
+		}
+		if lo.Rules[i].Effect != effect {
+			return fmt.Errorf("Rule effect is %s, expected %s", lo.Rules[i].Effect, effect)
+		}
+
+		return nil
+	}
+}
+
+func testAccVulnerabilityImagePolicyRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_image_vulnerability_policy_rule" {
+			continue
+		}
+
+		_, err := policy.GetVulnerabilityImageRule(context.Background(), *client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Rule %s still exists", rs.Primary.ID)
+		}
+		if !api.IsNotFound(err) {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func testAccVulnerabilityImagePolicyRuleConfig(name, effect, position string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_image_vulnerability_policy_rule" "test" {
+	name        = %q
+	effect      = %q
+	position    = %q
+	collections = ["All"]
+	alert_threshold {
+		disabled = false
+		value    = 0
+	}
+	grace_days_policy {
+		critical = 0
+		high     = 0
+		medium   = 0
+		low      = 0
+	}
+	block_threshold {
+		enabled = %t
+		value   = 0
+	}
+}`, name, effect, position, effect == "block")
+}
@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,103 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-func TestAccPolicyVulnerabilityImagesConfig(t *testing.T) {
-	fmt.Printf("\n\nStart TestAccPolicyVulnerabilityImagesConfig")
+func TestAccVulnerabilityImagePolicyConfig(t *testing.T) {
 	var o policy.VulnerabilityImagePolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityImagesDestroy,
+		CheckDestroy: testAccVulnerabilityImagePolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
+				Config: testAccVulnerabilityImagePolicyConfig(name + "-first"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityImagePolicyExists("prismacloudcompute_image_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityImagePolicyAttributes(&o, name+"-first"),
 				),
 			},
 			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
+				Config: testAccVulnerabilityImagePolicyConfig(name + "-second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
+					testAccCheckVulnerabilityImagePolicyExists("prismacloudcompute_image_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityImagePolicyAttributes(&o, name+"-second"),
 				),
 			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityImagesNetwork(t *testing.T) {
-	var o policy.VulnerabilityImagePolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityImagesDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccPolicyVulnerabilityImagesAuditEvent(t *testing.T) {
-	var o policy.VulnerabilityImagePolicy
-	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccPolicyVulnerabilityImagesDestroy,
-		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
-				),
-			},
-			{
-				Config: testAccPolicyVulnerabilityImagesConfig(id),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPolicyVulnerabilityImagesExists("prismacloudcompute_policies_vulnerability_images.test", &o),
-					testAccCheckPolicyVulnerabilityImagesAttributes(&o, id, "network"),
-				),
+				ResourceName:      "prismacloudcompute_image_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
 }
 
-func testAccCheckPolicyVulnerabilityImagesExists(n string, o *policy.VulnerabilityImagePolicy) resource.TestCheckFunc {
+func testAccCheckVulnerabilityImagePolicyExists(n string, o *policy.VulnerabilityImagePolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		// return fmt.Errorf("What is the name: %s", o.PolicyId)
-
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Resource not found: %s", n)
 		}
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("Object label Id is not set")
+		if rs.Primary.ID != policyTypeVulnerabilityImage {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityImage)
 		}
 
 		client := testAccProvider.Meta().(*api.Client)
-		lo, err := policy.GetVulnerabilityImage(*client)
+		lo, err := policy.GetVulnerabilityImage(context.Background(), *client)
 		if err != nil {
 			return fmt.Errorf("Error in get: %s", err)
 		}
@@ -119,51 +67,70 @@ func testAccCheckPolicyVulnerabilityImagesExists(n string, o *policy.Vulnerabili
 	}
 }
 
-func testAccCheckPolicyVulnerabilityImagesAttributes(o *policy.VulnerabilityImagePolicy, id string, policyType string) resource.TestCheckFunc {
+func testAccCheckVulnerabilityImagePolicyAttributes(o *policy.VulnerabilityImagePolicy, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if o.Id != id {
-			return fmt.Errorf("\n\nPolicyId is %s, expected %s", o.Id, id)
-		} else {
-			fmt.Printf("\n\nName is %s", o.Id)
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
 		}
 
-		if o.Type != policyType {
-			return fmt.Errorf("PolicyType is %s, expected %s", o.Type, policyType)
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
 		}
 
 		return nil
 	}
 }
 
-func testAccPolicyVulnerabilityImagesDestroy(s *terraform.State) error {
-	/*	client := testAccProvider.Meta().(*api.Client)
-
-		for _, rs := range s.RootModule().Resources {
+func testAccVulnerabilityImagePolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
 
-			if rs.Type != "prismacloudcompute_policyvulnerabilityimages" {
-				continue
-			}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_image_vulnerability_policy" {
+			continue
+		}
 
-			if rs.Primary.ID != "" {
-				name := rs.Primary.ID
-				if err := policy.Delete(client, name); err == nil {
-					return fmt.Errorf("Object %q still exists", name)
-				}
+		lo, err := policy.GetVulnerabilityImage(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityImage()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
 			}
-			return nil
 		}
-	*/
+	}
+
 	return nil
 }
 
-func testAccPolicyVulnerabilityImagesConfig(id string) string {
-	var buf bytes.Buffer
-	buf.Grow(500)
-
-	buf.WriteString(fmt.Sprintf(`
-resource "prismacloudcompute_policyVulnerabilityImages" "test" {
-    name = %q
-}`, id))
-
-	return buf.String()
+func testAccVulnerabilityImagePolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_image_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+		grace_days_policy {
+			critical = 0
+			high     = 0
+			medium   = 0
+			low      = 0
+		}
+		block_threshold {
+			enabled = false
+			value   = 0
+		}
+	}
+}`, name)
 }
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVulnerabilityServerlessPolicyConfig(t *testing.T) {
+	var o policy.VulnerabilityServerlessPolicy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVulnerabilityServerlessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVulnerabilityServerlessPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVulnerabilityServerlessPolicyExists("prismacloudcompute_serverless_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityServerlessPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccVulnerabilityServerlessPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVulnerabilityServerlessPolicyExists("prismacloudcompute_serverless_vulnerability_policy.test", &o),
+					testAccCheckVulnerabilityServerlessPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_serverless_vulnerability_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVulnerabilityServerlessPolicyExists(n string, o *policy.VulnerabilityServerlessPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != policyTypeVulnerabilityServerless {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, policyTypeVulnerabilityServerless)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetVulnerabilityServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckVulnerabilityServerlessPolicyAttributes(o *policy.VulnerabilityServerlessPolicy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccVulnerabilityServerlessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_serverless_vulnerability_policy" {
+			continue
+		}
+
+		lo, err := policy.GetVulnerabilityServerless(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := policy.DefaultVulnerabilityServerless()
+		if err != nil {
+			return err
+		}
+		if len(lo.Rules) != len(defaults.Rules) {
+			return fmt.Errorf("Policy has %d rules, expected the %d default rules", len(lo.Rules), len(defaults.Rules))
+		}
+		for i := range lo.Rules {
+			if lo.Rules[i].Name != defaults.Rules[i].Name {
+				return fmt.Errorf("Rule %d is %q, expected default rule %q", i, lo.Rules[i].Name, defaults.Rules[i].Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccVulnerabilityServerlessPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_serverless_vulnerability_policy" "test" {
+	rule {
+		name        = %q
+		effect      = "alert"
+		collections = ["All"]
+		alert_threshold {
+			disabled = false
+			value    = 0
+		}
+		cve_rule {
+			id          = "CVE-2021-44228"
+			description = "Accepted risk"
+			effect      = "ignore"
+			expiration {
+				enabled = false
+			}
+		}
+		block_threshold {
+			enabled = false
+			value   = 0
+		}
+	}
+}`, name)
+}
@@ -0,0 +1,541 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Get the schema of the rules of a WAAS policy, which is the same for every
+// kind of workload.
+func waasRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rules that make up the policy.",
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allow_malformed_http_header_names": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether or not to allow HTTP header names that do not comply with the HTTP specification.",
+				},
+				"application": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Applications protected by the rule.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api_protection": {
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Optional:    true,
+								Description: "API protection configuration.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"description": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "Description of the application.",
+										},
+										"effect": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect of requests to paths and methods that are not part of the API. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+										},
+										"endpoint": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Description: "Endpoints the application is served on.",
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"base_path": {
+														Type:        schema.TypeString,
+														Optional:    true,
+														Description: "Base path of the endpoint. Wildcards are supported.",
+													},
+													"exposed_port": {
+														Type:        schema.TypeInt,
+														Optional:    true,
+														Description: "Port WAAS listens on for traffic to the application, if different from the internal port.",
+													},
+													"grpc": {
+														Type:        schema.TypeBool,
+														Optional:    true,
+														Description: "Whether or not the application uses gRPC.",
+													},
+													"host": {
+														Type:        schema.TypeString,
+														Optional:    true,
+														Description: "Host name of the endpoint. Wildcards are supported.",
+													},
+													"http2": {
+														Type:        schema.TypeBool,
+														Optional:    true,
+														Description: "Whether or not the application uses HTTP/2.",
+													},
+													"internal_port": {
+														Type:        schema.TypeInt,
+														Optional:    true,
+														Description: "Port the application listens on.",
+													},
+													"tls": {
+														Type:        schema.TypeBool,
+														Optional:    true,
+														Description: "Whether or not the application is served over TLS.",
+													},
+												},
+											},
+										},
+										"fallback_effect": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect of requests with parameters that do not match the API. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+										},
+										"openapi_spec": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											Description:      "OpenAPI 2 or 3 specification of the API in JSON format, such as `file(\"openapi.json\")` or `jsonencode(yamldecode(file(\"openapi.yaml\")))`. The paths of the API are imported from it.",
+											ValidateFunc:     validation.StringIsJSON,
+											DiffSuppressFunc: suppressEquivalentJSONDiffs,
+											StateFunc: func(v interface{}) string {
+												json, _ := structure.NormalizeJsonString(v)
+												return json
+											},
+										},
+										"path": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Computed:    true,
+											Description: "Paths of the API. Paths imported from `openapi_spec` are shown here.",
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"methods": {
+														Type:        schema.TypeList,
+														Optional:    true,
+														Description: "HTTP methods allowed on the path.",
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+													"path": {
+														Type:        schema.TypeString,
+														Required:    true,
+														Description: "The path, relative to the base path of the endpoints.",
+													},
+												},
+											},
+										},
+										"query_param_fallback_effect": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect of requests with query parameters that are not part of the API. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+										},
+										"skip_learning": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Whether or not to skip learning the API from traffic.",
+										},
+									},
+								},
+							},
+							"app_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Unique ID of the application within the rule.",
+							},
+							"attack_tools": waasProtectionSchema("Attack tools and vulnerability scanners protection."),
+							"bot_protection": {
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Optional:    true,
+								Description: "Bot protection configuration.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"interstitial_page": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Whether or not to show an interstitial page while the browser is checked.",
+										},
+										"js_injection": {
+											Type:        schema.TypeList,
+											MaxItems:    1,
+											Optional:    true,
+											Description: "JavaScript injection to detect browsers.",
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"enabled": {
+														Type:        schema.TypeBool,
+														Optional:    true,
+														Description: "Whether or not to inject JavaScript into responses.",
+													},
+													"timeout_effect": {
+														Type:        schema.TypeString,
+														Optional:    true,
+														Description: "The effect of clients that do not run the JavaScript in time. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+													},
+												},
+											},
+										},
+										"known_bots": {
+											Type:        schema.TypeList,
+											MaxItems:    1,
+											Optional:    true,
+											Description: "Effects of known bots by category.",
+											Elem: &schema.Resource{
+												Schema: waasEffectsSchema(
+													"archiving",
+													"business_analytics",
+													"career_search",
+													"content_feed_clients",
+													"educational",
+													"financial",
+													"media_search",
+													"news",
+													"search_engine_crawlers",
+												),
+											},
+										},
+										"session_validation": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect of requests without a valid session cookie. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+										},
+										"unknown_bots": {
+											Type:        schema.TypeList,
+											MaxItems:    1,
+											Optional:    true,
+											Description: "Effects of unknown bots by category.",
+											Elem: &schema.Resource{
+												Schema: waasUnknownBotsSchema(),
+											},
+										},
+										"user_defined_bot": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Description: "Bots identified by a header or subnets.",
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"effect": {
+														Type:        schema.TypeString,
+														Optional:    true,
+														Description: "The effect of requests from the bot. Can be set to 'ban', 'prevent', 'alert', 'allow', or 'disable'.",
+													},
+													"header_name": {
+														Type:        schema.TypeString,
+														Optional:    true,
+														Description: "Header that identifies the bot.",
+													},
+													"header_values": {
+														Type:        schema.TypeList,
+														Optional:    true,
+														Description: "Values of the header that identify the bot.",
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+													"name": {
+														Type:        schema.TypeString,
+														Required:    true,
+														Description: "Name of the bot.",
+													},
+													"subnets": {
+														Type:        schema.TypeList,
+														Optional:    true,
+														Description: "Subnets the bot sends requests from.",
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"clickjacking_enabled": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether or not to prevent the application from being embedded in frames of other sites.",
+							},
+							"code_injection":    waasProtectionSchema("Code injection protection."),
+							"command_injection": waasProtectionSchema("OS command injection protection."),
+							"csrf_enabled": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether or not to enable cross-site request forgery protection.",
+							},
+							"custom_rule": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Custom WAAS rules of type 'waas-request' or 'waas-response' applied to the application.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"action": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The action to perform if the custom rule applies. Can be set to 'audit' or 'incident'.",
+										},
+										"effect": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect to be used. Can be set to 'ban', 'prevent', 'alert', 'allow', or 'disable'.",
+										},
+										"id": {
+											Type:        schema.TypeInt,
+											Required:    true,
+											Description: "Custom rule number.",
+										},
+									},
+								},
+							},
+							"intel_gathering": {
+								Type:        schema.TypeList,
+								MaxItems:    1,
+								Optional:    true,
+								Description: "Detection of information leakage.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"info_leakage_effect": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The effect of responses that leak information, such as stack traces. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+										},
+										"remove_fingerprints": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Whether or not to remove server fingerprints, such as the Server header, from responses.",
+										},
+									},
+								},
+							},
+							"local_file_inclusion": waasProtectionSchema("Local file inclusion protection."),
+							"malformed_request":    waasProtectionSchema("Malformed HTTP request protection."),
+							"shellshock":           waasProtectionSchema("Shellshock protection."),
+							"sql_injection":        waasProtectionSchema("SQL injection protection."),
+							"xss":                  waasProtectionSchema("Cross-site scripting protection."),
+						},
+					},
+				},
+				"collections": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Collections used to scope the rule.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"disabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether or not to disable the rule.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Unique name of the rule.",
+				},
+				"notes": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Notes.",
+				},
+				"read_timeout_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Timeout in seconds for reading requests from clients.",
+				},
+				"skip_api_learning": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether or not to skip learning the APIs of the applications from traffic.",
+				},
+			},
+		},
+	}
+}
+
+func waasProtectionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"effect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The effect of attacks. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+				},
+				"exception_field": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Parts of requests that are not inspected.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the field, such as a header or parameter name.",
+							},
+							"location": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Where the field is. Can be set to 'path', 'query', 'queryValues', 'cookie', 'UserAgentHeader', 'header', 'headerValues', 'body', 'JSONPath', 'XMLPath', 'multipart' or 'rawBody'.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get the schema of attributes that are each the effect of a category.
+func waasEffectsSchema(keys ...string) map[string]*schema.Schema {
+	ans := make(map[string]*schema.Schema, len(keys))
+	for _, key := range keys {
+		ans[key] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The effect of requests from bots in the category. Can be set to 'ban', 'prevent', 'alert', 'allow', or 'disable'.",
+		}
+	}
+	return ans
+}
+
+func waasUnknownBotsSchema() map[string]*schema.Schema {
+	ans := waasEffectsSchema(
+		"api_libraries",
+		"bot_impersonation",
+		"browser_impersonation",
+		"generic",
+		"headless_browsers",
+		"http_libraries",
+		"web_automation_tools",
+		"web_scrapers",
+	)
+	ans["request_anomalies"] = &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Description: "Detection of bots by anomalies in their requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"effect": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The effect of requests with anomalies. Can be set to 'ban', 'prevent', 'alert', or 'disable'.",
+				},
+				"threshold": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Number of anomalies after which the effect applies.",
+				},
+			},
+		},
+	}
+	return ans
+}
+
+// Get the schema of the port range WAAS proxies listen on, for the container
+// and host policies.
+func waasPortSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  description,
+		ValidateFunc: validation.IsPortNumber,
+	}
+}
+
+func suppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldJSON, err := structure.NormalizeJsonString(old)
+	if err != nil {
+		return false
+	}
+	newJSON, err := structure.NormalizeJsonString(new)
+	if err != nil {
+		return false
+	}
+	return oldJSON == newJSON
+}
+
+// Get the rules of a WAAS policy, importing the paths of the APIs with an
+// OpenAPI specification.
+func waasRulesFromSchema(ctx context.Context, client api.Client, d *schema.ResourceData) ([]waas.Rule, error) {
+	parsedRules, err := convert.SchemaToWaasRules(d)
+	if err != nil {
+		return nil, err
+	}
+	for i, val := range d.Get("rule").([]interface{}) {
+		for j, spec := range waasOpenAPISpecs(val) {
+			if spec == "" {
+				continue
+			}
+			imported, err := waas.ImportAPISpec(ctx, client, spec)
+			if err != nil {
+				return nil, err
+			}
+			parsedRules[i].Applications[j].ApiSpec.Paths = imported.Paths
+		}
+	}
+	return parsedRules, nil
+}
+
+// Get the rules of a WAAS policy for the state. The Console does not keep
+// OpenAPI specifications, so they are kept from the current state.
+func waasRulesToSchema(d *schema.ResourceData, rules []waas.Rule) []interface{} {
+	specs := make(map[[2]string]string)
+	for _, val := range d.Get("rule").([]interface{}) {
+		rule, _ := val.(map[string]interface{})
+		apps, _ := rule["application"].([]interface{})
+		for j, spec := range waasOpenAPISpecs(val) {
+			app := apps[j].(map[string]interface{})
+			specs[[2]string{rule["name"].(string), app["app_id"].(string)}] = spec
+		}
+	}
+
+	ans := convert.WaasRulesToSchema(rules)
+	for _, val := range ans {
+		rule := val.(map[string]interface{})
+		for _, a := range rule["application"].([]interface{}) {
+			app := a.(map[string]interface{})
+			spec, ok := specs[[2]string{rule["name"].(string), app["app_id"].(string)}]
+			if !ok {
+				continue
+			}
+			apiProtection := app["api_protection"].([]interface{})
+			if len(apiProtection) == 0 {
+				continue
+			}
+			apiProtection[0].(map[string]interface{})["openapi_spec"] = spec
+		}
+	}
+	return ans
+}
+
+// Get the OpenAPI specification of each application of a rule in the schema,
+// or an empty string for applications without one.
+func waasOpenAPISpecs(rule interface{}) []string {
+	r, _ := rule.(map[string]interface{})
+	apps, _ := r["application"].([]interface{})
+	ans := make([]string, len(apps))
+	for i, val := range apps {
+		app, _ := val.(map[string]interface{})
+		apiProtection, _ := app["api_protection"].([]interface{})
+		if len(apiProtection) == 0 || apiProtection[0] == nil {
+			continue
+		}
+		ans[i], _ = apiProtection[0].(map[string]interface{})["openapi_spec"].(string)
+	}
+	return ans
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesWaasAppEmbedded() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyWaasAppEmbedded,
+		ReadContext:   readPolicyWaasAppEmbedded,
+		UpdateContext: updatePolicyWaasAppEmbedded,
+		DeleteContext: deletePolicyWaasAppEmbedded,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": waasRuleSchema(),
+		},
+	}
+}
+
+func createPolicyWaasAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateAppEmbedded(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	d.SetId(policyTypeWaasAppEmbedded)
+
+	return readPolicyWaasAppEmbedded(ctx, d, meta)
+}
+
+func readPolicyWaasAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := waas.GetAppEmbedded(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	if err := d.Set("rule", waasRulesToSchema(d, retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+	return diags
+}
+
+func updatePolicyWaasAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateAppEmbedded(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	return readPolicyWaasAppEmbedded(ctx, d, meta)
+}
+
+func deletePolicyWaasAppEmbedded(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := waas.ResetAppEmbedded(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeWaasAppEmbedded, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesWaasContainer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyWaasContainer,
+		ReadContext:   readPolicyWaasContainer,
+		UpdateContext: updatePolicyWaasContainer,
+		DeleteContext: deletePolicyWaasContainer,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"max_port": waasPortSchema("Highest port of the range WAAS proxies listen on."),
+			"min_port": waasPortSchema("Lowest port of the range WAAS proxies listen on."),
+			"rule":     waasRuleSchema(),
+		},
+	}
+}
+
+func createPolicyWaasContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		MaxPort: d.Get("max_port").(int),
+		MinPort: d.Get("min_port").(int),
+		Rules:   parsedRules,
+	}
+
+	if err := waas.UpdateContainer(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	d.SetId(policyTypeWaasContainer)
+
+	return readPolicyWaasContainer(ctx, d, meta)
+}
+
+func readPolicyWaasContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := waas.GetContainer(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	if err := d.Set("max_port", retrievedPolicy.MaxPort); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasContainer, err)
+	}
+	if err := d.Set("min_port", retrievedPolicy.MinPort); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasContainer, err)
+	}
+	if err := d.Set("rule", waasRulesToSchema(d, retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasContainer, err)
+	}
+	return diags
+}
+
+func updatePolicyWaasContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		MaxPort: d.Get("max_port").(int),
+		MinPort: d.Get("min_port").(int),
+		Rules:   parsedRules,
+	}
+
+	if err := waas.UpdateContainer(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	return readPolicyWaasContainer(ctx, d, meta)
+}
+
+func deletePolicyWaasContainer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := waas.ResetContainer(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeWaasContainer, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccWaasContainerPolicyConfig(t *testing.T) {
+	var o waas.Policy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWaasPolicyDestroy("prismacloudcompute_container_waas_policy", waas.GetContainer),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaasContainerPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaasPolicyExists("prismacloudcompute_container_waas_policy.test", policyTypeWaasContainer, waas.GetContainer, &o),
+					testAccCheckWaasPolicyAttributes(&o, name+"-first"),
+					testAccCheckWaasContainerPolicyApplication(&o),
+					resource.TestCheckResourceAttr("prismacloudcompute_container_waas_policy.test", "rule.0.application.0.api_protection.0.path.#", "2"),
+					resource.TestCheckResourceAttr("prismacloudcompute_container_waas_policy.test", "rule.0.application.0.api_protection.0.path.1.path", "/pets/{id}"),
+				),
+			},
+			{
+				Config: testAccWaasContainerPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaasPolicyExists("prismacloudcompute_container_waas_policy.test", policyTypeWaasContainer, waas.GetContainer, &o),
+					testAccCheckWaasPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_container_waas_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The Console does not keep OpenAPI specifications.
+				ImportStateVerifyIgnore: []string{"rule.0.application.0.api_protection.0.openapi_spec"},
+			},
+		},
+	})
+}
+
+func testAccCheckWaasPolicyExists(n, id string, get func(context.Context, api.Client) (waas.Policy, error), o *waas.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != id {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, id)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := get(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckWaasPolicyAttributes(o *waas.Policy, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(o.Rules) != 1 {
+			return fmt.Errorf("Policy has %d rules, expected 1", len(o.Rules))
+		}
+
+		if o.Rules[0].Name != name {
+			return fmt.Errorf("Rule name is %s, expected %s", o.Rules[0].Name, name)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWaasContainerPolicyApplication(o *waas.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.MinPort != 30000 || o.MaxPort != 31000 {
+			return fmt.Errorf("Port range is %d-%d, expected 30000-31000", o.MinPort, o.MaxPort)
+		}
+
+		if len(o.Rules[0].Applications) != 1 {
+			return fmt.Errorf("Rule has %d applications, expected 1", len(o.Rules[0].Applications))
+		}
+		app := o.Rules[0].Applications[0]
+
+		if paths := app.ApiSpec.Paths; len(paths) != 2 || len(paths[1].Methods) != 1 || len(paths[1].Methods[0].Parameters) != 1 {
+			return fmt.Errorf("Paths were not imported from the OpenAPI specification: %+v", paths)
+		}
+		if app.SqlInjection.Effect != "prevent" || len(app.SqlInjection.ExceptionFields) != 1 {
+			return fmt.Errorf("SQL injection protection is %+v", app.SqlInjection)
+		}
+		if app.BotProtection.UnknownBots.RequestAnomalies.Threshold != 9 {
+			return fmt.Errorf("Request anomalies threshold is %d, expected 9", app.BotProtection.UnknownBots.RequestAnomalies.Threshold)
+		}
+		if len(app.CustomRules) != 1 || app.CustomRules[0].Effect != "alert" {
+			return fmt.Errorf("Custom rules are %+v", app.CustomRules)
+		}
+
+		return nil
+	}
+}
+
+func testAccWaasPolicyDestroy(resourceType string, get func(context.Context, api.Client) (waas.Policy, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			lo, err := get(context.Background(), *client)
+			if err != nil {
+				return fmt.Errorf("Error in get: %s", err)
+			}
+			if len(lo.Rules) != 0 {
+				return fmt.Errorf("Policy has %d rules, expected none", len(lo.Rules))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccWaasContainerPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_container_waas_policy" "test" {
+	min_port = 30000
+	max_port = 31000
+
+	rule {
+		name        = %q
+		collections = ["All"]
+
+		application {
+			app_id = "app-0001"
+
+			api_protection {
+				effect = "alert"
+				endpoint {
+					host          = "*"
+					base_path     = "/api"
+					internal_port = 8080
+				}
+				openapi_spec = jsonencode({
+					openapi = "3.0.0"
+					info    = { title = "Pets" }
+					paths = {
+						"/pets"      = { get = {}, post = {} }
+						"/pets/{id}" = { get = { parameters = [{ name = "id", in = "path", required = true, schema = { type = "integer" } }] } }
+					}
+				})
+			}
+
+			sql_injection {
+				effect = "prevent"
+				exception_field {
+					key      = "query"
+					location = "body"
+				}
+			}
+			xss {
+				effect = "alert"
+			}
+
+			bot_protection {
+				session_validation = "disable"
+				known_bots {
+					search_engine_crawlers = "disable"
+				}
+				unknown_bots {
+					headless_browsers = "alert"
+					request_anomalies {
+						effect    = "alert"
+						threshold = 9
+					}
+				}
+				user_defined_bot {
+					name        = "monitoring"
+					header_name = "User-Agent"
+					header_values = ["probe"]
+					effect      = "allow"
+				}
+			}
+
+			custom_rule {
+				id     = 1
+				effect = "alert"
+			}
+		}
+	}
+}`, name)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesWaasHost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyWaasHost,
+		ReadContext:   readPolicyWaasHost,
+		UpdateContext: updatePolicyWaasHost,
+		DeleteContext: deletePolicyWaasHost,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"max_port": waasPortSchema("Highest port of the range WAAS proxies listen on."),
+			"min_port": waasPortSchema("Lowest port of the range WAAS proxies listen on."),
+			"rule":     waasRuleSchema(),
+		},
+	}
+}
+
+func createPolicyWaasHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		MaxPort: d.Get("max_port").(int),
+		MinPort: d.Get("min_port").(int),
+		Rules:   parsedRules,
+	}
+
+	if err := waas.UpdateHost(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	d.SetId(policyTypeWaasHost)
+
+	return readPolicyWaasHost(ctx, d, meta)
+}
+
+func readPolicyWaasHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := waas.GetHost(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	if err := d.Set("max_port", retrievedPolicy.MaxPort); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasHost, err)
+	}
+	if err := d.Set("min_port", retrievedPolicy.MinPort); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasHost, err)
+	}
+	if err := d.Set("rule", waasRulesToSchema(d, retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasHost, err)
+	}
+	return diags
+}
+
+func updatePolicyWaasHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		MaxPort: d.Get("max_port").(int),
+		MinPort: d.Get("min_port").(int),
+		Rules:   parsedRules,
+	}
+
+	if err := waas.UpdateHost(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	return readPolicyWaasHost(ctx, d, meta)
+}
+
+func deletePolicyWaasHost(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := waas.ResetHost(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeWaasHost, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccWaasHostPolicyConfig(t *testing.T) {
+	var o waas.Policy
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWaasPolicyDestroy("prismacloudcompute_host_waas_policy", waas.GetHost),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWaasHostPolicyConfig(name + "-first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaasPolicyExists("prismacloudcompute_host_waas_policy.test", policyTypeWaasHost, waas.GetHost, &o),
+					testAccCheckWaasPolicyAttributes(&o, name+"-first"),
+				),
+			},
+			{
+				Config: testAccWaasHostPolicyConfig(name + "-second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWaasPolicyExists("prismacloudcompute_host_waas_policy.test", policyTypeWaasHost, waas.GetHost, &o),
+					testAccCheckWaasPolicyAttributes(&o, name+"-second"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_host_waas_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWaasHostPolicyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_host_waas_policy" "test" {
+	rule {
+		name        = %q
+		collections = ["All"]
+
+		application {
+			app_id = "app-0001"
+
+			api_protection {
+				endpoint {
+					host      = "*"
+					base_path = "*"
+				}
+				path {
+					path    = "/status"
+					methods = ["GET"]
+				}
+			}
+
+			sql_injection {
+				effect = "alert"
+			}
+			command_injection {
+				effect = "prevent"
+			}
+		}
+	}
+}`, name)
+}
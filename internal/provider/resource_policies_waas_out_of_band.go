@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesWaasOutOfBand() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyWaasOutOfBand,
+		ReadContext:   readPolicyWaasOutOfBand,
+		UpdateContext: updatePolicyWaasOutOfBand,
+		DeleteContext: deletePolicyWaasOutOfBand,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": waasRuleSchema(),
+		},
+	}
+}
+
+func createPolicyWaasOutOfBand(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateOutOfBand(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	d.SetId(policyTypeWaasOutOfBand)
+
+	return readPolicyWaasOutOfBand(ctx, d, meta)
+}
+
+func readPolicyWaasOutOfBand(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := waas.GetOutOfBand(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	if err := d.Set("rule", waasRulesToSchema(d, retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+	return diags
+}
+
+func updatePolicyWaasOutOfBand(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateOutOfBand(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	return readPolicyWaasOutOfBand(ctx, d, meta)
+}
+
+func deletePolicyWaasOutOfBand(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := waas.ResetOutOfBand(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeWaasOutOfBand, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/waas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourcePoliciesWaasServerless() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createPolicyWaasServerless,
+		ReadContext:   readPolicyWaasServerless,
+		UpdateContext: updatePolicyWaasServerless,
+		DeleteContext: deletePolicyWaasServerless,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rule": waasRuleSchema(),
+		},
+	}
+}
+
+func createPolicyWaasServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error creating %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	d.SetId(policyTypeWaasServerless)
+
+	return readPolicyWaasServerless(ctx, d, meta)
+}
+
+func readPolicyWaasServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedPolicy, err := waas.GetServerless(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	if err := setDigest(d, retrievedPolicy); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	if err := d.Set("rule", waasRulesToSchema(d, retrievedPolicy.Rules)); err != nil {
+		return diag.Errorf("error reading %s policy: %s", policyTypeWaasServerless, err)
+	}
+	return diags
+}
+
+func updatePolicyWaasServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRules, err := waasRulesFromSchema(ctx, *client, d)
+	if err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	parsedPolicy := waas.Policy{
+		Rules: parsedRules,
+	}
+
+	if err := waas.UpdateServerless(ctx, *client, parsedPolicy); err != nil {
+		return diag.Errorf("error updating %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	return readPolicyWaasServerless(ctx, d, meta)
+}
+
+func deletePolicyWaasServerless(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := waas.ResetServerless(ctx, *client); err != nil {
+		return diag.Errorf("error deleting %s policy: %s", policyTypeWaasServerless, err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -17,6 +17,8 @@ func resourceRbacRoles() *schema.Resource {
 		UpdateContext: updateRbacRole,
 		DeleteContext: deleteRbacRole,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -67,7 +69,7 @@ func createRbacRole(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("error creating role '%+v': %s", parsedRole, err)
 	}
 
-	if err := auth.CreateRole(*client, parsedRole); err != nil {
+	if err := auth.CreateRole(ctx, *client, parsedRole); err != nil {
 		return diag.Errorf("error creating role '%+v': %s", parsedRole, err)
 	}
 
@@ -80,8 +82,11 @@ func readRbacRole(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	var diags diag.Diagnostics
 
-	retrievedRole, err := auth.GetRole(*client, d.Id())
+	retrievedRole, err := auth.GetRole(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("error reading role: %s", err)
 	}
 
@@ -101,7 +106,7 @@ func updateRbacRole(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.Errorf("error updating role: %s", err)
 	}
 
-	if err := auth.UpdateRole(*client, parsedRole); err != nil {
+	if err := auth.UpdateRole(ctx, *client, parsedRole); err != nil {
 		return diag.Errorf("error updating role: %s", err)
 	}
 
@@ -113,7 +118,7 @@ func deleteRbacRole(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	var diags diag.Diagnostics
 
-	if err := auth.DeleteRole(*client, d.Id()); err != nil {
+	if err := auth.DeleteRole(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("error deleting role '%s': %s", d.Id(), err)
 	}
 
@@ -1,166 +1,122 @@
 package provider
 
-// import (
-// 	"fmt"
-// 	"testing"
-
-// 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-// 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-// )
-
-// func TestRbacRolesConfig(t *testing.T) {
-// 	fmt.Printf("\n\nStart TestAccRbacRolesConfig")
-// 	var o group.Group
-// 	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:     func() { testAccPreCheck(t) },
-// 		Providers:    testAccProviders,
-// 		CheckDestroy: testAccRbacRolesDestroy,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func TestRbacRolesNetwork(t *testing.T) {
-// 	var o group.Group
-// 	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:     func() { testAccPreCheck(t) },
-// 		Providers:    testAccProviders,
-// 		CheckDestroy: testAccRbacRolesDestroy,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func TestRbacRolesAuditEvent(t *testing.T) {
-// 	var o group.Group
-// 	id := fmt.Sprintf("tf%s", acctest.RandString(6))
-
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:     func() { testAccPreCheck(t) },
-// 		Providers:    testAccProviders,
-// 		CheckDestroy: testAccRbacRolesDestroy,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 			{
-// 				Config: testAccRbacRolesConfig(id),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckRbacRolesExists("prismacloudcompute_rbac_roles.test", &o),
-// 					testAccCheckRbacRolesAttributes(&o, id, true),
-// 				),
-// 			},
-// 		},
-// 	})
-// }
-
-// func testRbacRolesExists(n string, o *group.Group) resource.TestCheckFunc {
-// 	return func(s *terraform.State) error {
-// 		// return fmt.Errorf("What is the name: %s", o.GroupId)
-
-// 		rs, ok := s.RootModule().Resources[n]
-// 		if !ok {
-// 			return fmt.Errorf("Resource not found: %s", n)
-// 		}
-
-// 		if rs.Primary.ID == "" {
-// 			return fmt.Errorf("Object label Id is not set")
-// 		}
-
-// 		client := testAccProvider.Meta().(*api.Client)
-// 		lo, err := RbacRoles.Get(*client)
-// 		if err != nil {
-// 			return fmt.Errorf("Error in get: %s", err)
-// 		}
-// 		*o = lo
-
-// 		return nil
-// 	}
-// }
-
-// // func testRbacRolesAttributes(o *group.Group, id string, learningDisabled bool) resource.TestCheckFunc {
-// // 	return func(s *terraform.State) error {
-// // 		if o.GroupId != id {
-// // 			return fmt.Errorf("\n\nGroupId is %s, expected %s", o.GroupId, id)
-// // 		} else {
-// // 			fmt.Printf("\n\nName is %s", o.GroupId)
-// // 		}
-
-// // 		if o.LearningDisabled != learningDisabled {
-// // 			return fmt.Errorf("LearningDisabled is %t, expected %t", o.LearningDisabled, learningDisabled)
-// // 		}
-
-// // 		return nil
-// // 	}
-// // }
-
-// // func testRbacRolesDestroy(s *terraform.State) error {
-// // 	/*	client := testAccProvider.Meta().(*api.Client)
-
-// // 		for _, rs := range s.RootModule().Resources {
-
-// // 			if rs.Type != "prismacloudcompute_rbac_roles" {
-// // 				continue
-// // 			}
-
-// // 			if rs.Primary.ID != "" {
-// // 				name := rs.Primary.ID
-// // 				if err := group.Delete(client, name); err == nil {
-// // 					return fmt.Errorf("Object %q still exists", name)
-// // 				}
-// // 			}
-// // 			return nil
-// // 		}
-// // 	*/
-// // 	return nil
-// // }
-
-// // func testRbacRolesConfig(id string) string {
-// // 	var buf bytes.Buffer
-// // 	buf.Grow(500)
-
-// // 	buf.WriteString(fmt.Sprintf(`
-// // resource "prismacloudcompute_rbac_roles" "test" {
-// //     name = %q
-// // }`, id))
-
-// // 	return buf.String()
-// // }
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/auth"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRoleConfig(t *testing.T) {
+	var o auth.Role
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig(name, "first description", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists("prismacloudcompute_role.test", &o),
+					testAccCheckRoleAttributes(&o, name, "first description", false),
+				),
+			},
+			{
+				Config: testAccRoleConfig(name, "second description", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists("prismacloudcompute_role.test", &o),
+					testAccCheckRoleAttributes(&o, name, "second description", true),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoleExists(n string, o *auth.Role) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object label Name is not set")
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		name := rs.Primary.ID
+		lo, err := auth.GetRole(context.Background(), *client, name)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = *lo
+
+		return nil
+	}
+}
+
+func testAccCheckRoleAttributes(o *auth.Role, name string, description string, readWrite bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if o.Description != description {
+			return fmt.Errorf("Description is %s, expected %s", o.Description, description)
+		}
+
+		if len(o.Permissions) != 1 {
+			return fmt.Errorf("Role has %d permissions, expected 1", len(o.Permissions))
+		}
+
+		if o.Permissions[0].ReadWrite != readWrite {
+			return fmt.Errorf("Permission read_write is %t, expected %t", o.Permissions[0].ReadWrite, readWrite)
+		}
+
+		return nil
+	}
+}
+
+func testAccRoleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_role" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			name := rs.Primary.ID
+			if _, err := auth.GetRole(context.Background(), *client, name); err == nil {
+				return fmt.Errorf("Object %q still exists", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccRoleConfig(name, description string, readWrite bool) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_role" "test" {
+	name        = %q
+	description = %q
+	permission {
+		name       = "radarsContainers"
+		read_write = %t
+	}
+}`, name, description, readWrite)
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
 	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
@@ -10,23 +12,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-/*
- * Note: This resource is not fully implemented. Registries can be added but must be manually updated/deleted
-   using the UI. Any deleted registries must also be manually removed from the Terraform state file.
-*/
+// Resources created by earlier versions of the provider all have this ID, so
+// the specification they manage is identified by their attributes instead.
+const legacyRegistryId = "registrySettings"
 
 func resourceRegistry() *schema.Resource {
 	return &schema.Resource{
+		Description: "Manages a single registry scanning specification, leaving the other specifications as they are. " +
+			"A specification is identified by its `registry`, `repository`, `tag` and `os`, and changing any of them replaces it. " +
+			"Do not use it together with `prismacloudcompute_registry_settings`, which manages all specifications.",
+
 		CreateContext: createRegistry,
 		ReadContext:   readRegistry,
 		UpdateContext: updateRegistry,
 		DeleteContext: deleteRegistry,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the registry specification, in the form `registry,repository,tag,os`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"cap": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -82,16 +95,20 @@ func resourceRegistry() *schema.Resource {
 			"os": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
+				Default:     "linux",
 				Description: "The base OS of the registry images.",
 			},
 			"registry": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Registry address.",
 			},
 			"repository": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Repositories to scan. Pattern matching is supported.",
 			},
 			"scanners": {
@@ -102,6 +119,7 @@ func resourceRegistry() *schema.Resource {
 			"tag": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Tags to scan. Pattern matching is supported.",
 			},
 			"type": {
@@ -122,66 +140,100 @@ func createRegistry(ctx context.Context, d *schema.ResourceData, meta interface{
 	client := meta.(*api.Client)
 	parsedRegistry := convert.SchemaToRegistry(d)
 
-	if err := settings.AddRegistrySetting(*client, parsedRegistry); err != nil {
+	if err := settings.CreateRegistrySpecification(ctx, *client, parsedRegistry); err != nil {
 		return diag.Errorf("error creating registry: %s", err)
 	}
 
-	d.SetId("registrySettings")
+	d.SetId(registryId(parsedRegistry.Key()))
 	return readRegistry(ctx, d, meta)
 }
 
 func readRegistry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// client := meta.(*api.Client)
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	key, err := registryKey(d)
+	if err != nil {
+		return diag.Errorf("error reading registry: %s", err)
+	}
 
-	// var diags diag.Diagnostics
+	retrievedRegistry, err := settings.GetRegistrySpecification(ctx, *client, key)
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading registry: %s", err)
+	}
 
-	// retrievedRegistry, err := settings.GetRegistrySettings(*client)
-	// if err != nil {
-	// 	return diag.Errorf("error reading registry: %s", err)
-	// }
+	if err := setDigest(d, *retrievedRegistry); err != nil {
+		return diag.Errorf("error reading registry: %s", err)
+	}
 
-	// if err := d.Set("specification", convert.RegistrySpecificationToSchema(retrievedRegistry.Specifications)); err != nil {
-	// 	return diag.Errorf("error reading registry: %s", err)
-	// }
+	for key, val := range convert.RegistryToSchema(*retrievedRegistry) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading registry: %s", err)
+		}
+	}
+	d.SetId(registryId(retrievedRegistry.Key()))
 
-	// return diags
-	return diag.Diagnostics{}
+	return diags
 }
 
 func updateRegistry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// client := meta.(*api.Client)
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedRegistry := convert.SchemaToRegistry(d)
 
-	// parsedRegistry := convert.SchemaToRegistry(d)
+	if err := settings.UpdateRegistrySpecification(ctx, *client, parsedRegistry); err != nil {
+		return diag.Errorf("error updating registry: %s", err)
+	}
 
-	// retrievedRegistries, err := settings.GetRegistrySettings(*client)
-	// if err != nil {
-	// 	return diag.Errorf("error reading registry: %s", err)
-	// }
+	return readRegistry(ctx, d, meta)
+}
 
-	// registries := retrievedRegistries.Specifications
+func deleteRegistry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-	// if err := settings.UpdateRegistrySettings(*client, parsedRegistry); err != nil {
-	// 	return diag.Errorf("error updating registry: %s", err)
-	// }
+	var diags diag.Diagnostics
 
-	// return readRegistrySettings(ctx, d, meta)
-	return diag.Diagnostics{}
-}
+	key, err := registryKey(d)
+	if err != nil {
+		return diag.Errorf("error deleting registry: %s", err)
+	}
 
-func deleteRegistry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// client := meta.(*api.Client)
+	if err := settings.DeleteRegistrySpecification(ctx, *client, key); err != nil {
+		return diag.Errorf("error deleting registry: %s", err)
+	}
+
+	d.SetId("")
 
-	// var diags diag.Diagnostics
+	return diags
+}
 
-	// defaults := settings.RegistrySettings{
-	// 	Specifications: make([]settings.RegistrySpecification, 0),
-	// }
-	// if err := settings.UpdateRegistrySettings(*client, defaults); err != nil {
-	// 	return diag.Errorf("error deleting registry: %s", err)
-	// }
+func registryId(key settings.RegistryKey) string {
+	return strings.Join([]string{key.Registry, key.Repository, key.Tag, key.Os}, ",")
+}
 
-	// d.SetId("")
+// Get the key of the registry specification managed by a resource.
+func registryKey(d *schema.ResourceData) (settings.RegistryKey, error) {
+	if d.Id() == legacyRegistryId {
+		return settings.RegistryKey{
+			Registry:   d.Get("registry").(string),
+			Repository: d.Get("repository").(string),
+			Tag:        d.Get("tag").(string),
+			Os:         d.Get("os").(string),
+		}, nil
+	}
 
-	// return diags
-	return diag.Diagnostics{}
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 4 {
+		return settings.RegistryKey{}, fmt.Errorf("unexpected format of ID (%s), expected registry,repository,tag,os", d.Id())
+	}
+	return settings.RegistryKey{
+		Registry:   parts[0],
+		Repository: parts[1],
+		Tag:        parts[2],
+		Os:         parts[3],
+	}, nil
 }
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccRegistryConfig(t *testing.T) {
+	var o settings.RegistrySpecification
+	repository := fmt.Sprintf("tf%s/*", acctest.RandString(6))
+	// A specification managed by another configuration, which must be left
+	// as it is.
+	other := settings.RegistrySpecification{
+		Version:    "2",
+		Registry:   "registry.example.com",
+		Repository: repository,
+		Tag:        "*",
+		Os:         "windows",
+		Cap:        1,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRegistryDestroy(other),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryConfig(repository, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryExists("prismacloudcompute_registry.test", &o),
+					testAccCheckRegistryAttributes(&o, repository, 5),
+					resource.TestCheckResourceAttr("prismacloudcompute_registry.test", "id", "registry.example.com,"+repository+",*,linux"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*api.Client)
+					if err := settings.CreateRegistrySpecification(context.Background(), *client, other); err != nil {
+						t.Fatalf("error creating registry specification: %s", err)
+					}
+				},
+				Config: testAccRegistryConfig(repository, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryExists("prismacloudcompute_registry.test", &o),
+					testAccCheckRegistryAttributes(&o, repository, 10),
+				),
+			},
+			{
+				// Changes made outside of Terraform are reverted.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*api.Client)
+					changed := o
+					changed.Cap = 99
+					if err := settings.UpdateRegistrySpecification(context.Background(), *client, changed); err != nil {
+						t.Fatalf("error updating registry specification: %s", err)
+					}
+				},
+				Config: testAccRegistryConfig(repository, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryExists("prismacloudcompute_registry.test", &o),
+					testAccCheckRegistryAttributes(&o, repository, 10),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_registry.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRegistryDisappears(t *testing.T) {
+	var o settings.RegistrySpecification
+	repository := fmt.Sprintf("tf%s/*", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRegistryDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryConfig(repository, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistryExists("prismacloudcompute_registry.test", &o),
+					testAccCheckRegistryDisappears(&o),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRegistryExists(n string, o *settings.RegistrySpecification) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object label Name is not set")
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		key := settings.RegistryKey{
+			Registry:   rs.Primary.Attributes["registry"],
+			Repository: rs.Primary.Attributes["repository"],
+			Tag:        rs.Primary.Attributes["tag"],
+			Os:         rs.Primary.Attributes["os"],
+		}
+		lo, err := settings.GetRegistrySpecification(context.Background(), *client, key)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = *lo
+
+		return nil
+	}
+}
+
+func testAccCheckRegistryAttributes(o *settings.RegistrySpecification, repository string, layers int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Repository != repository {
+			return fmt.Errorf("Repository is %s, expected %s", o.Repository, repository)
+		}
+
+		if o.Cap != layers {
+			return fmt.Errorf("Cap is %d, expected %d", o.Cap, layers)
+		}
+
+		return nil
+	}
+}
+
+// Delete the registry specification outside of Terraform.
+func testAccCheckRegistryDisappears(o *settings.RegistrySpecification) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		return settings.DeleteRegistrySpecification(context.Background(), *client, o.Key())
+	}
+}
+
+// Check that the specifications of the registry resources were deleted, and
+// that the given specifications managed elsewhere were kept. The kept
+// specifications are then deleted to clean up.
+func testAccRegistryDestroy(kept ...settings.RegistrySpecification) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "prismacloudcompute_registry" {
+				continue
+			}
+
+			key := settings.RegistryKey{
+				Registry:   rs.Primary.Attributes["registry"],
+				Repository: rs.Primary.Attributes["repository"],
+				Tag:        rs.Primary.Attributes["tag"],
+				Os:         rs.Primary.Attributes["os"],
+			}
+			if _, err := settings.GetRegistrySpecification(context.Background(), *client, key); err == nil {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+		}
+
+		for _, spec := range kept {
+			if _, err := settings.GetRegistrySpecification(context.Background(), *client, spec.Key()); err != nil {
+				return fmt.Errorf("Registry specification managed elsewhere was not kept: %s", err)
+			}
+			if err := settings.DeleteRegistrySpecification(context.Background(), *client, spec.Key()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRegistryConfig(repository string, layers int) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_registry" "test" {
+	type        = "2"
+	registry    = "registry.example.com"
+	repository  = %q
+	tag         = "*"
+	cap         = %d
+	scanners    = 2
+	collections = ["All"]
+}`, repository, layers)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ldapSettingsId = "ldapSettings"
+
+func resourceLdapSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the LDAP settings of the Console, which LDAP groups and users authenticate with. " +
+			"Destroying the resource disables LDAP. " +
+			"The Console does not return `password` and `ca_cert`, so changes made to them outside of Terraform are not detected.",
+
+		CreateContext: createLdapSettings,
+		ReadContext:   readLdapSettings,
+		UpdateContext: updateLdapSettings,
+		DeleteContext: deleteLdapSettings,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the LDAP settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"account_upn": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Users log in with their user principal name, such as `user@example.com`, instead of their account name.",
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate to verify an LDAP server that uses TLS.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether LDAP authentication is enabled. Defaults to `true`.",
+			},
+			"group_search_base": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN to search for groups. Defaults to `search_base`.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the service account.",
+			},
+			"search_base": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base DN to search for users and groups, such as `dc=example,dc=com`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL of the LDAP server, such as `ldaps://ldap.example.com:636`.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name of the service account that searches the directory.",
+			},
+			"user_search_base": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base DN to search for users. Defaults to `search_base`.",
+			},
+			"user_search_identifier": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Attribute that identifies users, such as `sAMAccountName` or `uid`.",
+			},
+		},
+	}
+}
+
+func createLdapSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedLdapSettings := convert.SchemaToLdapSettings(d)
+
+	if err := settings.UpdateLdapSettings(ctx, *client, parsedLdapSettings); err != nil {
+		return diag.Errorf("error creating LDAP settings: %s", err)
+	}
+
+	d.SetId(ldapSettingsId)
+	return readLdapSettings(ctx, d, meta)
+}
+
+func readLdapSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedLdapSettings, err := settings.GetLdapSettings(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading LDAP settings: %s", err)
+	}
+
+	if err := setDigest(d, retrievedLdapSettings); err != nil {
+		return diag.Errorf("error reading LDAP settings: %s", err)
+	}
+
+	for key, val := range convert.LdapSettingsToSchema(retrievedLdapSettings) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading LDAP settings: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func updateLdapSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedLdapSettings := convert.SchemaToLdapSettings(d)
+
+	if err := settings.UpdateLdapSettings(ctx, *client, parsedLdapSettings); err != nil {
+		return diag.Errorf("error updating LDAP settings: %s", err)
+	}
+
+	return readLdapSettings(ctx, d, meta)
+}
+
+func deleteLdapSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := settings.ResetLdapSettings(ctx, *client); err != nil {
+		return diag.Errorf("error deleting LDAP settings: %s", err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// LDAP settings for the LDAP groups of other tests to depend on.
+const testAccGroupLdapSettingsConfig = `
+resource "prismacloudcompute_ldap_settings" "test" {
+	url         = "ldaps://ldap.example.com:636"
+	search_base = "dc=example,dc=com"
+}
+`
+
+func TestAccLdapSettingsConfig(t *testing.T) {
+	var o settings.LdapSettings
+	user := fmt.Sprintf("cn=tf%s,dc=example,dc=com", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccLdapSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLdapSettingsConfig(user, "ldaps://ldap.example.com:636", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLdapSettingsExists("prismacloudcompute_ldap_settings.test", &o),
+					testAccCheckLdapSettingsAttributes(&o, user, "ldaps://ldap.example.com:636"),
+					resource.TestCheckResourceAttr("prismacloudcompute_ldap_settings.test", "password", "first"),
+				),
+			},
+			{
+				Config: testAccLdapSettingsConfig(user, "ldaps://ldap2.example.com:636", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLdapSettingsExists("prismacloudcompute_ldap_settings.test", &o),
+					testAccCheckLdapSettingsAttributes(&o, user, "ldaps://ldap2.example.com:636"),
+					resource.TestCheckResourceAttr("prismacloudcompute_ldap_settings.test", "password", "second"),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_ldap_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ca_cert", "password"},
+			},
+		},
+	})
+}
+
+func testAccCheckLdapSettingsExists(n string, o *settings.LdapSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != ldapSettingsId {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, ldapSettingsId)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := settings.GetLdapSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckLdapSettingsAttributes(o *settings.LdapSettings, user, url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("LDAP is disabled, expected enabled")
+		}
+
+		if o.User != user {
+			return fmt.Errorf("User is %s, expected %s", o.User, user)
+		}
+
+		if o.Url != url {
+			return fmt.Errorf("URL is %s, expected %s", o.Url, url)
+		}
+
+		if o.Password.Encrypted == "" {
+			return fmt.Errorf("Password is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccLdapSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_ldap_settings" {
+			continue
+		}
+
+		lo, err := settings.GetLdapSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.Enabled {
+			return fmt.Errorf("LDAP is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccLdapSettingsConfig(user, url, password string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_ldap_settings" "test" {
+	url                    = %q
+	search_base            = "dc=example,dc=com"
+	user                   = %q
+	password               = %q
+	user_search_identifier = "sAMAccountName"
+	ca_cert                = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+}`, url, user, password)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const loggingSettingsId = "loggingSettings"
+
+func resourceLoggingSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the syslog, stdout, and metrics settings of the Console. Destroying the resource resets them to the Console defaults. " +
+			"Settings the provider does not manage, such as forwarding to Prisma Cloud and Cortex, are left as they are.",
+
+		CreateContext: createLoggingSettings,
+		ReadContext:   readLoggingSettings,
+		UpdateContext: updateLoggingSettings,
+		DeleteContext: deleteLoggingSettings,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the logging settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"console_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Console address used in links to the Console included in log messages.",
+			},
+			"enable_metrics_collection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Expose Prometheus metrics of the Console.",
+			},
+			"include_runtime_link": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include a link to the runtime event in the Console in runtime log messages.",
+			},
+			"stdout": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Write log messages to the standard output of the Console and the Defenders.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_proc_events": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Log all process activity detected by runtime defense, not only the activity that raised an audit.",
+						},
+						"verbose_scan": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Log the detailed results of every scan, including vulnerabilities and compliance issues.",
+						},
+					},
+				},
+			},
+			"syslog": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send log messages to syslog.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Address of a remote syslog server, such as `udp://syslog.example.com:514` or `tcp://syslog.example.com:601`. Messages are written to the local syslog of each Defender if not set.",
+						},
+						"all_proc_events": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Log all process activity detected by runtime defense, not only the activity that raised an audit.",
+						},
+						"cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM encoded CA certificate to verify a remote syslog server that uses TLS.",
+						},
+						"identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Identifier added to every syslog message.",
+						},
+						"verbose_scan": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Log the detailed results of every scan, including vulnerabilities and compliance issues.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createLoggingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedLoggingSettings := convert.SchemaToLoggingSettings(d)
+
+	if err := settings.UpdateLoggingSettings(ctx, *client, parsedLoggingSettings); err != nil {
+		return diag.Errorf("error creating logging settings: %s", err)
+	}
+
+	d.SetId(loggingSettingsId)
+	return readLoggingSettings(ctx, d, meta)
+}
+
+func readLoggingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedLoggingSettings, err := settings.GetLoggingSettings(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+
+	if err := setDigest(d, retrievedLoggingSettings); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+
+	if err := d.Set("console_address", retrievedLoggingSettings.ConsoleAddress); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+	if err := d.Set("enable_metrics_collection", retrievedLoggingSettings.EnableMetricsCollection); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+	if err := d.Set("include_runtime_link", retrievedLoggingSettings.IncludeRuntimeLink); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+	if err := d.Set("stdout", convert.LoggingStdoutToSchema(retrievedLoggingSettings.Stdout)); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+	if err := d.Set("syslog", convert.LoggingSyslogToSchema(retrievedLoggingSettings.Syslog)); err != nil {
+		return diag.Errorf("error reading logging settings: %s", err)
+	}
+
+	return diags
+}
+
+func updateLoggingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedLoggingSettings := convert.SchemaToLoggingSettings(d)
+
+	if err := settings.UpdateLoggingSettings(ctx, *client, parsedLoggingSettings); err != nil {
+		return diag.Errorf("error updating logging settings: %s", err)
+	}
+
+	return readLoggingSettings(ctx, d, meta)
+}
+
+func deleteLoggingSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := settings.ResetLoggingSettings(ctx, *client); err != nil {
+		return diag.Errorf("error deleting logging settings: %s", err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
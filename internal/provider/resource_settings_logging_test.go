@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLoggingSettingsConfig(t *testing.T) {
+	var o settings.LoggingSettings
+	identifier := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccLoggingSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingSettingsConfig(identifier, "udp://syslog.example.com:514", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingSettingsExists("prismacloudcompute_logging_settings.test", &o),
+					testAccCheckLoggingSettingsAttributes(&o, identifier, "udp://syslog.example.com:514", true),
+					resource.TestCheckResourceAttr("prismacloudcompute_logging_settings.test", "stdout.#", "1"),
+					resource.TestCheckResourceAttr("prismacloudcompute_logging_settings.test", "syslog.0.verbose_scan", "true"),
+				),
+			},
+			{
+				Config: testAccLoggingSettingsConfig(identifier, "tcp://syslog.example.com:601", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingSettingsExists("prismacloudcompute_logging_settings.test", &o),
+					testAccCheckLoggingSettingsAttributes(&o, identifier, "tcp://syslog.example.com:601", false),
+					resource.TestCheckResourceAttr("prismacloudcompute_logging_settings.test", "stdout.#", "0"),
+				),
+			},
+			{
+				// Changes made outside of Terraform are reverted, and settings
+				// the provider does not manage are kept.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*api.Client)
+					changed := o
+					changed.Syslog.Identifier = "changed"
+					changed.Stdout.Enabled = true
+					if err := settings.UpdateLoggingSettings(context.Background(), *client, changed); err != nil {
+						t.Fatalf("error updating logging settings: %s", err)
+					}
+					if err := client.ModifyDocument(context.Background(), settings.SettingsLoggingEndpoint, nil, func() (interface{}, error) {
+						var doc map[string]interface{}
+						if err := client.Request(context.Background(), http.MethodGet, settings.SettingsLoggingEndpoint, nil, nil, &doc); err != nil {
+							return nil, err
+						}
+						doc["unmanaged"] = "kept"
+						return doc, nil
+					}); err != nil {
+						t.Fatalf("error updating logging settings: %s", err)
+					}
+				},
+				Config: testAccLoggingSettingsConfig(identifier, "tcp://syslog.example.com:601", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLoggingSettingsExists("prismacloudcompute_logging_settings.test", &o),
+					testAccCheckLoggingSettingsAttributes(&o, identifier, "tcp://syslog.example.com:601", false),
+					testAccCheckLoggingSettingsUnmanaged("kept"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_logging_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLoggingSettingsExists(n string, o *settings.LoggingSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != loggingSettingsId {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, loggingSettingsId)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := settings.GetLoggingSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckLoggingSettingsAttributes(o *settings.LoggingSettings, identifier, address string, stdout bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Syslog.Enabled {
+			return fmt.Errorf("Syslog is disabled, expected enabled")
+		}
+
+		if o.Syslog.Identifier != identifier {
+			return fmt.Errorf("Syslog identifier is %s, expected %s", o.Syslog.Identifier, identifier)
+		}
+
+		if o.Syslog.Address != address {
+			return fmt.Errorf("Syslog address is %s, expected %s", o.Syslog.Address, address)
+		}
+
+		if o.Stdout.Enabled != stdout {
+			return fmt.Errorf("Stdout enabled is %t, expected %t", o.Stdout.Enabled, stdout)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLoggingSettingsUnmanaged(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		var doc map[string]interface{}
+		if err := client.Request(context.Background(), http.MethodGet, settings.SettingsLoggingEndpoint, nil, nil, &doc); err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+
+		if doc["unmanaged"] != expected {
+			return fmt.Errorf("Unmanaged setting is %v, expected %s", doc["unmanaged"], expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccLoggingSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_logging_settings" {
+			continue
+		}
+
+		lo, err := settings.GetLoggingSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		defaults, err := settings.DefaultLoggingSettings()
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(lo, defaults) {
+			return fmt.Errorf("Logging settings are %+v, expected the defaults", lo)
+		}
+	}
+
+	return nil
+}
+
+func testAccLoggingSettingsConfig(identifier, address string, stdout bool) string {
+	var stdoutBlock string
+	if stdout {
+		stdoutBlock = "stdout {}"
+	}
+	return fmt.Sprintf(`
+resource "prismacloudcompute_logging_settings" "test" {
+	console_address      = "https://console.example.com:8083"
+	include_runtime_link = true
+	%s
+
+	syslog {
+		address      = %q
+		identifier   = %q
+		verbose_scan = true
+	}
+}`, stdoutBlock, address, identifier)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const oauthSettingsId = "oauthSettings"
+
+func resourceOauthSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the OAuth settings of the Console, which OAuth groups and users authenticate with. " +
+			"Destroying the resource disables OAuth. " +
+			"The Console does not return `client_secret` and `cert`, so changes made to them outside of Terraform are not detected.",
+
+		CreateContext: createOauthSettings,
+		ReadContext:   readOauthSettings,
+		UpdateContext: updateOauthSettings,
+		DeleteContext: deleteOauthSettings,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the OAuth settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"auth_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Authorization URL of the identity provider.",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate to verify the identity provider.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the Console with the identity provider.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client secret of the Console with the identity provider.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether OAuth authentication is enabled. Defaults to `true`.",
+			},
+			"group_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Scope requested to get the groups of users.",
+			},
+			"provider_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the identity provider shown on the login page.",
+			},
+			"provider_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"github", "openshift"}, false),
+				Description:  "Identity provider. Can be set to `github` or `openshift`.",
+			},
+			"token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Token URL of the identity provider.",
+			},
+		},
+	}
+}
+
+func createOauthSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedOauthSettings := convert.SchemaToOauthSettings(d)
+
+	if err := settings.UpdateOauthSettings(ctx, *client, parsedOauthSettings); err != nil {
+		return diag.Errorf("error creating OAuth settings: %s", err)
+	}
+
+	d.SetId(oauthSettingsId)
+	return readOauthSettings(ctx, d, meta)
+}
+
+func readOauthSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedOauthSettings, err := settings.GetOauthSettings(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading OAuth settings: %s", err)
+	}
+
+	if err := setDigest(d, retrievedOauthSettings); err != nil {
+		return diag.Errorf("error reading OAuth settings: %s", err)
+	}
+
+	for key, val := range convert.OauthSettingsToSchema(retrievedOauthSettings) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading OAuth settings: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func updateOauthSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedOauthSettings := convert.SchemaToOauthSettings(d)
+
+	if err := settings.UpdateOauthSettings(ctx, *client, parsedOauthSettings); err != nil {
+		return diag.Errorf("error updating OAuth settings: %s", err)
+	}
+
+	return readOauthSettings(ctx, d, meta)
+}
+
+func deleteOauthSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := settings.ResetOauthSettings(ctx, *client); err != nil {
+		return diag.Errorf("error deleting OAuth settings: %s", err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOauthSettingsConfig(t *testing.T) {
+	var o settings.ProviderSettings
+	clientId := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccOauthSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOauthSettingsConfig(clientId, "https://github.example.com/login/oauth/authorize", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOauthSettingsExists("prismacloudcompute_oauth_settings.test", &o),
+					testAccCheckOauthSettingsAttributes(&o, clientId, "https://github.example.com/login/oauth/authorize"),
+					resource.TestCheckResourceAttr("prismacloudcompute_oauth_settings.test", "client_secret", "first"),
+				),
+			},
+			{
+				Config: testAccOauthSettingsConfig(clientId, "https://github2.example.com/login/oauth/authorize", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOauthSettingsExists("prismacloudcompute_oauth_settings.test", &o),
+					testAccCheckOauthSettingsAttributes(&o, clientId, "https://github2.example.com/login/oauth/authorize"),
+					resource.TestCheckResourceAttr("prismacloudcompute_oauth_settings.test", "client_secret", "second"),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_oauth_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cert", "client_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckOauthSettingsExists(n string, o *settings.ProviderSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != oauthSettingsId {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, oauthSettingsId)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := settings.GetOauthSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckOauthSettingsAttributes(o *settings.ProviderSettings, clientId, url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("OAuth is disabled, expected enabled")
+		}
+
+		if o.ClientId != clientId {
+			return fmt.Errorf("Client ID is %s, expected %s", o.ClientId, clientId)
+		}
+
+		if o.AuthUrl != url {
+			return fmt.Errorf("Authorization URL is %s, expected %s", o.AuthUrl, url)
+		}
+
+		if o.ClientSecret.Encrypted == "" {
+			return fmt.Errorf("Client secret is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOauthSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_oauth_settings" {
+			continue
+		}
+
+		lo, err := settings.GetOauthSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.Enabled {
+			return fmt.Errorf("OAuth is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccOauthSettingsConfig(clientId, url, secret string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_oauth_settings" "test" {
+	provider_name = "github"
+	client_id     = %q
+	client_secret = %q
+	auth_url      = %q
+	token_url     = "https://github.example.com/login/oauth/access_token"
+	group_scope   = "read:org"
+}`, clientId, secret, url)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const oidcSettingsId = "oidcSettings"
+
+func resourceOidcSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the OpenID Connect settings of the Console, which OpenID Connect groups and users authenticate with. " +
+			"Destroying the resource disables OpenID Connect. " +
+			"The Console does not return `client_secret` and `cert`, so changes made to them outside of Terraform are not detected.",
+
+		CreateContext: createOidcSettings,
+		ReadContext:   readOidcSettings,
+		UpdateContext: updateOidcSettings,
+		DeleteContext: deleteOidcSettings,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the OpenID Connect settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate to verify the identity provider.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the Console with the identity provider.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Client secret of the Console with the identity provider.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether OpenID Connect authentication is enabled. Defaults to `true`.",
+			},
+			"group_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the groups of users.",
+			},
+			"group_scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Scope requested to get the groups of users.",
+			},
+			"issuer_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Issuer URL of the identity provider, which serves its discovery document.",
+			},
+			"provider_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the identity provider shown on the login page.",
+			},
+			"user_claim": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Claim that holds the name of users.",
+			},
+		},
+	}
+}
+
+func createOidcSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedOidcSettings := convert.SchemaToOidcSettings(d)
+
+	if err := settings.UpdateOidcSettings(ctx, *client, parsedOidcSettings); err != nil {
+		return diag.Errorf("error creating OpenID Connect settings: %s", err)
+	}
+
+	d.SetId(oidcSettingsId)
+	return readOidcSettings(ctx, d, meta)
+}
+
+func readOidcSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedOidcSettings, err := settings.GetOidcSettings(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading OpenID Connect settings: %s", err)
+	}
+
+	if err := setDigest(d, retrievedOidcSettings); err != nil {
+		return diag.Errorf("error reading OpenID Connect settings: %s", err)
+	}
+
+	for key, val := range convert.OidcSettingsToSchema(retrievedOidcSettings) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading OpenID Connect settings: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func updateOidcSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedOidcSettings := convert.SchemaToOidcSettings(d)
+
+	if err := settings.UpdateOidcSettings(ctx, *client, parsedOidcSettings); err != nil {
+		return diag.Errorf("error updating OpenID Connect settings: %s", err)
+	}
+
+	return readOidcSettings(ctx, d, meta)
+}
+
+func deleteOidcSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := settings.ResetOidcSettings(ctx, *client); err != nil {
+		return diag.Errorf("error deleting OpenID Connect settings: %s", err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOidcSettingsConfig(t *testing.T) {
+	var o settings.ProviderSettings
+	clientId := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccOidcSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOidcSettingsConfig(clientId, "https://idp.example.com/first", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOidcSettingsExists("prismacloudcompute_oidc_settings.test", &o),
+					testAccCheckOidcSettingsAttributes(&o, clientId, "https://idp.example.com/first"),
+					resource.TestCheckResourceAttr("prismacloudcompute_oidc_settings.test", "client_secret", "first"),
+					resource.TestCheckResourceAttr("prismacloudcompute_group.test", "oidc_group", "true"),
+				),
+			},
+			{
+				Config: testAccOidcSettingsConfig(clientId, "https://idp.example.com/second", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOidcSettingsExists("prismacloudcompute_oidc_settings.test", &o),
+					testAccCheckOidcSettingsAttributes(&o, clientId, "https://idp.example.com/second"),
+					resource.TestCheckResourceAttr("prismacloudcompute_oidc_settings.test", "client_secret", "second"),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_oidc_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cert", "client_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckOidcSettingsExists(n string, o *settings.ProviderSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != oidcSettingsId {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, oidcSettingsId)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := settings.GetOidcSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckOidcSettingsAttributes(o *settings.ProviderSettings, clientId, url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("OpenID Connect is disabled, expected enabled")
+		}
+
+		if o.ClientId != clientId {
+			return fmt.Errorf("Client ID is %s, expected %s", o.ClientId, clientId)
+		}
+
+		if o.OpenIdIssuerUrl != url {
+			return fmt.Errorf("Issuer URL is %s, expected %s", o.OpenIdIssuerUrl, url)
+		}
+
+		if o.ClientSecret.Encrypted == "" {
+			return fmt.Errorf("Client secret is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOidcSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_oidc_settings" {
+			continue
+		}
+
+		lo, err := settings.GetOidcSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.Enabled {
+			return fmt.Errorf("OpenID Connect is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccOidcSettingsConfig(clientId, url, secret string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_oidc_settings" "test" {
+	issuer_url    = %q
+	client_id     = %q
+	client_secret = %q
+	group_claim   = "groups"
+	group_scope   = "groups"
+	user_claim    = "email"
+}
+
+resource "prismacloudcompute_group" "test" {
+	name       = %q
+	role       = "auditor"
+	oidc_group = true
+
+	depends_on = [prismacloudcompute_oidc_settings.test]
+}`, url, clientId, secret, clientId)
+}
@@ -17,11 +17,14 @@ func resourceRegistrySettings() *schema.Resource {
 		UpdateContext: updateRegistrySettings,
 		DeleteContext: deleteRegistrySettings,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
 			"id": {
 				Description: "The ID of the registry settings.",
 				Type:        schema.TypeString,
@@ -133,7 +136,7 @@ func createRegistrySettings(ctx context.Context, d *schema.ResourceData, meta in
 		Specifications: convert.SchemaToRegistrySpecification(d),
 	}
 
-	if err := settings.UpdateRegistrySettings(*client, parsedRegistry); err != nil {
+	if err := settings.UpdateRegistrySettings(ctx, *client, parsedRegistry); err != nil {
 		return diag.Errorf("error creating registry: %s", err)
 	}
 
@@ -146,11 +149,15 @@ func readRegistrySettings(ctx context.Context, d *schema.ResourceData, meta inte
 
 	var diags diag.Diagnostics
 
-	retrievedRegistry, err := settings.GetRegistrySettings(*client)
+	retrievedRegistry, err := settings.GetRegistrySettings(ctx, *client)
 	if err != nil {
 		return diag.Errorf("error reading registry: %s", err)
 	}
 
+	if err := setDigest(d, retrievedRegistry); err != nil {
+		return diag.Errorf("error reading registry: %s", err)
+	}
+
 	if err := d.Set("specification", convert.RegistrySpecificationToSchema(retrievedRegistry.Specifications)); err != nil {
 		return diag.Errorf("error reading registry: %s", err)
 	}
@@ -159,12 +166,13 @@ func readRegistrySettings(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 func updateRegistrySettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
 	client := meta.(*api.Client)
 	parsedRegistry := settings.RegistrySettings{
 		Specifications: convert.SchemaToRegistrySpecification(d),
 	}
 
-	if err := settings.UpdateRegistrySettings(*client, parsedRegistry); err != nil {
+	if err := settings.UpdateRegistrySettings(ctx, *client, parsedRegistry); err != nil {
 		return diag.Errorf("error updating registry: %s", err)
 	}
 
@@ -179,7 +187,7 @@ func deleteRegistrySettings(ctx context.Context, d *schema.ResourceData, meta in
 	defaults := settings.RegistrySettings{
 		Specifications: make([]settings.RegistrySpecification, 0),
 	}
-	if err := settings.UpdateRegistrySettings(*client, defaults); err != nil {
+	if err := settings.UpdateRegistrySettings(ctx, *client, defaults); err != nil {
 		return diag.Errorf("error deleting registry: %s", err)
 	}
 
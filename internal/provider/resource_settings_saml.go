@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const samlSettingsId = "samlSettings"
+
+func resourceSamlSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the SAML settings of the Console, which SAML groups and users authenticate with. " +
+			"Destroying the resource disables SAML. " +
+			"The Console does not return `app_secret` and `cert`, so changes made to them outside of Terraform are not detected.",
+
+		CreateContext: createSamlSettings,
+		ReadContext:   readSamlSettings,
+		UpdateContext: updateSamlSettings,
+		DeleteContext: deleteSamlSettings,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": digestSchema(),
+			"id": {
+				Description: "The ID of the SAML settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"app_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Application ID of the Console in Azure Active Directory. Only used with the `azure` type.",
+			},
+			"app_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Application secret of the Console in Azure Active Directory, used to look up the groups of users. Only used with the `azure` type.",
+			},
+			"audience": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Audience of the SAML assertions, which identifies the Console to the identity provider.",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM encoded certificate that the identity provider signs SAML responses with.",
+			},
+			"console_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the Console that the identity provider redirects users to.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether SAML authentication is enabled. Defaults to `true`.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Identity provider issuer.",
+			},
+			"provider_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the identity provider shown on the login page.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure Active Directory tenant ID. Only used with the `azure` type.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"okta", "gsuite", "ping", "shibboleth", "azure", "adfs"}, false),
+				Description:  "Identity provider type. Can be set to `okta`, `gsuite`, `ping`, `shibboleth`, `azure` or `adfs`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Single sign-on URL of the identity provider.",
+			},
+		},
+	}
+}
+
+func createSamlSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedSamlSettings := convert.SchemaToSamlSettings(d)
+
+	if err := settings.UpdateSamlSettings(ctx, *client, parsedSamlSettings); err != nil {
+		return diag.Errorf("error creating SAML settings: %s", err)
+	}
+
+	d.SetId(samlSettingsId)
+	return readSamlSettings(ctx, d, meta)
+}
+
+func readSamlSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedSamlSettings, err := settings.GetSamlSettings(ctx, *client)
+	if err != nil {
+		return diag.Errorf("error reading SAML settings: %s", err)
+	}
+
+	if err := setDigest(d, retrievedSamlSettings); err != nil {
+		return diag.Errorf("error reading SAML settings: %s", err)
+	}
+
+	for key, val := range convert.SamlSettingsToSchema(retrievedSamlSettings) {
+		if err := d.Set(key, val); err != nil {
+			return diag.Errorf("error reading SAML settings: %s", err)
+		}
+	}
+
+	return diags
+}
+
+func updateSamlSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ctx = withDigest(ctx, d)
+	client := meta.(*api.Client)
+	parsedSamlSettings := convert.SchemaToSamlSettings(d)
+
+	if err := settings.UpdateSamlSettings(ctx, *client, parsedSamlSettings); err != nil {
+		return diag.Errorf("error updating SAML settings: %s", err)
+	}
+
+	return readSamlSettings(ctx, d, meta)
+}
+
+func deleteSamlSettings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := settings.ResetSamlSettings(ctx, *client); err != nil {
+		return diag.Errorf("error deleting SAML settings: %s", err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
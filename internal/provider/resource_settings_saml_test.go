@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/settings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSamlSettingsConfig(t *testing.T) {
+	var o settings.SamlSettings
+	appId := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSamlSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSamlSettingsConfig(appId, "https://idp.example.com/sso/first", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSamlSettingsExists("prismacloudcompute_saml_settings.test", &o),
+					testAccCheckSamlSettingsAttributes(&o, appId, "https://idp.example.com/sso/first"),
+					resource.TestCheckResourceAttr("prismacloudcompute_saml_settings.test", "app_secret", "first"),
+				),
+			},
+			{
+				Config: testAccSamlSettingsConfig(appId, "https://idp.example.com/sso/second", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSamlSettingsExists("prismacloudcompute_saml_settings.test", &o),
+					testAccCheckSamlSettingsAttributes(&o, appId, "https://idp.example.com/sso/second"),
+					resource.TestCheckResourceAttr("prismacloudcompute_saml_settings.test", "app_secret", "second"),
+				),
+			},
+			{
+				ResourceName:            "prismacloudcompute_saml_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cert", "app_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckSamlSettingsExists(n string, o *settings.SamlSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID != samlSettingsId {
+			return fmt.Errorf("ID is %q, expected %q", rs.Primary.ID, samlSettingsId)
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := settings.GetSamlSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = lo
+
+		return nil
+	}
+}
+
+func testAccCheckSamlSettingsAttributes(o *settings.SamlSettings, appId, url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !o.Enabled {
+			return fmt.Errorf("SAML is disabled, expected enabled")
+		}
+
+		if o.AppId != appId {
+			return fmt.Errorf("App ID is %s, expected %s", o.AppId, appId)
+		}
+
+		if o.Url != url {
+			return fmt.Errorf("URL is %s, expected %s", o.Url, url)
+		}
+
+		if o.AppSecret.Encrypted == "" {
+			return fmt.Errorf("App secret is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccSamlSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_saml_settings" {
+			continue
+		}
+
+		lo, err := settings.GetSamlSettings(context.Background(), *client)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		if lo.Enabled {
+			return fmt.Errorf("SAML is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccSamlSettingsConfig(appId, url, secret string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_saml_settings" "test" {
+	type        = "azure"
+	url         = %q
+	issuer      = "https://sts.windows.net/tenant/"
+	audience    = "twistlock"
+	cert        = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	app_id      = %q
+	app_secret  = %q
+	tenant_id   = "tenant"
+	console_url = "https://console.example.com:8083"
+}`, url, appId, secret)
+}
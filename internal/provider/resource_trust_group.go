@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/convert"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTrustGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createTrustGroup,
+		ReadContext:   readTrustGroup,
+		UpdateContext: updateTrustGroup,
+		DeleteContext: deleteTrustGroup,
+
+		Timeouts: defaultTimeouts(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the trust group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Image name patterns of the images in the group, such as `docker.io/library/*` or `registry.example.com/app:1.*`.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"layers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Layer hashes of a base image. Images built on top of the base image are in the group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A unique trust group name, referenced by the rules of the trusted images policy.",
+			},
+		},
+	}
+}
+
+func createTrustGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedGroup := convert.SchemaToTrustGroup(d)
+	if err := policy.CreateTrustGroup(ctx, *client, parsedGroup); err != nil {
+		return diag.Errorf("error creating trust group '%s': %s", parsedGroup.Name, err)
+	}
+
+	d.SetId(parsedGroup.Name)
+
+	return readTrustGroup(ctx, d, meta)
+}
+
+func readTrustGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	retrievedGroup, err := policy.GetTrustGroup(ctx, *client, d.Id())
+	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
+		return diag.Errorf("error reading trust group: %s", err)
+	}
+
+	if err := d.Set("images", retrievedGroup.Images); err != nil {
+		return diag.Errorf("error reading trust group: %s", err)
+	}
+	if err := d.Set("layers", retrievedGroup.Layers); err != nil {
+		return diag.Errorf("error reading trust group: %s", err)
+	}
+	d.Set("name", retrievedGroup.Name)
+
+	return diags
+}
+
+func updateTrustGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+	parsedGroup := convert.SchemaToTrustGroup(d)
+
+	if err := policy.UpdateTrustGroup(ctx, *client, parsedGroup); err != nil {
+		return diag.Errorf("error updating trust group: %s", err)
+	}
+
+	return readTrustGroup(ctx, d, meta)
+}
+
+func deleteTrustGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	if err := policy.DeleteTrustGroup(ctx, *client, d.Id()); err != nil {
+		return diag.Errorf("error deleting trust group '%s': %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api"
+	"github.com/PaloAltoNetworks/terraform-provider-prismacloudcompute/internal/api/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTrustGroupConfig(t *testing.T) {
+	var o policy.TrustGroup
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTrustGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustGroupConfig(name, "registry.example.com/app:1.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustGroupExists("prismacloudcompute_trust_group.test", &o),
+					testAccCheckTrustGroupAttributes(&o, name, "registry.example.com/app:1.*"),
+				),
+			},
+			{
+				Config: testAccTrustGroupConfig(name, "registry.example.com/app:2.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustGroupExists("prismacloudcompute_trust_group.test", &o),
+					testAccCheckTrustGroupAttributes(&o, name, "registry.example.com/app:2.*"),
+				),
+			},
+			{
+				ResourceName:      "prismacloudcompute_trust_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTrustGroupDisappears(t *testing.T) {
+	var o policy.TrustGroup
+	name := fmt.Sprintf("tf%s", acctest.RandString(6))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTrustGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustGroupConfig(name, "registry.example.com/app:1.*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustGroupExists("prismacloudcompute_trust_group.test", &o),
+					testAccCheckTrustGroupDisappears(name),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustGroupExists(n string, o *policy.TrustGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object label Name is not set")
+		}
+
+		client := testAccProvider.Meta().(*api.Client)
+		lo, err := policy.GetTrustGroup(context.Background(), *client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error in get: %s", err)
+		}
+		*o = *lo
+
+		return nil
+	}
+}
+
+func testAccCheckTrustGroupAttributes(o *policy.TrustGroup, name, image string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if o.Name != name {
+			return fmt.Errorf("Name is %s, expected %s", o.Name, name)
+		}
+
+		if len(o.Images) != 1 || o.Images[0] != image {
+			return fmt.Errorf("Images are %v, expected [%s]", o.Images, image)
+		}
+
+		if len(o.Layers) != 1 {
+			return fmt.Errorf("Group has %d layers, expected 1", len(o.Layers))
+		}
+
+		return nil
+	}
+}
+
+// Delete the trust group outside of Terraform.
+func testAccCheckTrustGroupDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+		return policy.DeleteTrustGroup(context.Background(), *client, name)
+	}
+}
+
+func testAccTrustGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "prismacloudcompute_trust_group" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			name := rs.Primary.ID
+			if _, err := policy.GetTrustGroup(context.Background(), *client, name); err == nil {
+				return fmt.Errorf("Object %q still exists", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccTrustGroupConfig(name, image string) string {
+	return fmt.Sprintf(`
+resource "prismacloudcompute_trust_group" "test" {
+	name   = %q
+	images = [%q]
+	layers = ["sha256:3e207b409db364b595ba862cdc12be96dcdad8e36c59a03b7b3b61c946a5741a"]
+}`, name, image)
+}
@@ -17,6 +17,8 @@ func resourceUsers() *schema.Resource {
 		UpdateContext: updateUser,
 		DeleteContext: deleteUser,
 
+		Timeouts: defaultTimeouts(),
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -81,7 +83,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.Errorf("failed to create user '%+v': %s", parsedUser, err)
 	}
 
-	if err := auth.CreateUser(*client, parsedUser); err != nil {
+	if err := auth.CreateUser(ctx, *client, parsedUser); err != nil {
 		return diag.Errorf("failed to create user '%+v': %s", parsedUser, err)
 	}
 
@@ -94,13 +96,15 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 	var diags diag.Diagnostics
 
-	retrievedUser, err := auth.GetUser(*client, d.Id())
+	retrievedUser, err := auth.GetUser(ctx, *client, d.Id())
 	if err != nil {
+		if removeIfNotFound(ctx, d, err) {
+			return diags
+		}
 		return diag.Errorf("failed to read user: %s", err)
 	}
 
 	d.Set("authentication_type", retrievedUser.AuthType)
-	d.Set("password", retrievedUser.Password)
 	if err := d.Set("permissions", convert.UserPermissionsToSchema(retrievedUser.Permissions)); err != nil {
 		return diag.Errorf("failed to read user: %s", err)
 	}
@@ -117,7 +121,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.Errorf("failed to update user: %s", err)
 	}
 
-	if err := auth.UpdateUser(*client, parsedUser); err != nil {
+	if err := auth.UpdateUser(ctx, *client, parsedUser); err != nil {
 		return diag.Errorf("failed to update user: %s", err)
 	}
 
@@ -129,7 +133,7 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	var diags diag.Diagnostics
 
-	if err := auth.DeleteUser(*client, d.Id()); err != nil {
+	if err := auth.DeleteUser(ctx, *client, d.Id()); err != nil {
 		return diag.Errorf("failed to delete user '%s': %s", d.Id(), err)
 	}
 